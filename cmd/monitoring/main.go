@@ -41,6 +41,32 @@ func main() {
 		chainReader,
 		logger.With(log, "component", "source-txresults"),
 	)
+	txDetailsSourceFactory := monitoring.NewTxDetailsSourceFactory(
+		chainReader,
+		logger.With(log, "component", "source-tx-details"),
+	)
+
+	// optional: replay transactions missed across restarts and RPC gaps
+	if chainConfig.BackfillDir != "" {
+		checkpoints, err := monitoring.NewFileCheckpointStore(chainConfig.BackfillDir)
+		if err != nil {
+			log.Fatalw("failed to create backfill checkpoint store", "error", err)
+		}
+		backfill := monitoring.BackfillConfig{
+			Store:          checkpoints,
+			MaxTxsPerFetch: chainConfig.BackfillTxsPerPoll(),
+		}
+		txResultsSourceFactory = monitoring.NewTxResultsSourceFactoryWithBackfill(
+			chainReader,
+			logger.With(log, "component", "source-txresults"),
+			backfill,
+		)
+		txDetailsSourceFactory = monitoring.NewTxDetailsSourceFactoryWithBackfill(
+			chainReader,
+			logger.With(log, "component", "source-tx-details"),
+			backfill,
+		)
+	}
 
 	monitor, err := commonMonitoring.NewMonitor(
 		make(chan struct{}),
@@ -61,13 +87,14 @@ func main() {
 		chainReader,
 		logger.With(log, "component", "source-feed-balances"),
 	)
-	txDetailsSourceFactory := monitoring.NewTxDetailsSourceFactory(
+	feedFlagSourceFactory := monitoring.NewFeedFlagSourceFactory(
 		chainReader,
-		logger.With(log, "component", "source-tx-details"),
+		logger.With(log, "component", "source-feed-flag"),
 	)
 	monitor.SourceFactories = append(monitor.SourceFactories,
 		feedBalancesSourceFactory,
 		txDetailsSourceFactory,
+		feedFlagSourceFactory,
 	)
 
 	// network sources
@@ -110,11 +137,21 @@ func main() {
 		logger.With(log, "component", promExporter),
 		metrics.NewNodeSuccess(logger.With(log, "component", promMetrics)),
 	)
+	oracleParticipationFactory := exporter.NewOracleParticipationFactory(
+		logger.With(log, "component", promExporter),
+		metrics.NewOracleParticipation(logger.With(log, "component", promMetrics)),
+	)
+	feedFlagFactory := exporter.NewFeedFlagFactory(
+		logger.With(log, "component", promExporter),
+		metrics.NewFeedFlag(logger.With(log, "component", promMetrics)),
+	)
 	monitor.ExporterFactories = append(monitor.ExporterFactories,
 		feedBalancesExporterFactory,
 		reportObservationsFactory,
 		feesFactory,
 		nodeSuccessFactory,
+		oracleParticipationFactory,
+		feedFlagFactory,
 	)
 
 	// network exporters
@@ -0,0 +1,482 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/gagliardetto/solana-go"
+
+	relaySol "github.com/smartcontractkit/chainlink-solana/pkg/solana"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/deploy"
+)
+
+func printJSON(v interface{}) error {
+	out, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(out))
+	return nil
+}
+
+type oracleView struct {
+	Transmitter   solana.PublicKey `json:"transmitter"`
+	Signer        string           `json:"signer"`
+	Payee         solana.PublicKey `json:"payee"`
+	ProposedPayee solana.PublicKey `json:"proposedPayee"`
+	FromRoundID   uint32           `json:"fromRoundId"`
+	Payment       uint64           `json:"payment"`
+}
+
+type stateView struct {
+	Slot                      uint64           `json:"slot"`
+	Version                   uint8            `json:"version"`
+	Transmissions             solana.PublicKey `json:"transmissions"`
+	Owner                     solana.PublicKey `json:"owner"`
+	ProposedOwner             solana.PublicKey `json:"proposedOwner"`
+	TokenMint                 solana.PublicKey `json:"tokenMint"`
+	TokenVault                solana.PublicKey `json:"tokenVault"`
+	RequesterAccessController solana.PublicKey `json:"requesterAccessController"`
+	BillingAccessController   solana.PublicKey `json:"billingAccessController"`
+	MinAnswer                 string           `json:"minAnswer"`
+	MaxAnswer                 string           `json:"maxAnswer"`
+	F                         uint8            `json:"f"`
+	Epoch                     uint32           `json:"epoch"`
+	Round                     uint8            `json:"round"`
+	LatestAggregatorRoundID   uint32           `json:"latestAggregatorRoundId"`
+	LatestTransmitter         solana.PublicKey `json:"latestTransmitter"`
+	ConfigCount               uint32           `json:"configCount"`
+	LatestConfigDigest        string           `json:"latestConfigDigest"`
+	LatestConfigBlockNumber   uint64           `json:"latestConfigBlockNumber"`
+	ObservationPayment        uint32           `json:"observationPaymentGjuels"`
+	TransmissionPayment       uint32           `json:"transmissionPaymentGjuels"`
+	OffchainConfigVersion     uint64           `json:"offchainConfigVersion"`
+	OffchainConfig            string           `json:"offchainConfig"`
+	Oracles                   []oracleView     `json:"oracles"`
+}
+
+func inspectState(ctx context.Context, e *env, args []string) error {
+	keys, err := parseKeys(args, "state")
+	if err != nil {
+		return err
+	}
+	state, slot, err := relaySol.GetState(ctx, e.client, keys[0], e.commitment)
+	if err != nil {
+		return err
+	}
+	offchainConfig, err := state.OffchainConfig.Data()
+	if err != nil {
+		return err
+	}
+	oracles, err := state.Oracles.Data()
+	if err != nil {
+		return err
+	}
+	c := state.Config
+	view := stateView{
+		Slot:                      slot,
+		Version:                   state.Version,
+		Transmissions:             state.Transmissions,
+		Owner:                     c.Owner,
+		ProposedOwner:             c.ProposedOwner,
+		TokenMint:                 c.TokenMint,
+		TokenVault:                c.TokenVault,
+		RequesterAccessController: c.RequesterAccessController,
+		BillingAccessController:   c.BillingAccessController,
+		MinAnswer:                 c.MinAnswer.BigInt().String(),
+		MaxAnswer:                 c.MaxAnswer.BigInt().String(),
+		F:                         c.F,
+		Epoch:                     c.Epoch,
+		Round:                     c.Round,
+		LatestAggregatorRoundID:   c.LatestAggregatorRoundID,
+		LatestTransmitter:         c.LatestTransmitter,
+		ConfigCount:               c.ConfigCount,
+		LatestConfigDigest:        hex.EncodeToString(c.LatestConfigDigest[:]),
+		LatestConfigBlockNumber:   c.LatestConfigBlockNumber,
+		ObservationPayment:        c.Billing.ObservationPayment,
+		TransmissionPayment:       c.Billing.TransmissionPayment,
+		OffchainConfigVersion:     state.OffchainConfig.Version,
+		OffchainConfig:            hex.EncodeToString(offchainConfig),
+	}
+	for _, o := range oracles {
+		view.Oracles = append(view.Oracles, oracleView{
+			Transmitter:   o.Transmitter,
+			Signer:        hex.EncodeToString(o.Signer.Key[:]),
+			Payee:         o.Payee,
+			ProposedPayee: o.ProposedPayee,
+			FromRoundID:   o.FromRoundID,
+			Payment:       o.Payment,
+		})
+	}
+	return printJSON(view)
+}
+
+type roundView struct {
+	RoundID   uint32 `json:"roundId"`
+	Slot      uint64 `json:"slot"`
+	Timestamp uint32 `json:"timestamp"`
+	Answer    string `json:"answer"`
+}
+
+type feedView struct {
+	Slot              uint64           `json:"slot"`
+	Version           uint8            `json:"version"`
+	Flagged           bool             `json:"flagged"`
+	Owner             solana.PublicKey `json:"owner"`
+	ProposedOwner     solana.PublicKey `json:"proposedOwner"`
+	Writer            solana.PublicKey `json:"writer"`
+	Description       string           `json:"description"`
+	Decimals          uint8            `json:"decimals"`
+	FlaggingThreshold uint32           `json:"flaggingThreshold"`
+	LatestRoundID     uint32           `json:"latestRoundId"`
+	Granularity       uint8            `json:"granularity"`
+	LiveLength        uint32           `json:"liveLength"`
+	Rounds            []roundView      `json:"rounds"`
+}
+
+func inspectFeed(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("inspect-feed", flag.ContinueOnError)
+	rounds := fs.Int("rounds", 5, "number of latest rounds to print")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	keys, err := parseKeys(fs.Args(), "feed")
+	if err != nil {
+		return err
+	}
+	reader := relaySol.NewStoreFeedReader(e.client, keys[0], e.commitment)
+	header, slot, err := reader.GetHeader(ctx)
+	if err != nil {
+		return err
+	}
+	view := feedView{
+		Slot:              slot,
+		Version:           header.Version,
+		Flagged:           header.State == relaySol.FeedStateFlagged,
+		Owner:             header.Owner,
+		ProposedOwner:     header.ProposedOwner,
+		Writer:            header.Writer,
+		Description:       strings.TrimRight(string(header.Description[:]), "\x00"),
+		Decimals:          header.Decimals,
+		FlaggingThreshold: header.FlaggingThreshold,
+		LatestRoundID:     header.LatestRoundID,
+		Granularity:       header.Granularity,
+		LiveLength:        header.LiveLength,
+	}
+	if *rounds > 0 && header.LatestRoundID > 0 {
+		latest, err := reader.GetLatestRounds(ctx, *rounds)
+		if err != nil {
+			return err
+		}
+		for _, r := range latest {
+			view.Rounds = append(view.Rounds, roundView{RoundID: r.RoundID, Slot: r.Slot, Timestamp: r.Timestamp, Answer: r.Answer.String()})
+		}
+	}
+	return printJSON(view)
+}
+
+// proposalFile is the JSON config file of propose-config and accept-proposal
+type proposalFile struct {
+	State                 solana.PublicKey `json:"state"`
+	Proposal              solana.PublicKey `json:"proposal"`
+	TokenReceiver         solana.PublicKey `json:"tokenReceiver"`
+	F                     uint8            `json:"f"`
+	OffchainConfigVersion uint64           `json:"offchainConfigVersion"`
+	// OffchainConfig is hex encoded
+	OffchainConfig string `json:"offchainConfig"`
+	Oracles        []struct {
+		// Signer is the hex encoded onchain signing address
+		Signer      string           `json:"signer"`
+		Transmitter solana.PublicKey `json:"transmitter"`
+		Payee       solana.PublicKey `json:"payee"`
+	} `json:"oracles"`
+}
+
+func readProposalConfig(args []string) (deploy.ProposalConfig, error) {
+	if len(args) != 1 {
+		return deploy.ProposalConfig{}, errors.New("expected arguments: <config.json>")
+	}
+	raw, err := os.ReadFile(args[0])
+	if err != nil {
+		return deploy.ProposalConfig{}, err
+	}
+	var file proposalFile
+	if err = json.Unmarshal(raw, &file); err != nil {
+		return deploy.ProposalConfig{}, fmt.Errorf("failed to parse %s: %w", args[0], err)
+	}
+	cfg := deploy.ProposalConfig{
+		State:                 file.State,
+		Proposal:              file.Proposal,
+		TokenReceiver:         file.TokenReceiver,
+		F:                     file.F,
+		OffchainConfigVersion: file.OffchainConfigVersion,
+	}
+	if cfg.OffchainConfig, err = hex.DecodeString(strings.TrimPrefix(file.OffchainConfig, "0x")); err != nil {
+		return deploy.ProposalConfig{}, fmt.Errorf("invalid offchainConfig: %w", err)
+	}
+	for i, o := range file.Oracles {
+		signer, err := hex.DecodeString(strings.TrimPrefix(o.Signer, "0x"))
+		if err != nil {
+			return deploy.ProposalConfig{}, fmt.Errorf("invalid oracles[%d].signer: %w", i, err)
+		}
+		cfg.Oracles = append(cfg.Oracles, deploy.Oracle{Signer: signer, Transmitter: o.Transmitter, Payee: o.Payee})
+	}
+	return cfg, nil
+}
+
+// proposeConfig creates, writes and finalizes the proposal, the proposal is accepted separately so it can be reviewed
+func proposeConfig(ctx context.Context, e *env, args []string) error {
+	if err := e.requirePrograms(true, false, false); err != nil {
+		return err
+	}
+	cfg, err := readProposalConfig(args)
+	if err != nil {
+		return err
+	}
+	d, err := e.deployer()
+	if err != nil {
+		return err
+	}
+	plan, err := d.PlanConfig(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	if n := len(plan.Steps); n > 0 && plan.Steps[n-1].Name == "accept proposal" {
+		plan.Steps = plan.Steps[:n-1]
+		plan.Skipped = append(plan.Skipped, "accept proposal (run accept-proposal)")
+	}
+	return e.apply(ctx, d, plan)
+}
+
+// acceptProposal accepts a finalized proposal, the config file must match the proposal
+func acceptProposal(ctx context.Context, e *env, args []string) error {
+	if err := e.requirePrograms(true, false, false); err != nil {
+		return err
+	}
+	cfg, err := readProposalConfig(args)
+	if err != nil {
+		return err
+	}
+	d, err := e.deployer()
+	if err != nil {
+		return err
+	}
+	plan, err := d.PlanConfig(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	if len(plan.Steps) > 1 {
+		return fmt.Errorf("proposal %s is not finalized, run propose-config first:\n%s", cfg.Proposal, plan)
+	}
+	return e.apply(ctx, d, plan)
+}
+
+func closeProposal(ctx context.Context, e *env, args []string) error {
+	if err := e.requirePrograms(true, false, false); err != nil {
+		return err
+	}
+	keys, err := parseKeys(args, "proposal")
+	if err != nil {
+		return err
+	}
+	d, err := e.deployer()
+	if err != nil {
+		return err
+	}
+	plan, err := d.PlanCloseProposal(ctx, keys[0])
+	if err != nil {
+		return err
+	}
+	return e.apply(ctx, d, plan)
+}
+
+func setBilling(ctx context.Context, e *env, args []string) error {
+	if err := e.requirePrograms(true, false, false); err != nil {
+		return err
+	}
+	fs := flag.NewFlagSet("set-billing", flag.ContinueOnError)
+	observation := fs.Uint("observation", 0, "observation payment in gjuels")
+	transmission := fs.Uint("transmission", 0, "transmission payment in gjuels")
+	tokenReceiver := fs.String("token-receiver", "", "token account receiving the payments of oracles with closed payee accounts")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *observation > uint(^uint32(0)) || *transmission > uint(^uint32(0)) {
+		return errors.New("payments must fit in 32 bits")
+	}
+	receiver, err := optionalKey(*tokenReceiver)
+	if err != nil {
+		return fmt.Errorf("invalid -token-receiver: %w", err)
+	}
+	keys, err := parseKeys(fs.Args(), "state")
+	if err != nil {
+		return err
+	}
+	d, err := e.deployer()
+	if err != nil {
+		return err
+	}
+	billing := deploy.Billing{
+		ObservationPaymentGjuels:  uint32(*observation),  //nolint:gosec // checked above
+		TransmissionPaymentGjuels: uint32(*transmission), //nolint:gosec // checked above
+	}
+	plan, err := d.PlanBilling(ctx, keys[0], billing, receiver)
+	if err != nil {
+		return err
+	}
+	return e.apply(ctx, d, plan)
+}
+
+// withdrawPayment pays out the owed payment of the oracle with payee, the owner must own the payee token account
+func withdrawPayment(ctx context.Context, e *env, args []string) error {
+	if err := e.requirePrograms(true, false, false); err != nil {
+		return err
+	}
+	keys, err := parseKeys(args, "state", "payee")
+	if err != nil {
+		return err
+	}
+	d, err := e.deployer()
+	if err != nil {
+		return err
+	}
+	plan, err := d.PlanWithdrawPayment(ctx, keys[0], keys[1], e.owner)
+	if err != nil {
+		return err
+	}
+	return e.apply(ctx, d, plan)
+}
+
+func transferOwnership(ctx context.Context, e *env, args []string) error {
+	if err := e.requirePrograms(true, true, true); err != nil {
+		return err
+	}
+	keys, err := parseKeys(args, "account", "proposed owner")
+	if err != nil {
+		return err
+	}
+	d, err := e.deployer()
+	if err != nil {
+		return err
+	}
+	plan, err := d.PlanTransferOwnership(ctx, keys[0], keys[1])
+	if err != nil {
+		return err
+	}
+	return e.apply(ctx, d, plan)
+}
+
+func acceptOwnership(ctx context.Context, e *env, args []string) error {
+	if err := e.requirePrograms(true, true, true); err != nil {
+		return err
+	}
+	keys, err := parseKeys(args, "account")
+	if err != nil {
+		return err
+	}
+	d, err := e.deployer()
+	if err != nil {
+		return err
+	}
+	plan, err := d.PlanAcceptOwnership(ctx, keys[0])
+	if err != nil {
+		return err
+	}
+	return e.apply(ctx, d, plan)
+}
+
+// transferPayeeship proposes a new payee for the transmitter, the owner must own the current payee token account
+func transferPayeeship(ctx context.Context, e *env, args []string) error {
+	if err := e.requirePrograms(true, false, false); err != nil {
+		return err
+	}
+	keys, err := parseKeys(args, "state", "transmitter", "proposed payee")
+	if err != nil {
+		return err
+	}
+	d, err := e.deployer()
+	if err != nil {
+		return err
+	}
+	plan, err := d.PlanTransferPayeeship(ctx, keys[0], keys[1], e.owner, keys[2])
+	if err != nil {
+		return err
+	}
+	return e.apply(ctx, d, plan)
+}
+
+// acceptPayeeship accepts the proposed payee of the transmitter, the owner must own the proposed payee token account
+func acceptPayeeship(ctx context.Context, e *env, args []string) error {
+	if err := e.requirePrograms(true, false, false); err != nil {
+		return err
+	}
+	keys, err := parseKeys(args, "state", "transmitter")
+	if err != nil {
+		return err
+	}
+	d, err := e.deployer()
+	if err != nil {
+		return err
+	}
+	plan, err := d.PlanAcceptPayeeship(ctx, keys[0], keys[1], e.owner)
+	if err != nil {
+		return err
+	}
+	return e.apply(ctx, d, plan)
+}
+
+func addAccess(ctx context.Context, e *env, args []string) error {
+	return access(ctx, e, args, true)
+}
+
+func removeAccess(ctx context.Context, e *env, args []string) error {
+	return access(ctx, e, args, false)
+}
+
+func access(ctx context.Context, e *env, args []string, grant bool) error {
+	if err := e.requirePrograms(false, false, true); err != nil {
+		return err
+	}
+	keys, err := parseKeys(args, "access controller", "address")
+	if err != nil {
+		return err
+	}
+	d, err := e.deployer()
+	if err != nil {
+		return err
+	}
+	plan, err := d.PlanAccess(ctx, keys[0], keys[1], grant)
+	if err != nil {
+		return err
+	}
+	return e.apply(ctx, d, plan)
+}
+
+func setAccessController(ctx context.Context, e *env, args []string) error {
+	if len(args) != 3 {
+		return errors.New("expected arguments: <requester|billing|lowering> <state or store> <access controller>")
+	}
+	role := deploy.AccessControllerRole(args[0])
+	if err := e.requirePrograms(role != deploy.RoleLowering, role == deploy.RoleLowering, false); err != nil {
+		return err
+	}
+	keys, err := parseKeys(args[1:], "account", "access controller")
+	if err != nil {
+		return err
+	}
+	d, err := e.deployer()
+	if err != nil {
+		return err
+	}
+	plan, err := d.PlanSetAccessController(ctx, keys[0], role, keys[1])
+	if err != nil {
+		return err
+	}
+	return e.apply(ctx, d, plan)
+}
@@ -0,0 +1,243 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/deploy"
+)
+
+// env is the configuration shared by all subcommands
+type env struct {
+	client     *rpc.Client
+	commitment rpc.CommitmentType
+	programs   deploy.Programs
+	owner      solana.PublicKey
+	payer      solana.PublicKey
+	keys       map[solana.PublicKey]*solana.PrivateKey
+	unsigned   bool
+}
+
+type command struct {
+	name  string
+	usage string
+	run   func(ctx context.Context, e *env, args []string) error
+}
+
+var commands = []command{
+	{"inspect-state", "<state>", inspectState},
+	{"inspect-feed", "[-rounds n] <feed>", inspectFeed},
+	{"propose-config", "<config.json>", proposeConfig},
+	{"accept-proposal", "<config.json>", acceptProposal},
+	{"close-proposal", "<proposal>", closeProposal},
+	{"set-billing", "-observation n -transmission n -token-receiver <account> <state>", setBilling},
+	{"withdraw-payment", "<state> <payee>", withdrawPayment},
+	{"transfer-ownership", "<account> <proposed owner>", transferOwnership},
+	{"accept-ownership", "<account>", acceptOwnership},
+	{"transfer-payeeship", "<state> <transmitter> <proposed payee>", transferPayeeship},
+	{"accept-payeeship", "<state> <transmitter>", acceptPayeeship},
+	{"add-access", "<access controller> <address>", addAccess},
+	{"remove-access", "<access controller> <address>", removeAccess},
+	{"set-access-controller", "<requester|billing|lowering> <state or store> <access controller>", setAccessController},
+}
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	fs := flag.NewFlagSet("solana-ocr2", flag.ContinueOnError)
+	rpcURL := fs.String("rpc", rpc.LocalNet_RPC, "solana rpc endpoint")
+	commitment := fs.String("commitment", string(rpc.CommitmentConfirmed), "commitment used to read accounts and confirm transactions")
+	keypair := fs.String("keypair", "", "keypair file of the owner, signs and submits transactions")
+	var signers keypairFiles
+	fs.Var(&signers, "signer", "additional keypair file signing transactions, e.g. new accounts (repeatable)")
+	unsigned := fs.Bool("unsigned", false, "print unsigned base64 transactions for offline (multisig) signing instead of submitting")
+	owner := fs.String("owner", "", "owner (authority) public key, defaults to the keypair public key")
+	payer := fs.String("payer", "", "fee payer public key, defaults to the owner")
+	ocr2Program := fs.String("ocr2-program", "", "OCR2 program ID")
+	storeProgram := fs.String("store-program", "", "store program ID")
+	acProgram := fs.String("access-controller-program", "", "access controller program ID")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: solana-ocr2 [flags] <command> [args]\n\nCommands:\n")
+		for _, c := range commands {
+			fmt.Fprintf(fs.Output(), "  %s %s\n", c.name, c.usage)
+		}
+		fmt.Fprintf(fs.Output(), "\nFlags:\n")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return errors.New("missing command")
+	}
+
+	e := &env{
+		client:     rpc.New(*rpcURL),
+		commitment: rpc.CommitmentType(*commitment),
+		keys:       map[solana.PublicKey]*solana.PrivateKey{},
+		unsigned:   *unsigned,
+	}
+	var err error
+	if e.programs.OCR2, err = optionalKey(*ocr2Program); err != nil {
+		return fmt.Errorf("invalid -ocr2-program: %w", err)
+	}
+	if e.programs.Store, err = optionalKey(*storeProgram); err != nil {
+		return fmt.Errorf("invalid -store-program: %w", err)
+	}
+	if e.programs.AccessController, err = optionalKey(*acProgram); err != nil {
+		return fmt.Errorf("invalid -access-controller-program: %w", err)
+	}
+	if *keypair != "" {
+		key, err := solana.PrivateKeyFromSolanaKeygenFile(*keypair)
+		if err != nil {
+			return fmt.Errorf("failed to read keypair: %w", err)
+		}
+		e.keys[key.PublicKey()] = &key
+		e.owner = key.PublicKey()
+	}
+	for _, file := range signers {
+		key, err := solana.PrivateKeyFromSolanaKeygenFile(file)
+		if err != nil {
+			return fmt.Errorf("failed to read signer %s: %w", file, err)
+		}
+		e.keys[key.PublicKey()] = &key
+	}
+	if *owner != "" {
+		if e.owner, err = solana.PublicKeyFromBase58(*owner); err != nil {
+			return fmt.Errorf("invalid -owner: %w", err)
+		}
+	}
+	e.payer = e.owner
+	if *payer != "" {
+		if e.payer, err = solana.PublicKeyFromBase58(*payer); err != nil {
+			return fmt.Errorf("invalid -payer: %w", err)
+		}
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+	name, cmdArgs := fs.Arg(0), fs.Args()[1:]
+	for _, c := range commands {
+		if c.name == name {
+			if err = c.run(ctx, e, cmdArgs); err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+			return nil
+		}
+	}
+	fs.Usage()
+	return fmt.Errorf("unknown command %q", name)
+}
+
+// deployer returns a deployer for the owner, commands which submit transactions require an owner
+func (e *env) deployer() (*deploy.Deployer, error) {
+	if e.owner.IsZero() {
+		return nil, errors.New("an owner is required, set -keypair or -owner")
+	}
+	if !e.unsigned && e.keys[e.payer] == nil {
+		return nil, fmt.Errorf("no keypair for payer %s, set -keypair or -signer, or use -unsigned", e.payer)
+	}
+	return deploy.NewDeployer(e.client, e.programs, e.owner, e.payer, e.commitment), nil
+}
+
+// apply submits the plan steps signed with the local keypairs, or prints the unsigned transactions
+func (e *env) apply(ctx context.Context, d *deploy.Deployer, plan deploy.Plan) error {
+	fmt.Print(plan)
+	if plan.Empty() {
+		fmt.Println("nothing to do")
+		return nil
+	}
+	if e.unsigned {
+		txs, err := d.Unsigned(ctx, plan)
+		if err != nil {
+			return err
+		}
+		for i, tx := range txs {
+			// placeholder signatures keep the wire format expected by offline signers
+			tx.Signatures = make([]solana.Signature, tx.Message.Header.NumRequiredSignatures)
+			raw, err := tx.MarshalBinary()
+			if err != nil {
+				return fmt.Errorf("step %d (%s): failed to encode tx: %w", i+1, plan.Steps[i].Name, err)
+			}
+			fmt.Printf("\n# %d. %s, signers: %v\n%s\n", i+1, plan.Steps[i].Name, tx.Message.Signers(), base64.StdEncoding.EncodeToString(raw))
+		}
+		return nil
+	}
+	sigs, err := d.Execute(ctx, plan, func(key solana.PublicKey) *solana.PrivateKey {
+		return e.keys[key]
+	})
+	for i, sig := range sigs {
+		fmt.Printf("%d. %s: %s\n", i+1, plan.Steps[i].Name, sig)
+	}
+	return err
+}
+
+// keypairFiles is a repeatable flag of keypair file paths
+type keypairFiles []string
+
+func (k *keypairFiles) String() string {
+	return strings.Join(*k, ",")
+}
+
+func (k *keypairFiles) Set(file string) error {
+	*k = append(*k, file)
+	return nil
+}
+
+func optionalKey(s string) (solana.PublicKey, error) {
+	if s == "" {
+		return solana.PublicKey{}, nil
+	}
+	return solana.PublicKeyFromBase58(s)
+}
+
+// parseKeys parses exactly len(names) public key arguments
+func parseKeys(args []string, names ...string) ([]solana.PublicKey, error) {
+	if len(args) != len(names) {
+		return nil, fmt.Errorf("expected arguments: <%s>", strings.Join(names, "> <"))
+	}
+	keys := make([]solana.PublicKey, len(args))
+	for i, arg := range args {
+		key, err := solana.PublicKeyFromBase58(arg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %q: %w", names[i], arg, err)
+		}
+		keys[i] = key
+	}
+	return keys, nil
+}
+
+// requirePrograms returns an error naming the missing program ID flags
+func (e *env) requirePrograms(ocr2, store, accessController bool) error {
+	var missing []string
+	if ocr2 && e.programs.OCR2.IsZero() {
+		missing = append(missing, "-ocr2-program")
+	}
+	if store && e.programs.Store.IsZero() {
+		missing = append(missing, "-store-program")
+	}
+	if accessController && e.programs.AccessController.IsZero() {
+		missing = append(missing, "-access-controller-program")
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("missing program IDs: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
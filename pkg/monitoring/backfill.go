@@ -0,0 +1,233 @@
+package monitoring
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+
+	commonMonitoring "github.com/smartcontractkit/chainlink-common/pkg/monitoring"
+)
+
+// BackfillConfig enables replaying transactions that were missed by the tx sources (restarts, RPC gaps)
+type BackfillConfig struct {
+	// Store persists backfill progress per feed
+	Store CheckpointStore
+	// MaxTxsPerFetch limits the number of backfilled signatures returned per source fetch
+	MaxTxsPerFetch int
+}
+
+// Checkpoint is the persisted backfill progress for a single source + feed
+type Checkpoint struct {
+	// Latest is the most recent signature returned by the live source
+	Latest solana.Signature `json:"latest"`
+	// Gaps are ranges of signatures that have not been processed yet (newest first)
+	Gaps []Gap `json:"gaps,omitempty"`
+}
+
+// Gap is a range of unprocessed signatures, both bounds are exclusive
+// Before is used as the paging cursor and moves backwards as signatures are processed
+type Gap struct {
+	Before solana.Signature `json:"before"`
+	Until  solana.Signature `json:"until"`
+}
+
+// CheckpointStore persists backfill progress so it survives monitor restarts
+type CheckpointStore interface {
+	// Load returns an empty checkpoint if none exists for the key
+	Load(key string) (Checkpoint, error)
+	Save(key string, checkpoint Checkpoint) error
+}
+
+var _ CheckpointStore = (*fileCheckpointStore)(nil)
+
+// NewFileCheckpointStore stores each checkpoint as a json file in dir
+func NewFileCheckpointStore(dir string) (CheckpointStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create checkpoint directory: %w", err)
+	}
+	return &fileCheckpointStore{dir: dir}, nil
+}
+
+type fileCheckpointStore struct {
+	dir string
+	mu  sync.Mutex
+}
+
+func (f *fileCheckpointStore) path(key string) string {
+	return filepath.Join(f.dir, key+".json")
+}
+
+func (f *fileCheckpointStore) Load(key string) (Checkpoint, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var out Checkpoint
+	b, err := os.ReadFile(f.path(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return out, nil
+	}
+	if err != nil {
+		return out, fmt.Errorf("failed to read checkpoint: %w", err)
+	}
+	if err := json.Unmarshal(b, &out); err != nil {
+		return out, fmt.Errorf("failed to decode checkpoint: %w", err)
+	}
+	return out, nil
+}
+
+func (f *fileCheckpointStore) Save(key string, checkpoint Checkpoint) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	b, err := json.Marshal(checkpoint)
+	if err != nil {
+		return fmt.Errorf("failed to encode checkpoint: %w", err)
+	}
+
+	// write + rename to prevent partially written checkpoints
+	tmp := f.path(key) + ".tmp"
+	if err := os.WriteFile(tmp, b, 0o600); err != nil {
+		return fmt.Errorf("failed to write checkpoint: %w", err)
+	}
+	if err := os.Rename(tmp, f.path(key)); err != nil {
+		return fmt.Errorf("failed to write checkpoint: %w", err)
+	}
+	return nil
+}
+
+// backfiller tracks gaps in the signatures seen by a live source and pages backwards through them
+type backfiller struct {
+	key     string
+	account solana.PublicKey
+	client  ChainReader
+	store   CheckpointStore
+	limit   int
+	log     commonMonitoring.Logger
+
+	mu         sync.Mutex
+	checkpoint Checkpoint
+}
+
+func newBackfiller(key string, account solana.PublicKey, client ChainReader, cfg BackfillConfig, log commonMonitoring.Logger) *backfiller {
+	b := &backfiller{
+		key:     key,
+		account: account,
+		client:  client,
+		store:   cfg.Store,
+		limit:   cfg.MaxTxsPerFetch,
+		log:     log,
+	}
+	if b.limit <= 0 {
+		b.limit = 1
+	}
+
+	checkpoint, err := b.store.Load(key)
+	if err != nil {
+		// start fresh rather than blocking the live source
+		log.Errorw("failed to load backfill checkpoint", "key", key, "error", err)
+	}
+	b.checkpoint = checkpoint
+	return b
+}
+
+// latest returns the most recent signature processed before the monitor (re)started
+func (b *backfiller) latest() solana.Signature {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.checkpoint.Latest
+}
+
+// observe records a page of live signatures (newest first) fetched with `until` as the lower bound
+// a full page means older signatures may have been skipped, so a gap is opened for them
+func (b *backfiller) observe(until solana.Signature, sigs []*rpc.TransactionSignature, pageSize int) {
+	if len(sigs) == 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(sigs) >= pageSize && !until.IsZero() {
+		gap := Gap{Before: sigs[len(sigs)-1].Signature, Until: until}
+		b.checkpoint.Gaps = append([]Gap{gap}, b.checkpoint.Gaps...)
+		b.log.Infow("detected gap in transaction signatures", "key", b.key, "before", gap.Before, "until", gap.Until)
+	}
+	b.checkpoint.Latest = sigs[0].Signature
+	b.save()
+}
+
+// backfillBatch is a page of missed signatures (newest first) from the gap, the gap is only advanced past the
+// signatures once the batch is committed
+type backfillBatch struct {
+	gap  Gap
+	sigs []*rpc.TransactionSignature
+	done bool // the batch reaches the end of the gap
+}
+
+// next returns the next page of missed signatures, bounded by the configured limit
+// the checkpoint is not changed, the batch must be committed once it is processed or it is returned again
+func (b *backfiller) next(ctx context.Context) (*backfillBatch, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.checkpoint.Gaps) == 0 {
+		return nil, nil
+	}
+
+	gap := b.checkpoint.Gaps[0]
+	limit := b.limit
+	sigs, err := b.client.GetSignaturesForAddressWithOpts(
+		ctx,
+		b.account,
+		&rpc.GetSignaturesForAddressOpts{
+			Commitment: rpc.CommitmentConfirmed,
+			Before:     gap.Before,
+			Until:      gap.Until,
+			Limit:      &limit,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch backfill transactions for state account: %w", err)
+	}
+
+	return &backfillBatch{gap: gap, sigs: sigs, done: len(sigs) < limit}, nil
+}
+
+// commit advances the gap of the batch past its signatures and persists the checkpoint
+func (b *backfiller) commit(batch *backfillBatch) {
+	if batch == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// live fetches may have opened new gaps in front of the batch gap since the batch was returned
+	for i, gap := range b.checkpoint.Gaps {
+		if gap != batch.gap {
+			continue
+		}
+
+		if batch.done {
+			// reached the end of the gap
+			b.checkpoint.Gaps = append(b.checkpoint.Gaps[:i:i], b.checkpoint.Gaps[i+1:]...)
+		} else {
+			b.checkpoint.Gaps[i].Before = batch.sigs[len(batch.sigs)-1].Signature
+		}
+		b.save()
+		return
+	}
+}
+
+// save persists the checkpoint, must be called with the lock held
+func (b *backfiller) save() {
+	if err := b.store.Save(b.key, b.checkpoint); err != nil {
+		b.log.Errorw("failed to save backfill checkpoint", "key", b.key, "error", err)
+	}
+}
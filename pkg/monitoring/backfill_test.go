@@ -0,0 +1,239 @@
+package monitoring
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	commonMonitoring "github.com/smartcontractkit/chainlink-common/pkg/monitoring"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/monitoring/config"
+	"github.com/smartcontractkit/chainlink-solana/pkg/monitoring/mocks"
+	"github.com/smartcontractkit/chainlink-solana/pkg/monitoring/testutils"
+	"github.com/smartcontractkit/chainlink-solana/pkg/monitoring/types"
+	pkgSolana "github.com/smartcontractkit/chainlink-solana/pkg/solana"
+)
+
+func generateSignatures(offset, n int) []*rpc.TransactionSignature {
+	out := make([]*rpc.TransactionSignature, n)
+	for i := range out {
+		sig := solana.Signature{}
+		sig[0], sig[1] = byte((offset+i)>>8), byte(offset+i)
+		out[i] = &rpc.TransactionSignature{Signature: sig}
+	}
+	return out
+}
+
+func TestFileCheckpointStore(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "checkpoints")
+	store, err := NewFileCheckpointStore(dir)
+	require.NoError(t, err)
+
+	// missing checkpoint
+	checkpoint, err := store.Load("feed")
+	require.NoError(t, err)
+	assert.Equal(t, Checkpoint{}, checkpoint)
+
+	// round trip
+	expected := Checkpoint{
+		Latest: solana.Signature{1},
+		Gaps:   []Gap{{Before: solana.Signature{2}, Until: solana.Signature{3}}},
+	}
+	require.NoError(t, store.Save("feed", expected))
+	checkpoint, err = store.Load("feed")
+	require.NoError(t, err)
+	assert.Equal(t, expected, checkpoint)
+
+	// invalid checkpoint
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "invalid.json"), []byte("{"), 0o600))
+	_, err = store.Load("invalid")
+	assert.ErrorContains(t, err, "failed to decode checkpoint")
+}
+
+func TestTxResultsSource_Backfill(t *testing.T) {
+	cr := mocks.NewChainReader(t)
+	lgr := logger.Test(t)
+	ctx := tests.Context(t)
+
+	store, err := NewFileCheckpointStore(t.TempDir())
+	require.NoError(t, err)
+	feedConfig := config.SolanaFeedConfig{StateAccount: testutils.GeneratePublicKey()}
+	factory := NewTxResultsSourceFactoryWithBackfill(cr, lgr, BackfillConfig{Store: store, MaxTxsPerFetch: 10})
+
+	isLive := func(until solana.Signature) interface{} {
+		return mock.MatchedBy(func(opts *rpc.GetSignaturesForAddressOpts) bool {
+			return opts.Before.IsZero() && opts.Until == until
+		})
+	}
+	isBackfill := func(before, until solana.Signature) interface{} {
+		return mock.MatchedBy(func(opts *rpc.GetSignaturesForAddressOpts) bool {
+			return opts.Before == before && opts.Until == until && *opts.Limit == 10
+		})
+	}
+	fetch := func(source commonMonitoring.Source) commonMonitoring.TxResults {
+		out, err := source.Fetch(ctx)
+		require.NoError(t, err)
+		counts, ok := out.(commonMonitoring.TxResults)
+		require.True(t, ok)
+		return counts
+	}
+
+	// first run - no previous checkpoint, no backfill
+	source, err := factory.NewSource(nil, feedConfig)
+	require.NoError(t, err)
+	initial := generateSignatures(0, 5)
+	cr.On("GetSignaturesForAddressWithOpts", mock.Anything, mock.Anything, isLive(solana.Signature{})).Return(initial, nil).Once()
+	assert.Equal(t, uint64(5), fetch(source).NumSucceeded)
+
+	// restart - live source resumes from checkpoint and detects a gap with a full page
+	source, err = factory.NewSource(nil, feedConfig)
+	require.NoError(t, err)
+	live := generateSignatures(1000, 100)
+	gapStart := live[len(live)-1].Signature
+	missed := generateSignatures(100, 15)
+	cr.On("GetSignaturesForAddressWithOpts", mock.Anything, mock.Anything, isLive(initial[0].Signature)).Return(live, nil).Once()
+	cr.On("GetSignaturesForAddressWithOpts", mock.Anything, mock.Anything, isBackfill(gapStart, initial[0].Signature)).Return(missed[:10], nil).Once()
+	assert.Equal(t, uint64(110), fetch(source).NumSucceeded)
+
+	checkpoint, err := store.Load(fmt.Sprintf("%s-%s", txresultsType, feedConfig.StateAccount))
+	require.NoError(t, err)
+	assert.Equal(t, live[0].Signature, checkpoint.Latest)
+	require.Equal(t, 1, len(checkpoint.Gaps))
+	assert.Equal(t, Gap{Before: missed[9].Signature, Until: initial[0].Signature}, checkpoint.Gaps[0])
+
+	// backfill failure does not block live results
+	cr.On("GetSignaturesForAddressWithOpts", mock.Anything, mock.Anything, isLive(live[0].Signature)).Return([]*rpc.TransactionSignature{}, nil).Once()
+	cr.On("GetSignaturesForAddressWithOpts", mock.Anything, mock.Anything, isBackfill(missed[9].Signature, initial[0].Signature)).Return(nil, fmt.Errorf("fail")).Once()
+	assert.Equal(t, uint64(0), fetch(source).NumSucceeded)
+
+	// remaining backfill closes the gap
+	cr.On("GetSignaturesForAddressWithOpts", mock.Anything, mock.Anything, isLive(live[0].Signature)).Return([]*rpc.TransactionSignature{}, nil).Once()
+	cr.On("GetSignaturesForAddressWithOpts", mock.Anything, mock.Anything, isBackfill(missed[9].Signature, initial[0].Signature)).Return(missed[10:], nil).Once()
+	assert.Equal(t, uint64(5), fetch(source).NumSucceeded)
+
+	checkpoint, err = store.Load(fmt.Sprintf("%s-%s", txresultsType, feedConfig.StateAccount))
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(checkpoint.Gaps))
+
+	// no gaps - only live fetch
+	cr.On("GetSignaturesForAddressWithOpts", mock.Anything, mock.Anything, isLive(live[0].Signature)).Return([]*rpc.TransactionSignature{}, nil).Once()
+	assert.Equal(t, uint64(0), fetch(source).NumSucceeded)
+}
+
+func TestTxDetailsSource_BackfillRetry(t *testing.T) {
+	cr := mocks.NewChainReader(t)
+	lgr, logs := logger.TestObserved(t, zapcore.DebugLevel)
+	ctx := tests.Context(t)
+
+	store, err := NewFileCheckpointStore(t.TempDir())
+	require.NoError(t, err)
+	feedConfig := config.SolanaFeedConfig{StateAccount: testutils.GeneratePublicKey(), ContractAddress: types.SampleTxResultProgram}
+	key := fmt.Sprintf("%s-%s", types.TxDetailsType, feedConfig.StateAccount)
+
+	// previous run detected a gap
+	gap := Gap{Before: solana.Signature{1}, Until: solana.Signature{2}}
+	require.NoError(t, store.Save(key, Checkpoint{Latest: solana.Signature{3}, Gaps: []Gap{gap}}))
+
+	factory := NewTxDetailsSourceFactoryWithBackfill(cr, lgr, BackfillConfig{Store: store, MaxTxsPerFetch: 3})
+	source, err := factory.NewSource(nil, feedConfig)
+	require.NoError(t, err)
+
+	var rpcResponse rpc.GetTransactionResult
+	require.NoError(t, json.Unmarshal([]byte(types.SampleTxResultJSON), &rpcResponse))
+
+	isLive := mock.MatchedBy(func(opts *rpc.GetSignaturesForAddressOpts) bool { return opts.Before.IsZero() })
+	isBackfill := mock.MatchedBy(func(opts *rpc.GetSignaturesForAddressOpts) bool { return opts.Before == gap.Before })
+	missed := generateSignatures(100, 3)
+
+	// GetTransaction fails mid-batch - live results are returned and the gap is not advanced
+	cr.On("GetSignaturesForAddressWithOpts", mock.Anything, mock.Anything, isLive).Return([]*rpc.TransactionSignature{}, nil).Once()
+	cr.On("GetSignaturesForAddressWithOpts", mock.Anything, mock.Anything, isBackfill).Return(missed, nil).Once()
+	cr.On("GetTransaction", mock.Anything, missed[0].Signature, mock.Anything).Return(&rpcResponse, nil).Once()
+	cr.On("GetTransaction", mock.Anything, missed[1].Signature, mock.Anything).Return(nil, fmt.Errorf("fail")).Once()
+	res, err := source.Fetch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(testutils.ParseTxDetails(t, res)))
+	assert.Equal(t, 1, logs.FilterMessage("failed to fetch backfilled transactions, retrying on next fetch").Len())
+
+	checkpoint, err := store.Load(key)
+	require.NoError(t, err)
+	assert.Equal(t, []Gap{gap}, checkpoint.Gaps)
+
+	// the batch is fetched again and the gap is advanced once all transactions are fetched
+	cr.On("GetSignaturesForAddressWithOpts", mock.Anything, mock.Anything, isLive).Return([]*rpc.TransactionSignature{}, nil).Once()
+	cr.On("GetSignaturesForAddressWithOpts", mock.Anything, mock.Anything, isBackfill).Return(missed, nil).Once()
+	cr.On("GetTransaction", mock.Anything, mock.Anything, mock.Anything).Return(&rpcResponse, nil).Times(3)
+	cr.On("GetState", mock.Anything, mock.Anything, mock.Anything).Return(pkgSolana.State{}, uint64(0), nil).Once()
+	res, err = source.Fetch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(testutils.ParseTxDetails(t, res)))
+
+	checkpoint, err = store.Load(key)
+	require.NoError(t, err)
+	assert.Equal(t, []Gap{{Before: missed[2].Signature, Until: gap.Until}}, checkpoint.Gaps)
+}
+
+func TestTxDetailsSource_LiveRetry(t *testing.T) {
+	cr := mocks.NewChainReader(t)
+	lgr := logger.Test(t)
+	ctx := tests.Context(t)
+
+	store, err := NewFileCheckpointStore(t.TempDir())
+	require.NoError(t, err)
+	feedConfig := config.SolanaFeedConfig{StateAccount: testutils.GeneratePublicKey(), ContractAddress: types.SampleTxResultProgram}
+	key := fmt.Sprintf("%s-%s", types.TxDetailsType, feedConfig.StateAccount)
+
+	factory := NewTxDetailsSourceFactoryWithBackfill(cr, lgr, BackfillConfig{Store: store, MaxTxsPerFetch: 3})
+	source, err := factory.NewSource(nil, feedConfig)
+	require.NoError(t, err)
+
+	var rpcResponse rpc.GetTransactionResult
+	require.NoError(t, json.Unmarshal([]byte(types.SampleTxResultJSON), &rpcResponse))
+
+	isLive := func(until solana.Signature) interface{} {
+		return mock.MatchedBy(func(opts *rpc.GetSignaturesForAddressOpts) bool {
+			return opts.Before.IsZero() && opts.Until == until
+		})
+	}
+	live := generateSignatures(0, 2)
+
+	// GetTransaction fails mid-batch - the source does not move past the live signatures
+	cr.On("GetSignaturesForAddressWithOpts", mock.Anything, mock.Anything, isLive(solana.Signature{})).Return(live, nil).Once()
+	cr.On("GetTransaction", mock.Anything, live[0].Signature, mock.Anything).Return(&rpcResponse, nil).Once()
+	cr.On("GetTransaction", mock.Anything, live[1].Signature, mock.Anything).Return(nil, fmt.Errorf("fail")).Once()
+	_, err = source.Fetch(ctx)
+	require.Error(t, err)
+
+	checkpoint, err := store.Load(key)
+	require.NoError(t, err)
+	assert.Equal(t, Checkpoint{}, checkpoint)
+
+	// the live signatures are fetched again
+	cr.On("GetSignaturesForAddressWithOpts", mock.Anything, mock.Anything, isLive(solana.Signature{})).Return(live, nil).Once()
+	cr.On("GetTransaction", mock.Anything, mock.Anything, mock.Anything).Return(&rpcResponse, nil).Twice()
+	cr.On("GetState", mock.Anything, mock.Anything, mock.Anything).Return(pkgSolana.State{}, uint64(0), nil).Once()
+	res, err := source.Fetch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(testutils.ParseTxDetails(t, res)))
+
+	checkpoint, err = store.Load(key)
+	require.NoError(t, err)
+	assert.Equal(t, live[0].Signature, checkpoint.Latest)
+
+	// next fetch resumes after the processed signatures
+	cr.On("GetSignaturesForAddressWithOpts", mock.Anything, mock.Anything, isLive(live[0].Signature)).Return([]*rpc.TransactionSignature{}, nil).Once()
+	res, err = source.Fetch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(testutils.ParseTxDetails(t, res)))
+}
@@ -13,6 +13,7 @@ import (
 type ChainReader interface {
 	GetState(ctx context.Context, account solana.PublicKey, commitment rpc.CommitmentType) (state pkgSolana.State, blockHeight uint64, err error)
 	GetLatestTransmission(ctx context.Context, account solana.PublicKey, commitment rpc.CommitmentType) (answer pkgSolana.Answer, blockHeight uint64, err error)
+	GetTransmissionsHeader(ctx context.Context, account solana.PublicKey, commitment rpc.CommitmentType) (header pkgSolana.TransmissionsHeader, blockHeight uint64, err error)
 
 	GetTokenAccountBalance(ctx context.Context, account solana.PublicKey, commitment rpc.CommitmentType) (out *rpc.GetTokenAccountBalanceResult, err error)
 	GetBalance(ctx context.Context, account solana.PublicKey, commitment rpc.CommitmentType) (out *rpc.GetBalanceResult, err error)
@@ -38,6 +39,10 @@ func (c *chainReader) GetLatestTransmission(ctx context.Context, account solana.
 	return pkgSolana.GetLatestTransmission(ctx, c.client, account, commitment)
 }
 
+func (c *chainReader) GetTransmissionsHeader(ctx context.Context, account solana.PublicKey, commitment rpc.CommitmentType) (header pkgSolana.TransmissionsHeader, blockHeight uint64, err error) {
+	return pkgSolana.NewStoreFeedReader(c.client, account, commitment).GetHeader(ctx)
+}
+
 func (c *chainReader) GetTokenAccountBalance(ctx context.Context, account solana.PublicKey, commitment rpc.CommitmentType) (out *rpc.GetTokenAccountBalanceResult, err error) {
 	return c.client.GetTokenAccountBalance(ctx, account, commitment)
 }
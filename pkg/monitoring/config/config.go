@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"strconv"
 	"time"
 
 	commonMonitoring "github.com/smartcontractkit/chainlink-common/pkg/monitoring"
@@ -16,6 +17,10 @@ type SolanaConfig struct {
 	ChainID      string
 	ReadTimeout  time.Duration
 	PollInterval time.Duration
+
+	// optional: backfill missed transactions, enabled when BackfillDir is set
+	BackfillDir  string  // directory for backfill checkpoints
+	BackfillRate float64 // max backfilled transactions per second
 }
 
 var _ commonMonitoring.ChainConfig = SolanaConfig{}
@@ -75,9 +80,24 @@ func parseEnvVars(cfg *SolanaConfig) error {
 		}
 		cfg.PollInterval = pollInterval
 	}
+	if value, isPresent := os.LookupEnv("SOLANA_BACKFILL_DIR"); isPresent {
+		cfg.BackfillDir = value
+	}
+	if value, isPresent := os.LookupEnv("SOLANA_BACKFILL_RATE"); isPresent {
+		backfillRate, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("failed to parse env var SOLANA_BACKFILL_RATE: %w", err)
+		}
+		cfg.BackfillRate = backfillRate
+	}
 	return nil
 }
 
+// BackfillTxsPerPoll converts the backfill rate into the number of transactions backfilled per source fetch
+func (s SolanaConfig) BackfillTxsPerPoll() int {
+	return max(1, int(s.BackfillRate*s.PollInterval.Seconds()))
+}
+
 func validateConfig(cfg SolanaConfig) error {
 	// Required config
 	for envVarName, currentValue := range map[string]string{
@@ -98,6 +118,9 @@ func validateConfig(cfg SolanaConfig) error {
 			return fmt.Errorf("%s='%s' is not a valid URL: %w", envVarName, currentValue, err)
 		}
 	}
+	if cfg.BackfillRate < 0 {
+		return fmt.Errorf("'SOLANA_BACKFILL_RATE' must be positive, got %v", cfg.BackfillRate)
+	}
 	return nil
 }
 
@@ -108,4 +131,7 @@ func applyDefaults(cfg *SolanaConfig) {
 	if cfg.PollInterval == 0 {
 		cfg.PollInterval = 5 * time.Second
 	}
+	if cfg.BackfillRate == 0 {
+		cfg.BackfillRate = 2
+	}
 }
@@ -0,0 +1,63 @@
+package exporter
+
+import (
+	"context"
+
+	commonMonitoring "github.com/smartcontractkit/chainlink-common/pkg/monitoring"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/monitoring/metrics"
+	"github.com/smartcontractkit/chainlink-solana/pkg/monitoring/types"
+)
+
+func NewFeedFlagFactory(
+	log commonMonitoring.Logger,
+	metrics metrics.FeedFlag,
+) commonMonitoring.ExporterFactory {
+	return &feedFlagFactory{
+		log,
+		metrics,
+	}
+}
+
+type feedFlagFactory struct {
+	log     commonMonitoring.Logger
+	metrics metrics.FeedFlag
+}
+
+func (p *feedFlagFactory) NewExporter(
+	params commonMonitoring.ExporterParams,
+) (commonMonitoring.Exporter, error) {
+	return &feedFlagExporter{
+		metrics.FeedInput{
+			AccountAddress: params.FeedConfig.GetContractAddress(),
+			FeedID:         params.FeedConfig.GetContractAddress(),
+			ChainID:        params.ChainConfig.GetChainID(),
+			ContractStatus: params.FeedConfig.GetContractStatus(),
+			ContractType:   params.FeedConfig.GetContractType(),
+			FeedName:       params.FeedConfig.GetName(),
+			FeedPath:       params.FeedConfig.GetPath(),
+			NetworkID:      params.ChainConfig.GetNetworkID(),
+			NetworkName:    params.ChainConfig.GetNetworkName(),
+		},
+		p.log,
+		p.metrics,
+	}, nil
+}
+
+type feedFlagExporter struct {
+	label   metrics.FeedInput // static for each feed
+	log     commonMonitoring.Logger
+	metrics metrics.FeedFlag
+}
+
+func (f *feedFlagExporter) Export(ctx context.Context, data interface{}) {
+	flag, ok := data.(types.FeedFlag)
+	if !ok {
+		return // skip if input could not be parsed
+	}
+	f.metrics.Set(flag, f.label)
+}
+
+func (f *feedFlagExporter) Cleanup(_ context.Context) {
+	f.metrics.Cleanup(f.label)
+}
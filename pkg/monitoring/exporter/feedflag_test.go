@@ -0,0 +1,38 @@
+package exporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	commonMonitoring "github.com/smartcontractkit/chainlink-common/pkg/monitoring"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/monitoring/metrics/mocks"
+	"github.com/smartcontractkit/chainlink-solana/pkg/monitoring/testutils"
+	"github.com/smartcontractkit/chainlink-solana/pkg/monitoring/types"
+)
+
+func TestFeedFlag(t *testing.T) {
+	ctx := tests.Context(t)
+	m := mocks.NewFeedFlag(t)
+	flag := types.FeedFlag{Flagged: true, FlaggingThreshold: 1000}
+	m.On("Set", flag, mock.Anything).Once()
+	m.On("Cleanup", mock.Anything).Once()
+
+	factory := NewFeedFlagFactory(logger.Test(t), m)
+
+	chainConfig := testutils.GenerateChainConfig()
+	feedConfig := testutils.GenerateFeedConfig()
+	exporter, err := factory.NewExporter(commonMonitoring.ExporterParams{ChainConfig: chainConfig, FeedConfig: feedConfig})
+	require.NoError(t, err)
+
+	// happy path
+	exporter.Export(ctx, flag)
+	exporter.Cleanup(ctx)
+
+	// not a FeedFlag - should not call mock
+	exporter.Export(ctx, 1)
+}
@@ -0,0 +1,137 @@
+package exporter
+
+import (
+	"context"
+
+	"github.com/gagliardetto/solana-go"
+	commonMonitoring "github.com/smartcontractkit/chainlink-common/pkg/monitoring"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/monitoring/config"
+	"github.com/smartcontractkit/chainlink-solana/pkg/monitoring/metrics"
+	"github.com/smartcontractkit/chainlink-solana/pkg/monitoring/types"
+)
+
+func NewOracleParticipationFactory(
+	log commonMonitoring.Logger,
+	metrics metrics.OracleParticipation,
+) commonMonitoring.ExporterFactory {
+	return &oracleParticipationFactory{
+		log,
+		metrics,
+	}
+}
+
+type oracleParticipationFactory struct {
+	log     commonMonitoring.Logger
+	metrics metrics.OracleParticipation
+}
+
+func (p *oracleParticipationFactory) NewExporter(
+	params commonMonitoring.ExporterParams,
+) (commonMonitoring.Exporter, error) {
+	nodes, err := config.MakeSolanaNodeConfigs(params.Nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	nodesMap := map[solana.PublicKey]string{}
+	for _, v := range nodes {
+		pubkey, err := v.PublicKey()
+		if err != nil {
+			return nil, err
+		}
+		nodesMap[pubkey] = v.GetName()
+	}
+
+	return &oracleParticipation{
+		metrics.FeedInput{
+			AccountAddress: params.FeedConfig.GetContractAddress(),
+			FeedID:         params.FeedConfig.GetContractAddress(),
+			ChainID:        params.ChainConfig.GetChainID(),
+			ContractStatus: params.FeedConfig.GetContractStatus(),
+			ContractType:   params.FeedConfig.GetContractType(),
+			FeedName:       params.FeedConfig.GetName(),
+			FeedPath:       params.FeedConfig.GetPath(),
+			NetworkID:      params.ChainConfig.GetNetworkID(),
+			NetworkName:    params.ChainConfig.GetNetworkName(),
+		},
+		nodesMap,
+		p.log,
+		p.metrics,
+	}, nil
+}
+
+type oracleParticipation struct {
+	feedLabel metrics.FeedInput // static for each feed
+	nodes     map[solana.PublicKey]string
+	log       commonMonitoring.Logger
+	metrics   metrics.OracleParticipation
+}
+
+// Export calculates per oracle metrics from the reports included in the batch of TxDetails
+// - participation rate: fraction of reports that include the oracle as an observer
+// - missed rounds: number of reports that do not include the oracle as an observer
+// - transmit latency: average time between the report observations timestamp and block time for reports sent by the oracle
+func (p *oracleParticipation) Export(ctx context.Context, data interface{}) {
+	details, err := types.MakeTxDetails(data)
+	if err != nil {
+		return // skip if input could not be parsed
+	}
+
+	// skip on no updates
+	if len(details) == 0 {
+		return
+	}
+
+	var rounds int
+	observed := map[solana.PublicKey]int{}
+	latency := map[solana.PublicKey]int64{}
+	transmitted := map[solana.PublicKey]int64{}
+	for _, d := range details {
+		if d.BlockTime != 0 && d.ObservationsTimestamp != 0 {
+			latency[d.Sender] += d.BlockTime - int64(d.ObservationsTimestamp)
+			transmitted[d.Sender]++
+		}
+
+		// observers can only be attributed if they were mapped to the on-chain oracles
+		if len(d.ObserverTransmitters) == 0 {
+			continue
+		}
+		rounds++
+		for _, transmitter := range d.ObserverTransmitters {
+			observed[transmitter]++
+		}
+	}
+
+	for k, v := range p.nodes {
+		input := metrics.NodeFeedInput{
+			NodeAddress:  k.String(),
+			NodeOperator: v,
+			FeedInput:    p.feedLabel,
+		}
+
+		if count := transmitted[k]; count > 0 {
+			p.metrics.SetTransmitLatency(float64(latency[k])/float64(count), input)
+		}
+
+		if rounds == 0 {
+			continue
+		}
+		p.metrics.SetParticipationRate(float64(observed[k])/float64(rounds), input)
+		p.metrics.AddMissedRounds(rounds-observed[k], input)
+	}
+
+	if rounds == 0 {
+		p.log.Debugw("no reports with mapped observers", "feed", p.feedLabel.ToPromLabels())
+	}
+}
+
+func (p *oracleParticipation) Cleanup(_ context.Context) {
+	for k, v := range p.nodes {
+		p.metrics.Cleanup(metrics.NodeFeedInput{
+			NodeAddress:  k.String(),
+			NodeOperator: v,
+			FeedInput:    p.feedLabel,
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package exporter
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	commonMonitoring "github.com/smartcontractkit/chainlink-common/pkg/monitoring"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/monitoring/config"
+	"github.com/smartcontractkit/chainlink-solana/pkg/monitoring/metrics"
+	"github.com/smartcontractkit/chainlink-solana/pkg/monitoring/metrics/mocks"
+	"github.com/smartcontractkit/chainlink-solana/pkg/monitoring/testutils"
+	"github.com/smartcontractkit/chainlink-solana/pkg/monitoring/types"
+)
+
+func TestOracleParticipation(t *testing.T) {
+	ctx := tests.Context(t)
+	lgr, logs := logger.TestObserved(t, zapcore.DebugLevel)
+	m := mocks.NewOracleParticipation(t)
+
+	factory := NewOracleParticipationFactory(lgr, m)
+
+	nodeA, nodeB := solana.PublicKey{1}, solana.PublicKey{2}
+	chainConfig := testutils.GenerateChainConfig()
+	feedConfig := testutils.GenerateFeedConfig()
+	exporter, err := factory.NewExporter(commonMonitoring.ExporterParams{ChainConfig: chainConfig,
+		FeedConfig: feedConfig,
+		Nodes: []commonMonitoring.NodeConfig{
+			config.SolanaNodeConfig{ID: "a", NodeAddress: []string{nodeA.String()}},
+			config.SolanaNodeConfig{ID: "b", NodeAddress: []string{nodeB.String()}},
+		}})
+	require.NoError(t, err)
+
+	isNode := func(name string) interface{} {
+		return mock.MatchedBy(func(i metrics.NodeFeedInput) bool { return i.NodeOperator == name })
+	}
+
+	// node A observes both rounds, node B only observes one round
+	// node A transmits one report 2 seconds after the observations timestamp
+	m.On("SetParticipationRate", float64(1), isNode("a")).Once()
+	m.On("SetParticipationRate", 0.5, isNode("b")).Once()
+	m.On("AddMissedRounds", 0, isNode("a")).Once()
+	m.On("AddMissedRounds", 1, isNode("b")).Once()
+	m.On("SetTransmitLatency", float64(2), isNode("a")).Once()
+	exporter.Export(ctx, []types.TxDetails{
+		{Sender: nodeA, BlockTime: 12, ObservationsTimestamp: 10, Median: big.NewInt(100),
+			ObserverTransmitters: []solana.PublicKey{nodeA, {3}, nodeB}},
+		{Sender: solana.PublicKey{3}, Median: big.NewInt(100),
+			ObserverTransmitters: []solana.PublicKey{{3}, nodeA}},
+	})
+
+	// later batches only count their own rounds
+	m.On("SetParticipationRate", float64(1), isNode("a")).Once()
+	m.On("SetParticipationRate", float64(0), isNode("b")).Once()
+	m.On("AddMissedRounds", 0, isNode("a")).Once()
+	m.On("AddMissedRounds", 1, isNode("b")).Once()
+	exporter.Export(ctx, []types.TxDetails{
+		{Sender: solana.PublicKey{3}, Median: big.NewInt(100), ObserverTransmitters: []solana.PublicKey{{3}, nodeA}},
+	})
+
+	// unmapped observers - only latency is reported
+	m.On("SetTransmitLatency", float64(1), isNode("b")).Once()
+	exporter.Export(ctx, []types.TxDetails{
+		{Sender: nodeB, BlockTime: 11, ObservationsTimestamp: 10},
+	})
+	assert.Equal(t, 1, logs.FilterMessage("no reports with mapped observers").Len())
+
+	// not txdetails type - no calls to mock
+	assert.NotPanics(t, func() { exporter.Export(ctx, 1) })
+
+	// zero txdetails - no calls to mock
+	exporter.Export(ctx, []types.TxDetails{})
+
+	m.On("Cleanup", mock.Anything).Twice()
+	exporter.Cleanup(ctx)
+}
@@ -47,3 +47,40 @@ func (sg simpleGauge) delete(labels prometheus.Labels) {
 func (sg simpleGauge) add(value float64, labels prometheus.Labels) {
 	sg.run(func(g *prometheus.GaugeVec) { g.With(labels).Add(value) })
 }
+
+// simpleCounter is the counter equivalent of simpleGauge, fetching the counter from the counters map
+type simpleCounter struct {
+	log        commonMonitoring.Logger
+	metricName string
+}
+
+func newSimpleCounter(log commonMonitoring.Logger, name string) simpleCounter {
+	if log == nil {
+		panic("simpleCounter.logger is nil")
+	}
+	return simpleCounter{log, name}
+}
+
+func (sc simpleCounter) run(
+	f func(*prometheus.CounterVec),
+) {
+	if counters == nil {
+		sc.log.Fatalw("counters is nil")
+		return
+	}
+
+	counter, ok := counters[sc.metricName]
+	if !ok || counter == nil {
+		sc.log.Errorw("counter not found", "name", sc.metricName)
+		return
+	}
+	f(counter)
+}
+
+func (sc simpleCounter) add(value float64, labels prometheus.Labels) {
+	sc.run(func(c *prometheus.CounterVec) { c.With(labels).Add(value) })
+}
+
+func (sc simpleCounter) delete(labels prometheus.Labels) {
+	sc.run(func(c *prometheus.CounterVec) { c.Delete(labels) })
+}
@@ -0,0 +1,42 @@
+package metrics
+
+import (
+	commonMonitoring "github.com/smartcontractkit/chainlink-common/pkg/monitoring"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/monitoring/types"
+)
+
+//go:generate mockery --name FeedFlag --output ./mocks/
+
+type FeedFlag interface {
+	Set(flag types.FeedFlag, feedInput FeedInput)
+	Cleanup(feedInput FeedInput)
+}
+
+var _ FeedFlag = (*feedFlag)(nil)
+
+type feedFlag struct {
+	flagged           simpleGauge
+	flaggingThreshold simpleGauge
+}
+
+func NewFeedFlag(log commonMonitoring.Logger) *feedFlag {
+	return &feedFlag{
+		flagged:           newSimpleGauge(log, types.FeedFlaggedMetric),
+		flaggingThreshold: newSimpleGauge(log, types.FeedFlaggingThresholdMetric),
+	}
+}
+
+func (ff *feedFlag) Set(flag types.FeedFlag, feedInput FeedInput) {
+	var flagged float64
+	if flag.Flagged {
+		flagged = 1
+	}
+	ff.flagged.set(flagged, feedInput.ToPromLabels())
+	ff.flaggingThreshold.set(float64(flag.FlaggingThreshold), feedInput.ToPromLabels())
+}
+
+func (ff *feedFlag) Cleanup(feedInput FeedInput) {
+	ff.flagged.delete(feedInput.ToPromLabels())
+	ff.flaggingThreshold.delete(feedInput.ToPromLabels())
+}
@@ -0,0 +1,43 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/monitoring/types"
+)
+
+func TestFeedFlag(t *testing.T) {
+	lgr := logger.Test(t)
+	m := NewFeedFlag(lgr)
+
+	// fetching gauges
+	gFlagged, ok := gauges[types.FeedFlaggedMetric]
+	require.True(t, ok)
+	gThreshold, ok := gauges[types.FeedFlaggingThresholdMetric]
+	require.True(t, ok)
+
+	l := FeedInput{NetworkID: t.Name()}
+
+	// set gauge
+	assert.NotPanics(t, func() {
+		m.Set(types.FeedFlag{Flagged: true, FlaggingThreshold: 1000}, l)
+	})
+	assert.Equal(t, float64(1), testutil.ToFloat64(gFlagged.With(l.ToPromLabels())))
+	assert.Equal(t, float64(1000), testutil.ToFloat64(gThreshold.With(l.ToPromLabels())))
+
+	m.Set(types.FeedFlag{FlaggingThreshold: 1000}, l)
+	assert.Equal(t, float64(0), testutil.ToFloat64(gFlagged.With(l.ToPromLabels())))
+
+	// cleanup gauges
+	assert.Equal(t, 1, testutil.CollectAndCount(gFlagged))
+	assert.Equal(t, 1, testutil.CollectAndCount(gThreshold))
+	assert.NotPanics(t, func() { m.Cleanup(l) })
+	assert.Equal(t, 0, testutil.CollectAndCount(gFlagged))
+	assert.Equal(t, 0, testutil.CollectAndCount(gThreshold))
+}
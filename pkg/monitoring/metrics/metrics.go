@@ -35,7 +35,10 @@ var (
 	}
 )
 
-var gauges map[string]*prometheus.GaugeVec
+var (
+	gauges   map[string]*prometheus.GaugeVec
+	counters map[string]*prometheus.CounterVec
+)
 
 func makeBalanceMetricName(balanceAccountName string) string {
 	return fmt.Sprintf("sol_balance_%s", balanceAccountName)
@@ -43,6 +46,7 @@ func makeBalanceMetricName(balanceAccountName string) string {
 
 func init() {
 	gauges = map[string]*prometheus.GaugeVec{}
+	counters = map[string]*prometheus.CounterVec{}
 
 	// initialize gauges for data feed accounts (state, transmissions, access controllers, etc)
 	for _, balanceAccountName := range types.FeedBalanceAccountNames {
@@ -80,6 +84,34 @@ func init() {
 		nodeFeedLabels,
 	)
 
+	// init gauges for oracle participation per feed per node
+	for _, oracleMetric := range types.OracleParticipationMetrics {
+		gauges[oracleMetric] = promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: oracleMetric,
+			},
+			nodeFeedLabels,
+		)
+	}
+
+	// missed rounds only increase, a counter keeps rate() and increase() correct across restarts
+	counters[types.OracleMissedRoundsMetric] = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: types.OracleMissedRoundsMetric,
+		},
+		nodeFeedLabels,
+	)
+
+	// init gauges for store program feed flag per feed
+	for _, feedFlagMetric := range []string{types.FeedFlaggedMetric, types.FeedFlaggingThresholdMetric} {
+		gauges[feedFlagMetric] = promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: feedFlagMetric,
+			},
+			feedLabels,
+		)
+	}
+
 	// init gauge for slot height
 	gauges[types.SlotHeightMetric] = promauto.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -0,0 +1,50 @@
+package metrics
+
+import (
+	commonMonitoring "github.com/smartcontractkit/chainlink-common/pkg/monitoring"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/monitoring/types"
+)
+
+//go:generate mockery --name OracleParticipation --output ./mocks/
+
+type OracleParticipation interface {
+	SetParticipationRate(rate float64, i NodeFeedInput)
+	AddMissedRounds(count int, i NodeFeedInput)
+	SetTransmitLatency(seconds float64, i NodeFeedInput)
+	Cleanup(i NodeFeedInput)
+}
+
+var _ OracleParticipation = (*oracleParticipation)(nil)
+
+type oracleParticipation struct {
+	rate         simpleGauge
+	missedRounds simpleCounter
+	latency      simpleGauge
+}
+
+func NewOracleParticipation(log commonMonitoring.Logger) *oracleParticipation {
+	return &oracleParticipation{
+		rate:         newSimpleGauge(log, types.OracleParticipationRateMetric),
+		missedRounds: newSimpleCounter(log, types.OracleMissedRoundsMetric),
+		latency:      newSimpleGauge(log, types.OracleTransmitLatencyMetric),
+	}
+}
+
+func (op *oracleParticipation) SetParticipationRate(rate float64, i NodeFeedInput) {
+	op.rate.set(rate, i.ToPromLabels())
+}
+
+func (op *oracleParticipation) AddMissedRounds(count int, i NodeFeedInput) {
+	op.missedRounds.add(float64(count), i.ToPromLabels())
+}
+
+func (op *oracleParticipation) SetTransmitLatency(seconds float64, i NodeFeedInput) {
+	op.latency.set(seconds, i.ToPromLabels())
+}
+
+func (op *oracleParticipation) Cleanup(i NodeFeedInput) {
+	op.rate.delete(i.ToPromLabels())
+	op.missedRounds.delete(i.ToPromLabels())
+	op.latency.delete(i.ToPromLabels())
+}
@@ -0,0 +1,45 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/monitoring/types"
+)
+
+func TestOracleParticipation(t *testing.T) {
+	lgr := logger.Test(t)
+	m := NewOracleParticipation(lgr)
+
+	// fetching gauges and counters
+	gRate, ok := gauges[types.OracleParticipationRateMetric]
+	require.True(t, ok)
+	cMissed, ok := counters[types.OracleMissedRoundsMetric]
+	require.True(t, ok)
+	gLatency, ok := gauges[types.OracleTransmitLatencyMetric]
+	require.True(t, ok)
+
+	l := NodeFeedInput{NodeAddress: t.Name()}
+
+	// set gauges and counters
+	assert.NotPanics(t, func() {
+		m.SetParticipationRate(0.5, l)
+		m.AddMissedRounds(2, l)
+		m.AddMissedRounds(3, l)
+		m.SetTransmitLatency(4, l)
+	})
+	assert.Equal(t, 0.5, testutil.ToFloat64(gRate.With(l.ToPromLabels())))
+	assert.Equal(t, float64(5), testutil.ToFloat64(cMissed.With(l.ToPromLabels())))
+	assert.Equal(t, float64(4), testutil.ToFloat64(gLatency.With(l.ToPromLabels())))
+
+	// cleanup gauges and counters
+	assert.NotPanics(t, func() { m.Cleanup(l) })
+	assert.Equal(t, 0, testutil.CollectAndCount(gRate))
+	assert.Equal(t, 0, testutil.CollectAndCount(cMissed))
+	assert.Equal(t, 0, testutil.CollectAndCount(gLatency))
+}
@@ -267,6 +267,41 @@ func (_m *ChainReader) GetTransaction(ctx context.Context, txSig solana.Signatur
 	return r0, r1
 }
 
+// GetTransmissionsHeader provides a mock function with given fields: ctx, account, commitment
+func (_m *ChainReader) GetTransmissionsHeader(ctx context.Context, account solana.PublicKey, commitment rpc.CommitmentType) (pkgsolana.TransmissionsHeader, uint64, error) {
+	ret := _m.Called(ctx, account, commitment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransmissionsHeader")
+	}
+
+	var r0 pkgsolana.TransmissionsHeader
+	var r1 uint64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, solana.PublicKey, rpc.CommitmentType) (pkgsolana.TransmissionsHeader, uint64, error)); ok {
+		return rf(ctx, account, commitment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, solana.PublicKey, rpc.CommitmentType) pkgsolana.TransmissionsHeader); ok {
+		r0 = rf(ctx, account, commitment)
+	} else {
+		r0 = ret.Get(0).(pkgsolana.TransmissionsHeader)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, solana.PublicKey, rpc.CommitmentType) uint64); ok {
+		r1 = rf(ctx, account, commitment)
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, solana.PublicKey, rpc.CommitmentType) error); ok {
+		r2 = rf(ctx, account, commitment)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // NewChainReader creates a new instance of ChainReader. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewChainReader(t interface {
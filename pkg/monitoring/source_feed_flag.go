@@ -0,0 +1,63 @@
+package monitoring
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gagliardetto/solana-go/rpc"
+
+	commonMonitoring "github.com/smartcontractkit/chainlink-common/pkg/monitoring"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/monitoring/config"
+	"github.com/smartcontractkit/chainlink-solana/pkg/monitoring/types"
+	pkgSolana "github.com/smartcontractkit/chainlink-solana/pkg/solana"
+)
+
+func NewFeedFlagSourceFactory(
+	client ChainReader,
+	log commonMonitoring.Logger,
+) commonMonitoring.SourceFactory {
+	return &feedFlagSourceFactory{
+		client,
+		log,
+	}
+}
+
+type feedFlagSourceFactory struct {
+	client ChainReader
+	log    commonMonitoring.Logger
+}
+
+func (s *feedFlagSourceFactory) NewSource(
+	_ commonMonitoring.ChainConfig,
+	feedConfig commonMonitoring.FeedConfig,
+) (commonMonitoring.Source, error) {
+	solanaFeedConfig, ok := feedConfig.(config.SolanaFeedConfig)
+	if !ok {
+		return nil, fmt.Errorf("expected feedConfig to be of type config.SolanaFeedConfig not %T", feedConfig)
+	}
+	return &feedFlagSource{
+		client:     s.client,
+		feedConfig: solanaFeedConfig,
+	}, nil
+}
+
+func (s *feedFlagSourceFactory) GetType() string {
+	return types.FeedFlagType
+}
+
+type feedFlagSource struct {
+	client     ChainReader
+	feedConfig config.SolanaFeedConfig
+}
+
+func (s *feedFlagSource) Fetch(ctx context.Context) (interface{}, error) {
+	header, _, err := s.client.GetTransmissionsHeader(ctx, s.feedConfig.TransmissionsAccount, rpc.CommitmentConfirmed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transmissions header: %w", err)
+	}
+	return types.FeedFlag{
+		Flagged:           header.State == pkgSolana.FeedStateFlagged,
+		FlaggingThreshold: header.FlaggingThreshold,
+	}, nil
+}
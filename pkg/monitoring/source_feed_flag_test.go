@@ -0,0 +1,47 @@
+package monitoring
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/monitoring/mocks"
+	"github.com/smartcontractkit/chainlink-solana/pkg/monitoring/testutils"
+	"github.com/smartcontractkit/chainlink-solana/pkg/monitoring/types"
+	pkgSolana "github.com/smartcontractkit/chainlink-solana/pkg/solana"
+)
+
+func TestFeedFlagSource(t *testing.T) {
+	cr := mocks.NewChainReader(t)
+	lgr := logger.Test(t)
+	ctx := tests.Context(t)
+
+	factory := NewFeedFlagSourceFactory(cr, lgr)
+	assert.Equal(t, types.FeedFlagType, factory.GetType())
+
+	feedConfig := testutils.GenerateFeedConfig()
+	source, err := factory.NewSource(nil, feedConfig)
+	require.NoError(t, err)
+
+	// happy path
+	cr.On("GetTransmissionsHeader", mock.Anything, feedConfig.TransmissionsAccount, mock.Anything).Return(pkgSolana.TransmissionsHeader{
+		State:             pkgSolana.FeedStateFlagged,
+		FlaggingThreshold: 1000,
+	}, uint64(1), nil).Once()
+	out, err := source.Fetch(ctx)
+	require.NoError(t, err)
+	flag, ok := out.(types.FeedFlag)
+	require.True(t, ok)
+	assert.Equal(t, types.FeedFlag{Flagged: true, FlaggingThreshold: 1000}, flag)
+
+	// rpc error
+	cr.On("GetTransmissionsHeader", mock.Anything, mock.Anything, mock.Anything).Return(pkgSolana.TransmissionsHeader{}, uint64(0), errors.New("fail")).Once()
+	_, err = source.Fetch(ctx)
+	require.Error(t, err)
+}
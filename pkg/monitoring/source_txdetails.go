@@ -12,12 +12,19 @@ import (
 )
 
 func NewTxDetailsSourceFactory(client ChainReader, log commonMonitoring.Logger) commonMonitoring.SourceFactory {
-	return &txDetailsSourceFactory{client, log}
+	return &txDetailsSourceFactory{client: client, log: log}
+}
+
+// NewTxDetailsSourceFactoryWithBackfill includes missed transactions (restarts, RPC gaps) in the source results
+// backfilled transactions are parsed and exported the same as live transactions
+func NewTxDetailsSourceFactoryWithBackfill(client ChainReader, log commonMonitoring.Logger, backfill BackfillConfig) commonMonitoring.SourceFactory {
+	return &txDetailsSourceFactory{client: client, log: log, backfill: &backfill}
 }
 
 type txDetailsSourceFactory struct {
-	client ChainReader
-	log    commonMonitoring.Logger
+	client   ChainReader
+	log      commonMonitoring.Logger
+	backfill *BackfillConfig
 }
 
 func (f *txDetailsSourceFactory) NewSource(_ commonMonitoring.ChainConfig, feedConfig commonMonitoring.FeedConfig) (commonMonitoring.Source, error) {
@@ -27,11 +34,7 @@ func (f *txDetailsSourceFactory) NewSource(_ commonMonitoring.ChainConfig, feedC
 	}
 
 	return &txDetailsSource{
-		source: &txResultsSource{
-			client:     f.client,
-			log:        f.log,
-			feedConfig: solanaFeedConfig,
-		},
+		source: newTxResultsSource(f.client, f.log, solanaFeedConfig, types.TxDetailsType, f.backfill),
 	}, nil
 }
 
@@ -44,11 +47,42 @@ type txDetailsSource struct {
 }
 
 func (s *txDetailsSource) Fetch(ctx context.Context) (interface{}, error) {
-	_, sigs, err := s.source.fetch(ctx)
+	live, err := s.source.fetchLive(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the source only moves past the live signatures once all live transactions are fetched
+	// otherwise they are fetched again on the next run
+	details, err := s.details(ctx, live.sigs)
 	if err != nil {
 		return nil, err
 	}
+	s.source.commitLive(live)
+
+	// the backfill checkpoint is only advanced once all backfilled transactions are fetched
+	// otherwise the batch is fetched again on the next run
+	if batch := s.source.fetchBackfill(ctx); batch != nil {
+		backfillDetails, err := s.details(ctx, batch.sigs)
+		if err != nil {
+			s.source.log.Warnw("failed to fetch backfilled transactions, retrying on next fetch", "error", err)
+		} else {
+			details = append(details, backfillDetails...)
+			s.source.commitBackfill(batch)
+		}
+	}
+
+	// resolve report observers to oracle transmitters using the current on-chain config
+	if len(details) > 0 {
+		s.mapObservers(ctx, details)
+	}
 
+	// only return successful OCR2 transmit transactions (slice/array)
+	return details, nil
+}
+
+// details fetches and parses the transactions of the signatures, it fails on the first GetTransaction error
+func (s *txDetailsSource) details(ctx context.Context, sigs []*rpc.TransactionSignature) ([]types.TxDetails, error) {
 	details := []types.TxDetails{}
 	for _, sig := range sigs {
 		if sig == nil {
@@ -80,7 +114,20 @@ func (s *txDetailsSource) Fetch(ctx context.Context) (interface{}, error) {
 		}
 		details = append(details, res)
 	}
-
-	// only return successful OCR2 transmit transactions (slice/array)
 	return details, nil
 }
+
+// mapObservers populates ObserverTransmitters for details generated by the current on-chain config
+// failures are logged and do not prevent the details from being exported
+func (s *txDetailsSource) mapObservers(ctx context.Context, details []types.TxDetails) {
+	state, _, err := s.source.client.GetState(ctx, s.source.feedConfig.StateAccount, rpc.CommitmentConfirmed)
+	if err != nil {
+		s.source.log.Warnw("failed to fetch state for mapping report observers", "error", err)
+		return
+	}
+	for i := range details {
+		if err := details[i].MapObservers(state); err != nil {
+			s.source.log.Debugw("unable to map report observers", "error", err, "slot", details[i].Slot)
+		}
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/smartcontractkit/chainlink-solana/pkg/monitoring/mocks"
 	"github.com/smartcontractkit/chainlink-solana/pkg/monitoring/testutils"
 	"github.com/smartcontractkit/chainlink-solana/pkg/monitoring/types"
+	pkgSolana "github.com/smartcontractkit/chainlink-solana/pkg/solana"
 )
 
 func TestTxDetailsSource(t *testing.T) {
@@ -76,6 +77,7 @@ func TestTxDetailsSource(t *testing.T) {
 		{},
 	}, nil).Once()
 	cr.On("GetTransaction", mock.Anything, mock.Anything, mock.Anything).Return(&rpcResponse, nil).Once()
+	cr.On("GetState", mock.Anything, mock.Anything, mock.Anything).Return(pkgSolana.State{}, uint64(0), nil).Once()
 	res, err = s.Fetch(tests.Context(t))
 	require.NoError(t, err)
 	data = testutils.ParseTxDetails(t, res)
@@ -85,4 +87,27 @@ func TestTxDetailsSource(t *testing.T) {
 	assert.NotZero(t, data[0].ObservationCount)
 	assert.NotZero(t, data[0].Fee)
 	assert.NotZero(t, data[0].Slot)
+	assert.Nil(t, data[0].ObserverTransmitters) // config digest does not match state
+	assert.Equal(t, 1, logs.FilterLevelExact(zapcore.DebugLevel).FilterMessage("unable to map report observers").Len())
+
+	// happy path - observers mapped to transmitters
+	state := pkgSolana.State{}
+	state.Config.LatestConfigDigest = data[0].ConfigDigest
+	state.Oracles.Len = pkgSolana.MaxOracles
+	for i := range state.Oracles.Raw {
+		state.Oracles.Raw[i].Transmitter = solana.PublicKey{byte(i + 1)}
+	}
+	cr.On("GetSignaturesForAddressWithOpts", mock.Anything, mock.Anything, mock.Anything).Return([]*rpc.TransactionSignature{
+		{},
+	}, nil).Once()
+	cr.On("GetTransaction", mock.Anything, mock.Anything, mock.Anything).Return(&rpcResponse, nil).Once()
+	cr.On("GetState", mock.Anything, mock.Anything, mock.Anything).Return(state, uint64(0), nil).Once()
+	res, err = s.Fetch(tests.Context(t))
+	require.NoError(t, err)
+	data = testutils.ParseTxDetails(t, res)
+	require.Equal(t, 1, len(data))
+	require.Equal(t, len(data[0].Observers), len(data[0].ObserverTransmitters))
+	for i, o := range data[0].Observers {
+		assert.Equal(t, state.Oracles.Raw[o].Transmitter, data[0].ObserverTransmitters[i])
+	}
 }
@@ -22,14 +22,28 @@ func NewTxResultsSourceFactory(
 	log commonMonitoring.Logger,
 ) commonMonitoring.SourceFactory {
 	return &txResultsSourceFactory{
-		client,
-		log,
+		client: client,
+		log:    log,
+	}
+}
+
+// NewTxResultsSourceFactoryWithBackfill includes missed transactions (restarts, RPC gaps) in the source results
+func NewTxResultsSourceFactoryWithBackfill(
+	client ChainReader,
+	log commonMonitoring.Logger,
+	backfill BackfillConfig,
+) commonMonitoring.SourceFactory {
+	return &txResultsSourceFactory{
+		client:   client,
+		log:      log,
+		backfill: &backfill,
 	}
 }
 
 type txResultsSourceFactory struct {
-	client ChainReader
-	log    commonMonitoring.Logger
+	client   ChainReader
+	log      commonMonitoring.Logger
+	backfill *BackfillConfig
 }
 
 func (s *txResultsSourceFactory) NewSource(
@@ -40,64 +54,134 @@ func (s *txResultsSourceFactory) NewSource(
 	if !ok {
 		return nil, fmt.Errorf("expected feedConfig to be of type config.SolanaFeedConfig not %T", feedConfig)
 	}
-	return &txResultsSource{
-		s.client,
-		s.log,
-		solanaFeedConfig,
-		solana.Signature{},
-		sync.Mutex{},
-	}, nil
+	return newTxResultsSource(s.client, s.log, solanaFeedConfig, txresultsType, s.backfill), nil
 }
 
 func (s *txResultsSourceFactory) GetType() string {
 	return txresultsType
 }
 
+// newTxResultsSource creates the source, sourceType is used to separate backfill progress for sources of the same feed
+func newTxResultsSource(
+	client ChainReader,
+	log commonMonitoring.Logger,
+	feedConfig config.SolanaFeedConfig,
+	sourceType string,
+	backfill *BackfillConfig,
+) *txResultsSource {
+	source := &txResultsSource{
+		client:     client,
+		log:        log,
+		feedConfig: feedConfig,
+	}
+	if backfill != nil {
+		key := fmt.Sprintf("%s-%s", sourceType, feedConfig.StateAccount)
+		source.backfill = newBackfiller(key, feedConfig.StateAccount, client, *backfill, log)
+		// resume from the last signature processed before restart, missed signatures are backfilled
+		source.latestSig = source.backfill.latest()
+	}
+	return source
+}
+
 type txResultsSource struct {
 	client     ChainReader
 	log        commonMonitoring.Logger
 	feedConfig config.SolanaFeedConfig
+	backfill   *backfiller // optional
 
 	latestSig   solana.Signature
 	latestSigMu sync.Mutex
 }
 
 // Fetch is the externally called method that returns the specific TxResults output
+// if backfill is enabled, missed signatures are counted together with the latest signatures
 func (t *txResultsSource) Fetch(ctx context.Context) (interface{}, error) {
-	out, _, err := t.fetch(ctx)
-	return out, err
+	live, err := t.fetchLive(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// the results only count the signatures, so the signatures are processed once counted
+	t.commitLive(live)
+
+	batch := t.fetchBackfill(ctx)
+	txSigs := live.sigs
+	if batch != nil {
+		txSigs = append(txSigs, batch.sigs...)
+	}
+
+	var numSucceeded, numFailed uint64 = 0, 0
+	for _, txSig := range txSigs {
+		if txSig.Err == nil {
+			numSucceeded++
+		} else {
+			numFailed++
+		}
+	}
+	t.commitBackfill(batch)
+	return commonMonitoring.TxResults{NumSucceeded: numSucceeded, NumFailed: numFailed}, nil
 }
 
-// fetch is the internal method that returns data from the GetSignaturesForAddress RPC call
-func (t *txResultsSource) fetch(ctx context.Context) (commonMonitoring.TxResults, []*rpc.TransactionSignature, error) {
+// liveBatch is a page of the latest signatures (newest first) fetched with `until` as the lower bound
+// the source only moves past the signatures once the batch is committed, otherwise they are fetched again
+type liveBatch struct {
+	until    solana.Signature
+	sigs     []*rpc.TransactionSignature
+	pageSize int
+}
+
+// fetchLive returns the signatures since the latest committed signature from the GetSignaturesForAddress RPC call
+func (t *txResultsSource) fetchLive(ctx context.Context) (*liveBatch, error) {
 	txSigsPageSize := 100
+	t.latestSigMu.Lock()
+	until := t.latestSig
+	t.latestSigMu.Unlock()
 	txSigs, err := t.client.GetSignaturesForAddressWithOpts(
 		ctx,
 		t.feedConfig.StateAccount,
 		&rpc.GetSignaturesForAddressOpts{
 			Commitment: rpc.CommitmentConfirmed,
-			Until:      t.latestSig,
+			Until:      until,
 			Limit:      &txSigsPageSize,
 		},
 	)
 	if err != nil {
-		return commonMonitoring.TxResults{}, nil, fmt.Errorf("failed to fetch transactions for state account: %w", err)
+		return nil, fmt.Errorf("failed to fetch transactions for state account: %w", err)
 	}
-	if len(txSigs) == 0 {
-		return commonMonitoring.TxResults{NumSucceeded: 0, NumFailed: 0}, nil, nil
+	return &liveBatch{until: until, sigs: txSigs, pageSize: txSigsPageSize}, nil
+}
+
+// commitLive moves the source past a processed live batch, and records skipped signatures for backfill
+func (t *txResultsSource) commitLive(batch *liveBatch) {
+	if len(batch.sigs) == 0 {
+		return
 	}
-	var numSucceeded, numFailed uint64 = 0, 0
-	for _, txSig := range txSigs {
-		if txSig.Err == nil {
-			numSucceeded++
-		} else {
-			numFailed++
-		}
+
+	t.latestSigMu.Lock()
+	t.latestSig = batch.sigs[0].Signature
+	t.latestSigMu.Unlock()
+	if t.backfill != nil {
+		t.backfill.observe(batch.until, batch.sigs, batch.pageSize)
+	}
+}
+
+// fetchBackfill returns the next batch of missed signatures, nil if backfill is disabled or there is nothing to backfill
+// the batch must be committed with commitBackfill once it is processed
+func (t *txResultsSource) fetchBackfill(ctx context.Context) *backfillBatch {
+	if t.backfill == nil {
+		return nil
+	}
+	batch, err := t.backfill.next(ctx)
+	if err != nil {
+		// backfill is retried on the next fetch, do not block live results
+		t.log.Warnw("failed to backfill transactions", "error", err)
+		return nil
+	}
+	return batch
+}
+
+// commitBackfill advances the backfill checkpoint past a processed batch
+func (t *txResultsSource) commitBackfill(batch *backfillBatch) {
+	if t.backfill != nil {
+		t.backfill.commit(batch)
 	}
-	func() {
-		t.latestSigMu.Lock()
-		defer t.latestSigMu.Unlock()
-		t.latestSig = txSigs[0].Signature
-	}()
-	return commonMonitoring.TxResults{NumSucceeded: numSucceeded, NumFailed: numFailed}, txSigs, nil
 }
@@ -1,8 +1,10 @@
 package types
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"math/big"
 
 	solanaGo "github.com/gagliardetto/solana-go"
 	"github.com/gagliardetto/solana-go/rpc"
@@ -21,6 +23,15 @@ var (
 	ComputeUnitPriceMetric  = "tx_compute_unit_price"
 	NodeSuccessMetric       = "node_success" // per node per feed
 
+	// per node per feed oracle participation metrics
+	OracleParticipationRateMetric = "oracle_participation_rate"
+	OracleMissedRoundsMetric      = "oracle_missed_rounds_total" // counter
+	OracleTransmitLatencyMetric   = "oracle_transmit_latency_seconds"
+	OracleParticipationMetrics    = []string{
+		OracleParticipationRateMetric,
+		OracleTransmitLatencyMetric,
+	}
+
 	// these metrics are per feed
 	TxDetailsMetrics = []string{
 		ReportObservationMetric,
@@ -30,15 +41,25 @@ var (
 )
 
 type TxDetails struct {
-	Err  interface{}
-	Fee  uint64
-	Slot uint64
+	Err       interface{}
+	Fee       uint64
+	Slot      uint64
+	BlockTime int64 // unix seconds, 0 if not provided by the RPC
 
 	Sender solanaGo.PublicKey
 
 	// report tx information - only supports single report per tx
-	ObservationCount uint8
-	ComputeUnitPrice fees.ComputeUnitPrice
+	ConfigDigest          types.ConfigDigest
+	ObservationCount      uint8
+	ObservationsTimestamp uint32
+	Observers             []uint8 // oracle indices ordered by observed value (ascending)
+	Median                *big.Int
+	JuelsPerFeeCoin       *big.Int
+	ComputeUnitPrice      fees.ComputeUnitPrice
+
+	// ObserverTransmitters contains the transmitter accounts for Observers (same order)
+	// populated by MapObservers using the on-chain State.Oracles
+	ObserverTransmitters []solanaGo.PublicKey
 }
 
 func (td TxDetails) Empty() bool {
@@ -49,6 +70,29 @@ func (td TxDetails) Empty() bool {
 		td.ComputeUnitPrice == 0
 }
 
+// MapObservers resolves the report observer indices to transmitter accounts using the on-chain state.
+// Observer indices are only meaningful for the config that produced the report, so the report config digest must match the state.
+func (td *TxDetails) MapObservers(state solana.State) error {
+	if td.ConfigDigest != state.Config.LatestConfigDigest {
+		return fmt.Errorf("report config digest (%s) does not match state config digest (%s)", td.ConfigDigest.Hex(), types.ConfigDigest(state.Config.LatestConfigDigest).Hex())
+	}
+
+	oracles, err := state.Oracles.Data()
+	if err != nil {
+		return err
+	}
+
+	transmitters := make([]solanaGo.PublicKey, len(td.Observers))
+	for i, o := range td.Observers {
+		if int(o) >= len(oracles) {
+			return fmt.Errorf("observer index out of range: %d (received), %d (oracles)", o, len(oracles))
+		}
+		transmitters[i] = oracles[o].Transmitter
+	}
+	td.ObserverTransmitters = transmitters
+	return nil
+}
+
 // MakeTxDetails casts an interface to []TxDetails
 func MakeTxDetails(in interface{}) ([]TxDetails, error) {
 	out, ok := (in).([]TxDetails)
@@ -85,6 +129,9 @@ func ParseTxResult(txResult *rpc.GetTransactionResult, programAddr solanaGo.Publ
 	details.Err = txResult.Meta.Err
 	details.Fee = txResult.Meta.Fee
 	details.Slot = txResult.Slot
+	if txResult.BlockTime != nil {
+		details.BlockTime = int64(*txResult.BlockTime)
+	}
 	return details, nil
 }
 
@@ -133,12 +180,13 @@ func ParseTx(tx *solanaGo.Transaction, programAddr solanaGo.PublicKey) (TxDetail
 			}
 
 			report := types.Report(instruction.Data[start:end])
-			var err error
-			txDetails.ObservationCount, err = solana.ReportCodec{}.ObserversCountFromReport(report)
-			if err != nil {
+			if err := parseReport(&txDetails, report); err != nil {
 				totalErr = errors.Join(totalErr, fmt.Errorf("%w (%+v)", err, instruction))
 				continue
 			}
+
+			// report context begins with the config digest
+			copy(txDetails.ConfigDigest[:], instruction.Data[solana.StoreNonceLen:solana.StoreNonceLen+len(txDetails.ConfigDigest)])
 			foundTransmit = true
 			continue
 		}
@@ -167,3 +215,22 @@ func ParseTx(tx *solanaGo.Transaction, programAddr solanaGo.PublicKey) (TxDetail
 
 	return txDetails, nil
 }
+
+// parseReport decodes the full report (see solana/report.go) into the tx details
+func parseReport(txDetails *TxDetails, report types.Report) (err error) {
+	codec := solana.ReportCodec{}
+	if txDetails.ObservationsTimestamp, err = codec.TimestampFromReport(report); err != nil {
+		return err
+	}
+	if txDetails.Observers, err = codec.ObserversFromReport(report); err != nil {
+		return err
+	}
+	txDetails.ObservationCount = uint8(len(txDetails.Observers)) //nolint:gosec // observers array is at most 32 entries
+	if txDetails.Median, err = codec.MedianFromReport(context.Background(), report); err != nil {
+		return err
+	}
+	if txDetails.JuelsPerFeeCoin, err = codec.JuelsPerFeeCoinFromReport(report); err != nil {
+		return err
+	}
+	return nil
+}
@@ -9,6 +9,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/smartcontractkit/libocr/offchainreporting2/types"
+
+	pkgSolana "github.com/smartcontractkit/chainlink-solana/pkg/solana"
 	"github.com/smartcontractkit/chainlink-solana/pkg/solana/fees"
 )
 
@@ -44,6 +47,7 @@ func TestParseTxResult(t *testing.T) {
 
 	assert.Equal(t, nil, res.Err)
 	assert.Equal(t, uint64(5000), res.Fee)
+	assert.Equal(t, int64(1712887149), res.BlockTime)
 }
 
 func TestParseTx(t *testing.T) {
@@ -83,6 +87,11 @@ func TestParseTx(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, sampleTxResultSigner, out.Sender)
 	assert.Equal(t, uint8(4), out.ObservationCount)
+	assert.Equal(t, 4, len(out.Observers))
+	assert.NotZero(t, out.ObservationsTimestamp)
+	assert.NotNil(t, out.Median)
+	assert.NotNil(t, out.JuelsPerFeeCoin)
+	assert.NotEqual(t, types.ConfigDigest{}, out.ConfigDigest)
 	assert.Equal(t, fees.ComputeUnitPrice(0), out.ComputeUnitPrice)
 
 	// multiple instructions - currently not the case
@@ -91,3 +100,27 @@ func TestParseTx(t *testing.T) {
 	out, err = ParseTx(txMultipleTransmit, SampleTxResultProgram)
 	require.Error(t, err)
 }
+
+func TestMapObservers(t *testing.T) {
+	details, err := ParseTx(getTestTx(t), SampleTxResultProgram)
+	require.NoError(t, err)
+
+	state := pkgSolana.State{}
+	state.Oracles.Len = 2
+	state.Oracles.Raw[0].Transmitter = solana.PublicKey{1}
+	state.Oracles.Raw[1].Transmitter = solana.PublicKey{2}
+
+	// mismatched config digest
+	require.ErrorContains(t, details.MapObservers(state), "does not match state config digest")
+	assert.Nil(t, details.ObserverTransmitters)
+
+	// observer index out of range
+	state.Config.LatestConfigDigest = details.ConfigDigest
+	details.Observers = []uint8{0, 2}
+	require.ErrorContains(t, details.MapObservers(state), "observer index out of range")
+
+	// happy path
+	details.Observers = []uint8{1, 0}
+	require.NoError(t, details.MapObservers(state))
+	assert.Equal(t, []solana.PublicKey{{2}, {1}}, details.ObserverTransmitters)
+}
@@ -7,8 +7,18 @@ const (
 
 	NetworkFeesType   = "network_fees"
 	NetworkFeesMetric = "sol_" + NetworkFeesType
+
+	FeedFlagType                = "feed_flag"
+	FeedFlaggedMetric           = "sol_feed_flagged"
+	FeedFlaggingThresholdMetric = "sol_feed_flagging_threshold"
 )
 
 // SlotHeight type wraps the uint64 type returned by the RPC call
 // this helps to delineate types when sending to the exporter
 type SlotHeight uint64
+
+// FeedFlag contains the store program validator state of a feed
+type FeedFlag struct {
+	Flagged           bool
+	FlaggingThreshold uint32
+}
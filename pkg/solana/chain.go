@@ -40,6 +40,10 @@ type Chain interface {
 	TxManager() TxManager
 	// Reader returns a new Reader from the available list of nodes (if there are multiple, it will randomly select one)
 	Reader() (client.Reader, error)
+	// Simulate dry-runs a transaction and returns the decoded logs, events, compute units, and account diffs
+	Simulate(ctx context.Context, tx *solanago.Transaction, opts SimulateOpts) (*SimulateResult, error)
+	// TransactToken transfers SPL Token or Token-2022 tokens, creating the destination associated token account if needed
+	TransactToken(ctx context.Context, from, to, mint string, amount *big.Int, balanceCheck bool) error
 }
 
 // DefaultRequestTimeout is the default Solana client timeout.
@@ -86,7 +90,7 @@ type chain struct {
 	id             string
 	cfg            *config.TOMLConfig
 	txm            *txm.Txm
-	balanceMonitor services.Service
+	balanceMonitor monitor.BalanceMonitor
 	lggr           logger.Logger
 
 	// if multiNode is enabled, the clientCache will not be used
@@ -213,6 +217,15 @@ func (v *verifiedCachedClient) GetFeeForMessage(ctx context.Context, msg string)
 	return v.ReaderWriter.GetFeeForMessage(ctx, msg)
 }
 
+func (v *verifiedCachedClient) GetMinimumBalanceForRentExemption(ctx context.Context, dataSize uint64) (uint64, error) {
+	verified, err := v.verifyChainID(ctx)
+	if !verified {
+		return 0, err
+	}
+
+	return v.ReaderWriter.GetMinimumBalanceForRentExemption(ctx, dataSize)
+}
+
 func (v *verifiedCachedClient) GetAccountInfoWithOpts(ctx context.Context, addr solanago.PublicKey, opts *rpc.GetAccountInfoOpts) (*rpc.GetAccountInfoResult, error) {
 	verified, err := v.verifyChainID(ctx)
 	if !verified {
@@ -222,6 +235,15 @@ func (v *verifiedCachedClient) GetAccountInfoWithOpts(ctx context.Context, addr
 	return v.ReaderWriter.GetAccountInfoWithOpts(ctx, addr, opts)
 }
 
+func (v *verifiedCachedClient) GetAccountInfoWithCommitment(ctx context.Context, addr solanago.PublicKey, opts *rpc.GetAccountInfoOpts) (*rpc.GetAccountInfoResult, error) {
+	verified, err := v.verifyChainID(ctx)
+	if !verified {
+		return nil, err
+	}
+
+	return v.ReaderWriter.GetAccountInfoWithCommitment(ctx, addr, opts)
+}
+
 func newChain(id string, cfg *config.TOMLConfig, ks loop.Keystore, lggr logger.Logger) (*chain, error) {
 	lggr = logger.With(lggr, "chainID", id, "chain", "solana")
 	var ch = chain{
@@ -244,6 +266,7 @@ func newChain(id string, cfg *config.TOMLConfig, ks loop.Keystore, lggr logger.L
 
 		var nodes []mn.Node[mn.StringID, *client.MultiNodeClient]
 		var sendOnlyNodes []mn.SendOnlyNode[mn.StringID, *client.MultiNodeClient]
+		var stakedNodes []string
 
 		for i, nodeInfo := range cfg.ListNodes() {
 			rpcClient, err := client.NewMultiNodeClient(nodeInfo.URL.String(), cfg, DefaultRequestTimeout, logger.Named(lggr, "Client."+*nodeInfo.Name))
@@ -252,6 +275,10 @@ func newChain(id string, cfg *config.TOMLConfig, ks loop.Keystore, lggr logger.L
 				return nil, fmt.Errorf("failed to create client: %w", err)
 			}
 
+			if nodeInfo.Staked {
+				stakedNodes = append(stakedNodes, *nodeInfo.Name)
+			}
+
 			if nodeInfo.SendOnly {
 				newSendOnly := mn.NewSendOnlyNode[mn.StringID, *client.MultiNodeClient](
 					lggr, *nodeInfo.URL.URL(), *nodeInfo.Name, mn.StringID(id), rpcClient)
@@ -282,6 +309,16 @@ func newChain(id string, cfg *config.TOMLConfig, ks loop.Keystore, lggr logger.L
 			multiNode,
 			client.NewSendTxResult,
 			0, // use the default value provided by the implementation
+			mn.SendTxTiering[*solanago.Transaction]{
+				PriorityNodes: stakedNodes,
+				FanoutDelay:   mnCfg.SendTxFanoutDelay(),
+				TxKey: func(tx *solanago.Transaction) string {
+					if len(tx.Signatures) == 0 {
+						return ""
+					}
+					return tx.Signatures[0].String()
+				},
+			},
 		)
 
 		ch.multiNode = multiNode
@@ -307,7 +344,14 @@ func newChain(id string, cfg *config.TOMLConfig, ks loop.Keystore, lggr logger.L
 	}
 
 	ch.txm = txm.NewTxm(ch.id, tc, sendTx, cfg, ks, lggr)
-	ch.balanceMonitor = monitor.NewBalanceMonitor(ch.id, cfg, lggr, ks, bc)
+	if ch.txSender != nil {
+		// landing results are used by MultiNode to rank nodes for transaction broadcasting
+		ch.txm.SetLandingObserver(func(sig solanago.Signature, landed bool) {
+			ch.txSender.ReportLanding(sig.String(), landed)
+		})
+	}
+	ch.balanceMonitor = monitor.NewBalanceMonitor(ch.id, cfg, lggr, ks, bc, ch.txm)
+	ch.txm.SetBalanceCheck(ch.balanceMonitor.CheckBalanceFloor)
 	return &ch, nil
 }
 
@@ -526,6 +570,7 @@ func (c *chain) Ready() error {
 func (c *chain) HealthReport() map[string]error {
 	report := map[string]error{c.Name(): c.Healthy()}
 	services.CopyHealth(report, c.txm.HealthReport())
+	services.CopyHealth(report, c.balanceMonitor.HealthReport())
 	return report
 }
 
@@ -0,0 +1,362 @@
+package chainreader
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+)
+
+// SlotDataReader is implemented by a BinaryDataReader that also returns the slot of the RPC context the
+// data was read at.
+type SlotDataReader interface {
+	ReadAllWithSlot(context.Context, solana.PublicKey, *rpc.GetAccountInfoOpts) ([]byte, uint64, error)
+}
+
+// AccountSubscriber streams the updates of an account, e.g. with accountSubscribe over a WebSocket
+// connection. The BinaryDataReader of the chain reader must implement it for cache policies with
+// subscriptions, as the reader returned by NewSubscribingAccountDataReader does. The context only applies
+// to establishing the subscription.
+type AccountSubscriber interface {
+	SubscribeAccount(context.Context, solana.PublicKey, rpc.CommitmentType) (AccountSubscription, error)
+}
+
+// AccountSubscription is an active account subscription.
+type AccountSubscription interface {
+	// Recv blocks until the next update of the account and returns its data and slot. Recv returns
+	// an error if the context is done or the subscription failed.
+	Recv(context.Context) ([]byte, uint64, error)
+	// Unsubscribe stops the subscription.
+	Unsubscribe()
+}
+
+// readWithSlot reads the account data and the slot it was read at, the slot is 0 if the reader
+// can't return it.
+func readWithSlot(ctx context.Context, reader BinaryDataReader, account solana.PublicKey, opts *rpc.GetAccountInfoOpts) ([]byte, uint64, error) {
+	if slotReader, ok := reader.(SlotDataReader); ok {
+		return slotReader.ReadAllWithSlot(ctx, account, opts)
+	}
+
+	bts, err := reader.ReadAll(ctx, account, opts)
+
+	return bts, 0, err
+}
+
+const (
+	// subscribeBackoffMin and subscribeBackoffMax bound the delay before a failed subscription is retried
+	subscribeBackoffMin = time.Second
+	subscribeBackoffMax = time.Minute
+	// defaultCacheIdleTimeout is the default time after which subscriptions of accounts that are not read are stopped
+	defaultCacheIdleTimeout = 10 * time.Minute
+)
+
+type cacheKey struct {
+	account    solana.PublicKey
+	commitment rpc.CommitmentType
+}
+
+type cachedAccount struct {
+	data    []byte
+	slot    uint64
+	updated time.Time
+}
+
+// subscription is an account subscription, sub is nil while subscribing
+type subscription struct {
+	sub      AccountSubscription
+	cancel   context.CancelFunc // stops receiving updates
+	lastRead time.Time
+}
+
+// subscribeFailure delays subscribing again after failed attempts
+type subscribeFailure struct {
+	attempts int
+	retryAt  time.Time
+}
+
+// accountCache is a BinaryDataReader that caches the account data read through reader by address and
+// commitment, see config.CachePolicy. Data is only replaced by data of the same or a later slot, so a
+// slow RPC read can't overwrite a newer subscription update. Expired data is removed and subscriptions
+// that were not read for idleTimeout are stopped in the background.
+type accountCache struct {
+	lggr        logger.Logger
+	reader      BinaryDataReader
+	subscriber  AccountSubscriber
+	ttl         time.Duration
+	idleTimeout time.Duration
+	backoffMin  time.Duration
+	backoffMax  time.Duration
+	// cleanupInterval is how often idle subscriptions and expired data are removed, 0 if never
+	cleanupInterval time.Duration
+
+	mu            sync.Mutex
+	accounts      map[cacheKey]*cachedAccount
+	subscriptions map[cacheKey]*subscription
+	failures      map[cacheKey]*subscribeFailure
+
+	wg     sync.WaitGroup
+	stopCh services.StopChan
+}
+
+var (
+	_ BinaryDataReader = &accountCache{}
+	_ SlotDataReader   = &accountCache{}
+)
+
+// newAccountCache returns a cache serving data for ttl, or for as long as the account subscription is
+// active if subscriber is not nil. Subscriptions are stopped if the account was not read for idleTimeout.
+func newAccountCache(lggr logger.Logger, reader BinaryDataReader, subscriber AccountSubscriber, ttl, idleTimeout time.Duration) *accountCache {
+	c := &accountCache{
+		lggr:          lggr,
+		reader:        reader,
+		subscriber:    subscriber,
+		ttl:           ttl,
+		idleTimeout:   idleTimeout,
+		backoffMin:    subscribeBackoffMin,
+		backoffMax:    subscribeBackoffMax,
+		accounts:      make(map[cacheKey]*cachedAccount),
+		subscriptions: make(map[cacheKey]*subscription),
+		failures:      make(map[cacheKey]*subscribeFailure),
+		stopCh:        make(services.StopChan),
+	}
+
+	c.cleanupInterval = ttl
+	if subscriber != nil && (c.cleanupInterval <= 0 || idleTimeout < c.cleanupInterval) {
+		c.cleanupInterval = idleTimeout
+	}
+
+	return c
+}
+
+// start starts removing idle subscriptions and expired data in the background until Close is called.
+func (c *accountCache) start() {
+	if c.cleanupInterval <= 0 {
+		return
+	}
+
+	c.wg.Add(1)
+	go c.cleanupLoop(c.cleanupInterval)
+}
+
+func (c *accountCache) ReadAll(ctx context.Context, account solana.PublicKey, opts *rpc.GetAccountInfoOpts) ([]byte, error) {
+	bts, _, err := c.ReadAllWithSlot(ctx, account, opts)
+
+	return bts, err
+}
+
+func (c *accountCache) ReadAllWithSlot(ctx context.Context, account solana.PublicKey, opts *rpc.GetAccountInfoOpts) ([]byte, uint64, error) {
+	key := cacheKey{account: account}
+	if opts != nil {
+		key.commitment = opts.Commitment
+	}
+
+	if cached, ok := c.get(key); ok {
+		return cached.data, cached.slot, nil
+	}
+
+	// subscribe before reading so that no update between the read and the subscription is missed
+	if c.subscriber != nil {
+		c.subscribe(ctx, key)
+	}
+
+	bts, slot, err := readWithSlot(ctx, c.reader, account, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	c.update(key, bts, slot)
+
+	return bts, slot, nil
+}
+
+// Close stops all subscriptions.
+func (c *accountCache) Close() {
+	c.mu.Lock()
+	close(c.stopCh)
+	c.mu.Unlock()
+
+	c.wg.Wait()
+}
+
+// get returns the cached data if it is kept up to date by a subscription or is not older than the TTL
+func (c *accountCache) get(key cacheKey) (cachedAccount, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	sub, subscribed := c.subscriptions[key]
+	if subscribed {
+		sub.lastRead = time.Now()
+	}
+
+	cached, ok := c.accounts[key]
+	if !ok {
+		return cachedAccount{}, false
+	}
+
+	if (subscribed && sub.sub != nil) || time.Since(cached.updated) < c.ttl {
+		return *cached, true
+	}
+
+	return cachedAccount{}, false
+}
+
+// update replaces the cached data unless the cached data is from a later slot
+func (c *accountCache) update(key cacheKey, data []byte, slot uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if cached, ok := c.accounts[key]; ok && cached.slot > slot {
+		return
+	}
+
+	c.accounts[key] = &cachedAccount{data: data, slot: slot, updated: time.Now()}
+}
+
+func (c *accountCache) subscribe(ctx context.Context, key cacheKey) {
+	c.mu.Lock()
+	if _, ok := c.subscriptions[key]; ok {
+		c.mu.Unlock()
+
+		return
+	}
+
+	if failure, ok := c.failures[key]; ok && time.Now().Before(failure.retryAt) {
+		c.mu.Unlock()
+
+		return
+	}
+
+	c.subscriptions[key] = &subscription{lastRead: time.Now()}
+	c.mu.Unlock()
+
+	ctx, cancel := c.stopCh.Ctx(ctx)
+	defer cancel()
+
+	sub, err := c.subscriber.SubscribeAccount(ctx, key.account, key.commitment)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err != nil {
+		delete(c.subscriptions, key)
+
+		failure, ok := c.failures[key]
+		if !ok {
+			failure = &subscribeFailure{}
+			c.failures[key] = failure
+		}
+
+		backoff := c.backoffMin << min(failure.attempts, 16)
+		if backoff > c.backoffMax {
+			backoff = c.backoffMax
+		}
+
+		failure.attempts++
+		failure.retryAt = time.Now().Add(backoff)
+
+		c.lggr.Warnw("failed to subscribe to account, caching with TTL", "account", key.account, "commitment", key.commitment, "retryIn", backoff, "error", err)
+
+		return
+	}
+
+	delete(c.failures, key)
+
+	select {
+	case <-c.stopCh:
+		// closed while subscribing
+		delete(c.subscriptions, key)
+		sub.Unsubscribe()
+
+		return
+	default:
+	}
+
+	s := c.subscriptions[key]
+	s.sub = sub
+
+	var recvCtx context.Context
+	recvCtx, s.cancel = c.stopCh.NewCtx()
+
+	c.wg.Add(1)
+	go c.receive(recvCtx, key, s)
+}
+
+// receive updates the cached data from the subscription until it fails, is idle or the cache is closed
+func (c *accountCache) receive(ctx context.Context, key cacheKey, s *subscription) {
+	defer c.wg.Done()
+	defer s.sub.Unsubscribe()
+	defer s.cancel()
+
+	for {
+		data, slot, err := s.sub.Recv(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				c.lggr.Warnw("account subscription failed, caching with TTL", "account", key.account, "commitment", key.commitment, "error", err)
+			}
+
+			c.mu.Lock()
+			if c.subscriptions[key] == s {
+				delete(c.subscriptions, key)
+			}
+			c.mu.Unlock()
+
+			return
+		}
+
+		c.update(key, data, slot)
+	}
+}
+
+func (c *accountCache) cleanupLoop(interval time.Duration) {
+	defer c.wg.Done()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.stopCh:
+			return
+		case <-ticker.C:
+			c.cleanup()
+		}
+	}
+}
+
+// cleanup stops idle subscriptions and removes data that can't be served anymore
+func (c *accountCache) cleanup() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for key, s := range c.subscriptions {
+		// subscriptions are only stopped once established
+		if s.sub != nil && now.Sub(s.lastRead) >= c.idleTimeout {
+			c.lggr.Debugw("stopping idle account subscription", "account", key.account, "commitment", key.commitment)
+			s.cancel()
+			delete(c.subscriptions, key)
+		}
+	}
+
+	for key, cached := range c.accounts {
+		if s, ok := c.subscriptions[key]; ok && s.sub != nil {
+			continue
+		}
+
+		if now.Sub(cached.updated) >= c.ttl {
+			delete(c.accounts, key)
+		}
+	}
+
+	// forget failures of accounts that are no longer read
+	for key, failure := range c.failures {
+		if now.Sub(failure.retryAt) >= c.idleTimeout {
+			delete(c.failures, key)
+		}
+	}
+}
@@ -0,0 +1,388 @@
+package chainreader
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+func TestAccountCache(t *testing.T) {
+	t.Parallel()
+
+	account := solana.NewWallet().PublicKey()
+	finalized := &rpc.GetAccountInfoOpts{Commitment: rpc.CommitmentFinalized}
+	confirmed := &rpc.GetAccountInfoOpts{Commitment: rpc.CommitmentConfirmed}
+
+	t.Run("serves data until it is older than the TTL", func(t *testing.T) {
+		t.Parallel()
+
+		reader := &mockSlotReader{data: []byte{1}, slot: 10}
+		cache := newAccountCache(logger.Test(t), reader, nil, 200*time.Millisecond, 0)
+		cache.start()
+		defer cache.Close()
+
+		ctx := context.Background()
+		for i := 0; i < 3; i++ {
+			bts, slot, err := cache.ReadAllWithSlot(ctx, account, finalized)
+			require.NoError(t, err)
+			assert.Equal(t, []byte{1}, bts)
+			assert.Equal(t, uint64(10), slot)
+		}
+
+		assert.Equal(t, 1, reader.reads())
+
+		// each commitment is cached separately
+		_, err := cache.ReadAll(ctx, account, confirmed)
+		require.NoError(t, err)
+		assert.Equal(t, 2, reader.reads())
+
+		time.Sleep(250 * time.Millisecond)
+
+		_, err = cache.ReadAll(ctx, account, finalized)
+		require.NoError(t, err)
+		assert.Equal(t, 3, reader.reads())
+	})
+
+	t.Run("read errors are not cached", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("test error")
+		reader := &mockSlotReader{err: expectedErr}
+		cache := newAccountCache(logger.Test(t), reader, nil, time.Minute, 0)
+		cache.start()
+		defer cache.Close()
+
+		_, err := cache.ReadAll(context.Background(), account, finalized)
+		assert.ErrorIs(t, err, expectedErr)
+		_, err = cache.ReadAll(context.Background(), account, finalized)
+		assert.ErrorIs(t, err, expectedErr)
+		assert.Equal(t, 2, reader.reads())
+	})
+
+	t.Run("subscription updates the data of later slots", func(t *testing.T) {
+		t.Parallel()
+
+		reader := &mockSlotReader{data: []byte{1}, slot: 10}
+		subscriber := newMockSubscriber()
+		cache := newAccountCache(logger.Test(t), reader, subscriber, 0, time.Minute)
+		cache.start()
+		defer cache.Close()
+
+		ctx := context.Background()
+		bts, err := cache.ReadAll(ctx, account, finalized)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{1}, bts)
+
+		sub := subscriber.subscription(t, account, rpc.CommitmentFinalized)
+
+		// an update of an earlier slot is ignored
+		sub.send(t, []byte{0}, 9)
+		sub.send(t, []byte{2}, 11)
+
+		require.Eventually(t, func() bool {
+			bts, slot, err := cache.ReadAllWithSlot(ctx, account, finalized)
+			return err == nil && assert.ObjectsAreEqual([]byte{2}, bts) && slot == 11
+		}, time.Second, 10*time.Millisecond)
+
+		assert.Equal(t, 1, reader.reads())
+	})
+
+	t.Run("failed subscription reads from the reader", func(t *testing.T) {
+		t.Parallel()
+
+		reader := &mockSlotReader{data: []byte{1}, slot: 10}
+		subscriber := newMockSubscriber()
+		cache := newAccountCache(logger.Test(t), reader, subscriber, 0, time.Minute)
+		cache.start()
+		defer cache.Close()
+
+		ctx := context.Background()
+		_, err := cache.ReadAll(ctx, account, finalized)
+		require.NoError(t, err)
+
+		subscriber.subscription(t, account, rpc.CommitmentFinalized).fail(errors.New("connection closed"))
+
+		require.Eventually(t, func() bool {
+			_, err := cache.ReadAll(ctx, account, finalized)
+			return err == nil && reader.reads() > 1
+		}, time.Second, 10*time.Millisecond)
+
+		// the next read subscribes again
+		subscriber.subscription(t, account, rpc.CommitmentFinalized)
+	})
+
+	t.Run("expired data is removed", func(t *testing.T) {
+		t.Parallel()
+
+		reader := &mockSlotReader{data: []byte{1}, slot: 10}
+		cache := newAccountCache(logger.Test(t), reader, nil, 50*time.Millisecond, 0)
+		cache.start()
+		defer cache.Close()
+
+		_, err := cache.ReadAll(context.Background(), account, finalized)
+		require.NoError(t, err)
+		assert.Equal(t, 1, cachedAccounts(cache))
+
+		require.Eventually(t, func() bool {
+			return cachedAccounts(cache) == 0
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("idle subscriptions are stopped", func(t *testing.T) {
+		t.Parallel()
+
+		reader := &mockSlotReader{data: []byte{1}, slot: 10}
+		subscriber := newMockSubscriber()
+		cache := newAccountCache(logger.Test(t), reader, subscriber, 0, 200*time.Millisecond)
+		cache.start()
+		defer cache.Close()
+
+		ctx := context.Background()
+		_, err := cache.ReadAll(ctx, account, finalized)
+		require.NoError(t, err)
+
+		sub := subscriber.subscription(t, account, rpc.CommitmentFinalized)
+
+		// reads keep the subscription active
+		for i := 0; i < 10; i++ {
+			time.Sleep(50 * time.Millisecond)
+			_, err = cache.ReadAll(ctx, account, finalized)
+			require.NoError(t, err)
+		}
+
+		select {
+		case <-sub.unsubscribed:
+			t.Fatal("subscription was stopped while being read")
+		default:
+		}
+
+		assert.Equal(t, 1, reader.reads())
+
+		select {
+		case <-sub.unsubscribed:
+		case <-time.After(time.Second):
+			t.Fatal("idle subscription was not stopped")
+		}
+
+		require.Eventually(t, func() bool {
+			return cachedAccounts(cache) == 0
+		}, time.Second, 10*time.Millisecond)
+
+		// the next read subscribes again
+		_, err = cache.ReadAll(ctx, account, finalized)
+		require.NoError(t, err)
+		subscriber.subscription(t, account, rpc.CommitmentFinalized)
+	})
+
+	t.Run("failed subscribe is retried after a backoff", func(t *testing.T) {
+		t.Parallel()
+
+		reader := &mockSlotReader{data: []byte{1}, slot: 10}
+		subscriber := newMockSubscriber()
+		subscriber.setErr(errors.New("connection refused"))
+		cache := newAccountCache(logger.Test(t), reader, subscriber, 0, time.Minute)
+		cache.start()
+		cache.backoffMin = 200 * time.Millisecond
+		defer cache.Close()
+
+		ctx := context.Background()
+		for i := 0; i < 3; i++ {
+			_, err := cache.ReadAll(ctx, account, finalized)
+			require.NoError(t, err)
+		}
+
+		assert.Equal(t, 1, subscriber.attempts())
+		assert.Equal(t, 3, reader.reads())
+
+		// the backoff doubles after each failed attempt
+		time.Sleep(300 * time.Millisecond)
+		_, err := cache.ReadAll(ctx, account, finalized)
+		require.NoError(t, err)
+		assert.Equal(t, 2, subscriber.attempts())
+
+		time.Sleep(200 * time.Millisecond)
+		_, err = cache.ReadAll(ctx, account, finalized)
+		require.NoError(t, err)
+		assert.Equal(t, 2, subscriber.attempts())
+
+		subscriber.setErr(nil)
+		time.Sleep(300 * time.Millisecond)
+		_, err = cache.ReadAll(ctx, account, finalized)
+		require.NoError(t, err)
+		assert.Equal(t, 3, subscriber.attempts())
+		subscriber.subscription(t, account, rpc.CommitmentFinalized)
+	})
+
+	t.Run("close stops subscriptions", func(t *testing.T) {
+		t.Parallel()
+
+		reader := &mockSlotReader{data: []byte{1}, slot: 10}
+		subscriber := newMockSubscriber()
+		cache := newAccountCache(logger.Test(t), reader, subscriber, 0, time.Minute)
+		cache.start()
+
+		_, err := cache.ReadAll(context.Background(), account, finalized)
+		require.NoError(t, err)
+
+		sub := subscriber.subscription(t, account, rpc.CommitmentFinalized)
+		cache.Close()
+
+		select {
+		case <-sub.unsubscribed:
+		case <-time.After(time.Second):
+			t.Fatal("subscription was not stopped")
+		}
+	})
+}
+
+type mockSlotReader struct {
+	mu    sync.Mutex
+	data  []byte
+	slot  uint64
+	err   error
+	calls int
+}
+
+func (r *mockSlotReader) ReadAll(ctx context.Context, pk solana.PublicKey, opts *rpc.GetAccountInfoOpts) ([]byte, error) {
+	bts, _, err := r.ReadAllWithSlot(ctx, pk, opts)
+
+	return bts, err
+}
+
+func (r *mockSlotReader) ReadAllWithSlot(_ context.Context, _ solana.PublicKey, _ *rpc.GetAccountInfoOpts) ([]byte, uint64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls++
+
+	return r.data, r.slot, r.err
+}
+
+func (r *mockSlotReader) reads() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.calls
+}
+
+// cachedAccounts returns the number of accounts held by the cache
+func cachedAccounts(c *accountCache) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.accounts)
+}
+
+type mockSubscriber struct {
+	subscriptions chan *mockSubscription
+
+	mu    sync.Mutex
+	err   error
+	calls int
+}
+
+func newMockSubscriber() *mockSubscriber {
+	return &mockSubscriber{subscriptions: make(chan *mockSubscription, 10)}
+}
+
+func (s *mockSubscriber) SubscribeAccount(_ context.Context, account solana.PublicKey, commitment rpc.CommitmentType) (AccountSubscription, error) {
+	s.mu.Lock()
+	s.calls++
+	err := s.err
+	s.mu.Unlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	sub := &mockSubscription{
+		account:      account,
+		commitment:   commitment,
+		updates:      make(chan mockUpdate),
+		unsubscribed: make(chan struct{}),
+	}
+	s.subscriptions <- sub
+
+	return sub, nil
+}
+
+func (s *mockSubscriber) setErr(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.err = err
+}
+
+func (s *mockSubscriber) attempts() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.calls
+}
+
+// subscription returns the next subscription and checks its account and commitment
+func (s *mockSubscriber) subscription(t *testing.T, account solana.PublicKey, commitment rpc.CommitmentType) *mockSubscription {
+	t.Helper()
+
+	select {
+	case sub := <-s.subscriptions:
+		assert.Equal(t, account, sub.account)
+		assert.Equal(t, commitment, sub.commitment)
+
+		return sub
+	case <-time.After(time.Second):
+		t.Fatal("no subscription")
+
+		return nil
+	}
+}
+
+type mockUpdate struct {
+	data []byte
+	slot uint64
+	err  error
+}
+
+type mockSubscription struct {
+	account      solana.PublicKey
+	commitment   rpc.CommitmentType
+	updates      chan mockUpdate
+	unsubscribed chan struct{}
+	once         sync.Once
+}
+
+func (s *mockSubscription) Recv(ctx context.Context) ([]byte, uint64, error) {
+	select {
+	case update := <-s.updates:
+		return update.data, update.slot, update.err
+	case <-ctx.Done():
+		return nil, 0, ctx.Err()
+	}
+}
+
+func (s *mockSubscription) Unsubscribe() {
+	s.once.Do(func() { close(s.unsubscribed) })
+}
+
+func (s *mockSubscription) send(t *testing.T, data []byte, slot uint64) {
+	t.Helper()
+
+	select {
+	case s.updates <- mockUpdate{data: data, slot: slot}:
+	case <-time.After(time.Second):
+		t.Fatal("update was not received")
+	}
+}
+
+func (s *mockSubscription) fail(err error) {
+	s.updates <- mockUpdate{err: err}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/gagliardetto/solana-go/rpc"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
 )
 
 // BinaryDataReader provides an interface for reading bytes from a source. This is likely a wrapper
@@ -18,55 +19,52 @@ type BinaryDataReader interface {
 
 // accountReadBinding provides decoding and reading Solana Account data using a defined codec. The
 // `idlAccount` refers to the account name in the IDL for which the codec has a type mapping.
+// When `pda` is set, the account address is derived from the bound address and the read params.
 type accountReadBinding struct {
 	idlAccount string
 	codec      types.RemoteCodec
 	reader     BinaryDataReader
 	opts       *rpc.GetAccountInfoOpts
+	pda        *pdaResolver
 }
 
-func newAccountReadBinding(acct string, codec types.RemoteCodec, reader BinaryDataReader, opts *rpc.GetAccountInfoOpts) *accountReadBinding {
+func newAccountReadBinding(acct string, codec types.RemoteCodec, reader BinaryDataReader, opts *rpc.GetAccountInfoOpts, pda *pdaResolver) *accountReadBinding {
 	return &accountReadBinding{
 		idlAccount: acct,
 		codec:      codec,
 		reader:     reader,
 		opts:       opts,
+		pda:        pda,
 	}
 }
 
 var _ readBinding = &accountReadBinding{}
 
-func (b *accountReadBinding) PreLoad(ctx context.Context, address string, result *loadedResult) {
+func (b *accountReadBinding) PreLoad(ctx context.Context, address string, params any, confidence primitives.ConfidenceLevel, result *loadedResult) {
 	if result == nil {
 		return
 	}
 
-	account, err := solana.PublicKeyFromBase58(address)
+	bts, info, err := b.read(ctx, address, params, confidence)
 	if err != nil {
 		result.err <- err
 
 		return
 	}
 
-	bts, err := b.reader.ReadAll(ctx, account, b.opts)
-	if err != nil {
-		result.err <- fmt.Errorf("%w: failed to get binary data", err)
-
-		return
-	}
-
 	select {
 	case <-ctx.Done():
 		result.err <- ctx.Err()
 	default:
-		result.value <- bts
+		result.value <- loadedValue{data: bts, info: info}
 	}
 }
 
-func (b *accountReadBinding) GetLatestValue(ctx context.Context, address string, _ any, outVal any, result *loadedResult) error {
+func (b *accountReadBinding) GetLatestValue(ctx context.Context, address string, params any, outVal any, confidence primitives.ConfidenceLevel, result *loadedResult) (readInfo, error) {
 	var (
-		bts []byte
-		err error
+		bts  []byte
+		info readInfo
+		err  error
 	)
 
 	if result != nil {
@@ -77,25 +75,53 @@ func (b *accountReadBinding) GetLatestValue(ctx context.Context, address string,
 		select {
 		case <-ctx.Done():
 			err = ctx.Err()
-		case bts = <-result.value:
+		case loaded := <-result.value:
+			bts, info = loaded.data, loaded.info
 		case err = <-result.err:
 		}
 
 		if err != nil {
-			return err
-		}
-	} else {
-		account, err := solana.PublicKeyFromBase58(address)
-		if err != nil {
-			return err
+			return readInfo{}, err
 		}
+	} else if bts, info, err = b.read(ctx, address, params, confidence); err != nil {
+		return readInfo{}, err
+	}
 
-		if bts, err = b.reader.ReadAll(ctx, account, b.opts); err != nil {
-			return fmt.Errorf("%w: failed to get binary data", err)
-		}
+	if err = b.codec.Decode(ctx, bts, outVal, b.idlAccount); err != nil {
+		return readInfo{}, err
+	}
+
+	return info, nil
+}
+
+// read reads the account data with the commitment of the confidence level
+func (b *accountReadBinding) read(ctx context.Context, address string, params any, confidence primitives.ConfidenceLevel) ([]byte, readInfo, error) {
+	account, err := b.account(address, params)
+	if err != nil {
+		return nil, readInfo{}, err
+	}
+
+	opts, err := confidenceOpts(confidence, b.opts)
+	if err != nil {
+		return nil, readInfo{}, err
+	}
+
+	bts, slot, err := readWithSlot(ctx, b.reader, account, opts)
+	if err != nil {
+		return nil, readInfo{}, fmt.Errorf("%w: failed to get binary data", err)
+	}
+
+	return bts, readInfo{commitment: opts.Commitment, slot: slot}, nil
+}
+
+// account returns the address to read, the bound address or the PDA derived from it
+func (b *accountReadBinding) account(address string, params any) (solana.PublicKey, error) {
+	account, err := solana.PublicKeyFromBase58(address)
+	if err != nil || b.pda == nil {
+		return account, err
 	}
 
-	return b.codec.Decode(ctx, bts, outVal, b.idlAccount)
+	return b.pda.address(account, params)
 }
 
 func (b *accountReadBinding) CreateType(_ bool) (any, error) {
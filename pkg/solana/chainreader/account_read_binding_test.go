@@ -15,6 +15,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/codec/encodings"
 	"github.com/smartcontractkit/chainlink-common/pkg/codec/encodings/binary"
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
 )
 
 func TestPreload(t *testing.T) {
@@ -26,7 +27,7 @@ func TestPreload(t *testing.T) {
 		t.Parallel()
 
 		reader := new(mockReader)
-		binding := newAccountReadBinding(testCodecKey, testCodec, reader, nil)
+		binding := newAccountReadBinding(testCodecKey, testCodec, reader, nil, nil)
 
 		expected := testStruct{A: true, B: 42}
 		bts, err := testCodec.Encode(context.Background(), expected, testCodecKey)
@@ -38,17 +39,17 @@ func TestPreload(t *testing.T) {
 		ctx := context.Background()
 		start := time.Now()
 		loaded := &loadedResult{
-			value: make(chan []byte, 1),
+			value: make(chan loadedValue, 1),
 			err:   make(chan error, 1),
 		}
 
 		pubKey := solana.NewWallet().PublicKey()
 
-		binding.PreLoad(ctx, pubKey.String(), loaded)
+		binding.PreLoad(ctx, pubKey.String(), nil, primitives.Unconfirmed, loaded)
 
 		var result testStruct
 
-		err = binding.GetLatestValue(ctx, pubKey.String(), nil, &result, loaded)
+		_, err = binding.GetLatestValue(ctx, pubKey.String(), nil, &result, primitives.Unconfirmed, loaded)
 		elapsed := time.Since(start)
 
 		require.NoError(t, err)
@@ -61,7 +62,7 @@ func TestPreload(t *testing.T) {
 		t.Parallel()
 
 		reader := new(mockReader)
-		binding := newAccountReadBinding(testCodecKey, testCodec, reader, nil)
+		binding := newAccountReadBinding(testCodecKey, testCodec, reader, nil, nil)
 
 		ctx, cancel := context.WithCancelCause(context.Background())
 
@@ -78,14 +79,14 @@ func TestPreload(t *testing.T) {
 
 		pubKey := solana.NewWallet().PublicKey()
 		loaded := &loadedResult{
-			value: make(chan []byte, 1),
+			value: make(chan loadedValue, 1),
 			err:   make(chan error, 1),
 		}
 		start := time.Now()
-		binding.PreLoad(ctx, pubKey.String(), loaded)
+		binding.PreLoad(ctx, pubKey.String(), nil, primitives.Unconfirmed, loaded)
 
 		var result testStruct
-		err := binding.GetLatestValue(ctx, pubKey.String(), nil, &result, loaded)
+		_, err := binding.GetLatestValue(ctx, pubKey.String(), nil, &result, primitives.Unconfirmed, loaded)
 		elapsed := time.Since(start)
 
 		assert.ErrorIs(t, err, ctx.Err())
@@ -98,7 +99,7 @@ func TestPreload(t *testing.T) {
 		t.Parallel()
 
 		reader := new(mockReader)
-		binding := newAccountReadBinding(testCodecKey, testCodec, reader, nil)
+		binding := newAccountReadBinding(testCodecKey, testCodec, reader, nil, nil)
 		ctx := context.Background()
 		expectedErr := errors.New("test error")
 
@@ -107,13 +108,13 @@ func TestPreload(t *testing.T) {
 
 		pubKey := solana.NewWallet().PublicKey()
 		loaded := &loadedResult{
-			value: make(chan []byte, 1),
+			value: make(chan loadedValue, 1),
 			err:   make(chan error, 1),
 		}
-		binding.PreLoad(ctx, pubKey.String(), loaded)
+		binding.PreLoad(ctx, pubKey.String(), nil, primitives.Unconfirmed, loaded)
 
 		var result testStruct
-		err := binding.GetLatestValue(ctx, pubKey.String(), nil, &result, loaded)
+		_, err := binding.GetLatestValue(ctx, pubKey.String(), nil, &result, primitives.Unconfirmed, loaded)
 
 		assert.ErrorIs(t, err, expectedErr)
 	})
@@ -0,0 +1,169 @@
+package chainreader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	ag_solana "github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/gagliardetto/solana-go/rpc/ws"
+)
+
+// wsAccountSubscriber implements AccountSubscriber with accountSubscribe over a single WebSocket connection.
+// Any subscription error closes the connection, so all of its subscriptions fail and the next subscription
+// reconnects. This prevents subscriptions on a broken connection from silently receiving no updates.
+type wsAccountSubscriber struct {
+	endpoint string
+
+	mu     sync.Mutex
+	client *ws.Client
+}
+
+var _ AccountSubscriber = &wsAccountSubscriber{}
+
+func newWSAccountSubscriber(endpoint string) *wsAccountSubscriber {
+	return &wsAccountSubscriber{endpoint: endpoint}
+}
+
+func (s *wsAccountSubscriber) SubscribeAccount(ctx context.Context, account ag_solana.PublicKey, commitment rpc.CommitmentType) (AccountSubscription, error) {
+	client, err := s.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sub, err := client.AccountSubscribeWithOpts(account, commitment, ag_solana.EncodingBase64)
+	if err != nil {
+		s.disconnect(client)
+
+		return nil, fmt.Errorf("failed to subscribe to account %s: %w", account, err)
+	}
+
+	return newWSAccountSubscription(sub, func() { s.disconnect(client) }), nil
+}
+
+// Close closes the WebSocket connection, which stops all subscriptions.
+func (s *wsAccountSubscriber) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.client != nil {
+		s.client.Close()
+		s.client = nil
+	}
+}
+
+func (s *wsAccountSubscriber) connect(ctx context.Context) (*ws.Client, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.client != nil {
+		return s.client, nil
+	}
+
+	client, err := ws.Connect(ctx, s.endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the WebSocket endpoint: %w", err)
+	}
+
+	s.client = client
+
+	return client, nil
+}
+
+// disconnect closes client if it is still the active connection
+func (s *wsAccountSubscriber) disconnect(client *ws.Client) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.client == client {
+		s.client.Close()
+		s.client = nil
+	}
+}
+
+type accountUpdate struct {
+	data []byte
+	slot uint64
+}
+
+// wsAccountSubscription forwards the notifications of a WebSocket subscription, which can't be received with
+// a context, so that Recv returns once the context is done.
+type wsAccountSubscription struct {
+	sub     *ws.AccountSubscription
+	failed  func() // called when the subscription fails
+	updates chan accountUpdate
+	stop    chan struct{} // closed by Unsubscribe
+	done    chan struct{} // closed once the subscription ended, err is set before
+	err     error
+	once    sync.Once
+}
+
+var _ AccountSubscription = &wsAccountSubscription{}
+
+func newWSAccountSubscription(sub *ws.AccountSubscription, failed func()) *wsAccountSubscription {
+	s := &wsAccountSubscription{
+		sub:     sub,
+		failed:  failed,
+		updates: make(chan accountUpdate),
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+
+	go s.forward()
+
+	return s
+}
+
+func (s *wsAccountSubscription) Recv(ctx context.Context) ([]byte, uint64, error) {
+	select {
+	case update := <-s.updates:
+		return update.data, update.slot, nil
+	case <-s.done:
+		return nil, 0, s.err
+	case <-ctx.Done():
+		return nil, 0, ctx.Err()
+	}
+}
+
+func (s *wsAccountSubscription) Unsubscribe() {
+	s.once.Do(func() {
+		close(s.stop)
+		s.sub.Unsubscribe()
+	})
+}
+
+func (s *wsAccountSubscription) forward() {
+	defer close(s.done)
+
+	for {
+		res, err := s.sub.Recv()
+		if err != nil {
+			s.err = fmt.Errorf("account subscription failed: %w", err)
+			s.failed()
+
+			return
+		}
+
+		// the subscription returns no result and no error once unsubscribed
+		if res == nil {
+			s.err = errors.New("account subscription stopped")
+
+			return
+		}
+
+		update := accountUpdate{slot: res.Context.Slot}
+		if res.Value.Data != nil {
+			update.data = res.Value.Data.GetBinary()
+		}
+
+		select {
+		case s.updates <- update:
+		case <-s.stop:
+			s.err = errors.New("account subscription stopped")
+
+			return
+		}
+	}
+}
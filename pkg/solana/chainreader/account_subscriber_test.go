@@ -0,0 +1,220 @@
+package chainreader
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWSAccountSubscriber(t *testing.T) {
+	t.Parallel()
+
+	account := solana.NewWallet().PublicKey()
+
+	t.Run("receives account notifications", func(t *testing.T) {
+		t.Parallel()
+
+		server := newMockWSServer(t)
+		subscriber := newWSAccountSubscriber(server.url)
+		defer subscriber.Close()
+
+		ctx := context.Background()
+		sub, err := subscriber.SubscribeAccount(ctx, account, rpc.CommitmentFinalized)
+		require.NoError(t, err)
+		defer sub.Unsubscribe()
+
+		subID := server.subscription(t)
+		server.notify(t, subID, []byte{1, 2}, 5)
+
+		data, slot, err := sub.Recv(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{1, 2}, data)
+		assert.Equal(t, uint64(5), slot)
+
+		// Recv returns once the context is done
+		cancelled, cancel := context.WithCancel(ctx)
+		cancel()
+		_, _, err = sub.Recv(cancelled)
+		require.ErrorIs(t, err, context.Canceled)
+
+		// both subscriptions share the connection
+		_, err = subscriber.SubscribeAccount(ctx, solana.NewWallet().PublicKey(), rpc.CommitmentFinalized)
+		require.NoError(t, err)
+		server.subscription(t)
+		assert.Equal(t, 1, server.connections())
+	})
+
+	t.Run("stops receiving once unsubscribed", func(t *testing.T) {
+		t.Parallel()
+
+		server := newMockWSServer(t)
+		subscriber := newWSAccountSubscriber(server.url)
+		defer subscriber.Close()
+
+		ctx := context.Background()
+		sub, err := subscriber.SubscribeAccount(ctx, account, rpc.CommitmentFinalized)
+		require.NoError(t, err)
+		server.subscription(t)
+
+		sub.Unsubscribe()
+		sub.Unsubscribe()
+
+		_, _, err = sub.Recv(ctx)
+		require.Error(t, err)
+	})
+
+	t.Run("reconnects after the connection failed", func(t *testing.T) {
+		t.Parallel()
+
+		server := newMockWSServer(t)
+		subscriber := newWSAccountSubscriber(server.url)
+		defer subscriber.Close()
+
+		ctx := context.Background()
+		sub, err := subscriber.SubscribeAccount(ctx, account, rpc.CommitmentFinalized)
+		require.NoError(t, err)
+		defer sub.Unsubscribe()
+		server.subscription(t)
+
+		server.disconnect(t)
+
+		_, _, err = sub.Recv(ctx)
+		require.Error(t, err)
+
+		sub, err = subscriber.SubscribeAccount(ctx, account, rpc.CommitmentFinalized)
+		require.NoError(t, err)
+		defer sub.Unsubscribe()
+
+		subID := server.subscription(t)
+		server.notify(t, subID, []byte{3}, 6)
+
+		data, slot, err := sub.Recv(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{3}, data)
+		assert.Equal(t, uint64(6), slot)
+		assert.Equal(t, 2, server.connections())
+	})
+}
+
+// mockWSServer confirms accountSubscribe requests and sends notifications on the latest connection
+type mockWSServer struct {
+	url           string
+	subscriptions chan uint64
+
+	mu    sync.Mutex
+	conns []*websocket.Conn
+	subID uint64
+}
+
+func newMockWSServer(t *testing.T) *mockWSServer {
+	t.Helper()
+
+	s := &mockWSServer{subscriptions: make(chan uint64, 10)}
+	upgrader := websocket.Upgrader{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+
+		s.mu.Lock()
+		s.conns = append(s.conns, conn)
+		s.mu.Unlock()
+
+		for {
+			_, message, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+
+			var req struct {
+				ID     uint64 `json:"id"`
+				Method string `json:"method"`
+			}
+			if err = json.Unmarshal(message, &req); err != nil || req.Method != "accountSubscribe" {
+				continue
+			}
+
+			s.mu.Lock()
+			s.subID++
+			subID := s.subID
+			err = conn.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(`{"jsonrpc":"2.0","result":%d,"id":%d}`, subID, req.ID)))
+			s.mu.Unlock()
+
+			if err != nil {
+				return
+			}
+
+			s.subscriptions <- subID
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	s.url = "ws" + strings.TrimPrefix(server.URL, "http")
+
+	return s
+}
+
+func (s *mockWSServer) subscription(t *testing.T) uint64 {
+	t.Helper()
+
+	select {
+	case subID := <-s.subscriptions:
+		return subID
+	case <-time.After(time.Second):
+		t.Fatal("no subscription request received")
+
+		return 0
+	}
+}
+
+func (s *mockWSServer) notify(t *testing.T, subID uint64, data []byte, slot uint64) {
+	t.Helper()
+
+	value, err := json.Marshal(map[string]any{
+		"context": map[string]any{"slot": slot},
+		"value": map[string]any{
+			"data":       rpc.DataBytesOrJSONFromBytes(data),
+			"executable": false,
+			"lamports":   1,
+			"owner":      solana.SystemProgramID,
+			"rentEpoch":  0,
+		},
+	})
+	require.NoError(t, err)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	message := fmt.Sprintf(`{"jsonrpc":"2.0","method":"accountNotification","params":{"result":%s,"subscription":%d}}`, value, subID)
+	require.NoError(t, s.conns[len(s.conns)-1].WriteMessage(websocket.TextMessage, []byte(message)))
+}
+
+func (s *mockWSServer) disconnect(t *testing.T) {
+	t.Helper()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	require.NoError(t, s.conns[len(s.conns)-1].Close())
+}
+
+func (s *mockWSServer) connections() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.conns)
+}
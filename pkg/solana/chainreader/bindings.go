@@ -8,11 +8,12 @@ import (
 	"github.com/gagliardetto/solana-go"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
 )
 
 type readBinding interface {
-	PreLoad(context.Context, string, *loadedResult)
-	GetLatestValue(ctx context.Context, address string, params, returnVal any, preload *loadedResult) error
+	PreLoad(ctx context.Context, address string, params any, confidence primitives.ConfidenceLevel, preload *loadedResult)
+	GetLatestValue(ctx context.Context, address string, params, returnVal any, confidence primitives.ConfidenceLevel, preload *loadedResult) (readInfo, error)
 	CreateType(bool) (any, error)
 }
 
@@ -133,6 +134,12 @@ func (b namespaceBindings) Bind(binding types.BoundContract) error {
 }
 
 type loadedResult struct {
-	value chan []byte
+	value chan loadedValue
 	err   chan error
 }
+
+// loadedValue is the account data of a preload and the read it was loaded with
+type loadedValue struct {
+	data []byte
+	info readInfo
+}
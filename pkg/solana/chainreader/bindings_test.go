@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
 )
 
 func TestBindings_CreateType(t *testing.T) {
@@ -105,10 +106,11 @@ type mockBinding struct {
 	mock.Mock
 }
 
-func (_m *mockBinding) PreLoad(context.Context, string, *loadedResult) {}
+func (_m *mockBinding) PreLoad(context.Context, string, any, primitives.ConfidenceLevel, *loadedResult) {
+}
 
-func (_m *mockBinding) GetLatestValue(ctx context.Context, address string, params, returnVal any, _ *loadedResult) error {
-	return nil
+func (_m *mockBinding) GetLatestValue(ctx context.Context, address string, params, returnVal any, _ primitives.ConfidenceLevel, _ *loadedResult) (readInfo, error) {
+	return readInfo{}, nil
 }
 
 func (_m *mockBinding) CreateType(b bool) (any, error) {
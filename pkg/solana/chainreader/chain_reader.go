@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"reflect"
 	"sync"
+	"time"
 
 	ag_solana "github.com/gagliardetto/solana-go"
 	"github.com/gagliardetto/solana-go/rpc"
@@ -36,6 +37,7 @@ type SolanaChainReaderService struct {
 	// internal values
 	bindings namespaceBindings
 	lookup   *lookup
+	caches   []*accountCache
 
 	// service state management
 	wg sync.WaitGroup
@@ -73,6 +75,10 @@ func (s *SolanaChainReaderService) Name() string {
 // and error.
 func (s *SolanaChainReaderService) Start(_ context.Context) error {
 	return s.StartOnce(ServiceName, func() error {
+		for _, cache := range s.caches {
+			cache.start()
+		}
+
 		return nil
 	})
 }
@@ -83,6 +89,10 @@ func (s *SolanaChainReaderService) Close() error {
 	return s.StopOnce(ServiceName, func() error {
 		s.wg.Wait()
 
+		for _, cache := range s.caches {
+			cache.Close()
+		}
+
 		return nil
 	})
 }
@@ -100,8 +110,10 @@ func (s *SolanaChainReaderService) HealthReport() map[string]error {
 }
 
 // GetLatestValue implements the types.ContractReader interface and requests and parses on-chain
-// data named by the provided contract, method, and params.
-func (s *SolanaChainReaderService) GetLatestValue(ctx context.Context, readIdentifier string, _ primitives.ConfidenceLevel, params any, returnVal any) error {
+// data named by the provided contract, method, and params. The confidence level is read with the
+// commitment finalized for primitives.Finalized, and confirmed, or processed if configured, for
+// primitives.Unconfirmed. Pass a *ReadResult as returnVal to also get the commitment and slot.
+func (s *SolanaChainReaderService) GetLatestValue(ctx context.Context, readIdentifier string, confidenceLevel primitives.ConfidenceLevel, params any, returnVal any) error {
 	if err := s.Ready(); err != nil {
 		return err
 	}
@@ -133,38 +145,67 @@ func (s *SolanaChainReaderService) GetLatestValue(ctx context.Context, readIdent
 		return fmt.Errorf("%w: addresses and bindings lengths do not match", types.ErrInvalidConfig)
 	}
 
+	// if the returnVal is a *ReadResult, read into its value and add the commitment and slot of the read
+	readResult, isReadResult := returnVal.(*ReadResult)
+	if isReadResult {
+		returnVal = readResult.Value
+	}
+
+	info, err := s.readValue(ctx, vals, bindings, addresses, confidenceLevel, params, returnVal)
+	if err != nil {
+		return err
+	}
+
+	if isReadResult {
+		readResult.Commitment = info.commitment
+		readResult.Slot = info.slot
+	}
+
+	return nil
+}
+
+func (s *SolanaChainReaderService) readValue(
+	ctx context.Context,
+	vals readValues,
+	bindings []readBinding,
+	addresses []string,
+	confidence primitives.ConfidenceLevel,
+	params, returnVal any,
+) (readInfo, error) {
 	// if the returnVal is not a *values.Value, run normally without using the ptrToValue
 	ptrToValue, isValue := returnVal.(*values.Value)
 	if !isValue {
-		return s.runAllBindings(ctx, bindings, addresses, params, returnVal)
+		return s.runAllBindings(ctx, bindings, addresses, confidence, params, returnVal)
 	}
 
 	// if the returnVal is a *values.Value, create the type from the contract, run normally, and wrap the value
 	contractType, err := s.bindings.CreateType(vals.contract, vals.readName, false)
 	if err != nil {
-		return err
+		return readInfo{}, err
 	}
 
-	if err = s.runAllBindings(ctx, bindings, addresses, params, contractType); err != nil {
-		return err
+	info, err := s.runAllBindings(ctx, bindings, addresses, confidence, params, contractType)
+	if err != nil {
+		return readInfo{}, err
 	}
 
 	value, err := values.Wrap(contractType)
 	if err != nil {
-		return err
+		return readInfo{}, err
 	}
 
 	*ptrToValue = value
 
-	return nil
+	return info, nil
 }
 
 func (s *SolanaChainReaderService) runAllBindings(
 	ctx context.Context,
 	bindings []readBinding,
 	addresses []string,
+	confidence primitives.ConfidenceLevel,
 	params, returnVal any,
-) error {
+) (readInfo, error) {
 	localCtx, localCancel := context.WithCancel(ctx)
 
 	// the wait group ensures GetLatestValue returns only after all go-routines have completed
@@ -188,13 +229,13 @@ func (s *SolanaChainReaderService) runAllBindings(
 
 			wg.Wait()
 
-			return fmt.Errorf("%w: multiple bindings is only supported for struct and map", types.ErrInvalidType)
+			return readInfo{}, fmt.Errorf("%w: multiple bindings is only supported for struct and map", types.ErrInvalidType)
 		}
 
 		// for multiple bindings, preload the remote data in parallel
 		for idx, binding := range bindings {
 			results[idx] = &loadedResult{
-				value: make(chan []byte, 1),
+				value: make(chan loadedValue, 1),
 				err:   make(chan error, 1),
 			}
 
@@ -202,7 +243,7 @@ func (s *SolanaChainReaderService) runAllBindings(
 			go func(ctx context.Context, rb readBinding, res *loadedResult, address string) {
 				defer wg.Done()
 
-				rb.PreLoad(ctx, address, res)
+				rb.PreLoad(ctx, address, params, confidence, res)
 			}(localCtx, binding, results[idx], addresses[idx])
 		}
 	}
@@ -211,13 +252,21 @@ func (s *SolanaChainReaderService) runAllBindings(
 	// sequence because the function will block until the data is loaded.
 	// in the case of no preloading, GetLatestValue will load and decode in
 	// sequence.
+	var info readInfo
 	for idx, binding := range bindings {
-		if err := binding.GetLatestValue(ctx, addresses[idx], params, returnVal, results[idx]); err != nil {
+		bindingInfo, err := binding.GetLatestValue(ctx, addresses[idx], params, returnVal, confidence, results[idx])
+		if err != nil {
 			localCancel()
 
 			wg.Wait()
 
-			return err
+			return readInfo{}, err
+		}
+
+		if idx == 0 {
+			info = bindingInfo
+		} else {
+			info = info.merge(bindingInfo)
 		}
 	}
 
@@ -225,7 +274,7 @@ func (s *SolanaChainReaderService) runAllBindings(
 
 	wg.Wait()
 
-	return nil
+	return info, nil
 }
 
 // BatchGetLatestValues implements the types.ContractReader interface.
@@ -281,7 +330,18 @@ func (s *SolanaChainReaderService) init(namespaces map[string]config.ChainReader
 				return err
 			}
 
-			idlCodec, err := codec.NewIDLAccountCodec(idl, config.BuilderForEncoding(method.Encoding))
+			// bincode is used by native programs, their accounts don't have an Anchor discriminator
+			newCodec := codec.NewIDLAccountCodec
+			if method.Encoding == config.EncodingTypeBincode {
+				newCodec = codec.NewIDLNativeAccountCodec
+			}
+
+			// zero-copy accounts of legacy IDLs don't declare their repr(C) layout
+			if method.Encoding == config.EncodingTypeZeroCopy {
+				newCodec = codec.NewIDLZeroCopyAccountCodec
+			}
+
+			idlCodec, err := newCodec(idl, config.BuilderForEncoding(method.Encoding))
 			if err != nil {
 				return err
 			}
@@ -301,11 +361,35 @@ func (s *SolanaChainReaderService) init(namespaces map[string]config.ChainReader
 					return err
 				}
 
+				if procedure.ProgramAccounts != nil {
+					binding, err := s.newProgramAccountsBinding(idl, method, procedure, codecWithModifiers)
+					if err != nil {
+						return err
+					}
+
+					s.bindings.AddReadBinding(namespace, methodName, binding)
+
+					continue
+				}
+
+				pda, err := newPDAResolver(procedure.PDA)
+				if err != nil {
+					return err
+				}
+
+				reader := s.client
+				if procedure.Cache != nil {
+					if reader, err = s.newAccountCache(procedure); err != nil {
+						return err
+					}
+				}
+
 				s.bindings.AddReadBinding(namespace, methodName, newAccountReadBinding(
 					procedure.IDLAccount,
 					codecWithModifiers,
-					s.client,
+					reader,
 					createRPCOpts(procedure.RPCOpts),
+					pda,
 				))
 			}
 		}
@@ -314,6 +398,84 @@ func (s *SolanaChainReaderService) init(namespaces map[string]config.ChainReader
 	return nil
 }
 
+func (s *SolanaChainReaderService) newProgramAccountsBinding(
+	idl codec.IDL,
+	method config.ChainDataReader,
+	procedure config.ChainReaderProcedure,
+	remoteCodec types.RemoteCodec,
+) (*programAccountsBinding, error) {
+	// a program accounts read returns a slice, which can't be merged with the results of other procedures
+	if len(method.Procedures) != 1 {
+		return nil, fmt.Errorf("%w: program accounts must be the only procedure of a method", types.ErrInvalidConfig)
+	}
+
+	if procedure.Cache != nil {
+		return nil, fmt.Errorf("%w: program accounts can't be cached", types.ErrInvalidConfig)
+	}
+
+	reader, ok := s.client.(ProgramAccountsReader)
+	if !ok {
+		return nil, fmt.Errorf("%w: reader does not support program accounts", types.ErrInvalidConfig)
+	}
+
+	var discriminator []byte
+	if method.Encoding != config.EncodingTypeBincode {
+		var err error
+		if discriminator, err = codec.AccountDiscriminator(idl, procedure.IDLAccount); err != nil {
+			return nil, err
+		}
+	}
+
+	return newProgramAccountsBinding(
+		procedure.IDLAccount,
+		remoteCodec,
+		reader,
+		createRPCOpts(procedure.RPCOpts),
+		discriminator,
+		procedure.ProgramAccounts,
+	)
+}
+
+func (s *SolanaChainReaderService) newAccountCache(procedure config.ChainReaderProcedure) (*accountCache, error) {
+	policy := procedure.Cache
+
+	var ttl time.Duration
+	if policy.TTL != nil {
+		ttl = policy.TTL.Duration()
+	}
+
+	if ttl <= 0 && !policy.Subscribe {
+		return nil, fmt.Errorf("%w: cache policy must have a TTL or subscribe", types.ErrInvalidConfig)
+	}
+
+	idleTimeout := defaultCacheIdleTimeout
+	if policy.IdleTimeout != nil {
+		idleTimeout = policy.IdleTimeout.Duration()
+	}
+
+	if idleTimeout <= 0 {
+		return nil, fmt.Errorf("%w: cache policy idle timeout must be positive", types.ErrInvalidConfig)
+	}
+
+	var subscriber AccountSubscriber
+	if policy.Subscribe {
+		var ok bool
+		if subscriber, ok = s.client.(AccountSubscriber); !ok {
+			return nil, fmt.Errorf("%w: reader does not support account subscriptions", types.ErrInvalidConfig)
+		}
+
+		// notifications contain the whole account
+		if procedure.RPCOpts != nil && procedure.RPCOpts.DataSlice != nil {
+			return nil, fmt.Errorf("%w: cache subscriptions can't be used with a data slice", types.ErrInvalidConfig)
+		}
+	}
+
+	cache := newAccountCache(logger.Named(s.lggr, "AccountCache"), s.client, subscriber, ttl, idleTimeout)
+	s.caches = append(s.caches, cache)
+
+	return cache, nil
+}
+
 // injectAddressModifier injects AddressModifier into OutputModifications.
 // This is necessary because AddressModifier cannot be serialized and must be applied at runtime.
 func injectAddressModifier(outputModifications codeccommon.ModifiersConfig) {
@@ -349,19 +511,66 @@ type accountDataReader struct {
 	client *rpc.Client
 }
 
+var (
+	_ SlotDataReader        = &accountDataReader{}
+	_ ProgramAccountsReader = &accountDataReader{}
+)
+
 func NewAccountDataReader(client *rpc.Client) *accountDataReader {
 	return &accountDataReader{client: client}
 }
 
+// subscribingAccountDataReader is an accountDataReader that also supports the account subscriptions of
+// cache policies with subscribe set.
+type subscribingAccountDataReader struct {
+	*accountDataReader
+	*wsAccountSubscriber
+}
+
+var _ AccountSubscriber = &subscribingAccountDataReader{}
+
+// NewSubscribingAccountDataReader returns an account data reader that subscribes to accounts over the
+// WebSocket endpoint wsEndpoint. Close must be called to close the WebSocket connection.
+func NewSubscribingAccountDataReader(client *rpc.Client, wsEndpoint string) *subscribingAccountDataReader {
+	return &subscribingAccountDataReader{
+		accountDataReader:   NewAccountDataReader(client),
+		wsAccountSubscriber: newWSAccountSubscriber(wsEndpoint),
+	}
+}
+
 func (r *accountDataReader) ReadAll(ctx context.Context, pk ag_solana.PublicKey, opts *rpc.GetAccountInfoOpts) ([]byte, error) {
+	bts, _, err := r.ReadAllWithSlot(ctx, pk, opts)
+
+	return bts, err
+}
+
+func (r *accountDataReader) ReadAllWithSlot(ctx context.Context, pk ag_solana.PublicKey, opts *rpc.GetAccountInfoOpts) ([]byte, uint64, error) {
 	result, err := r.client.GetAccountInfoWithOpts(ctx, pk, opts)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	bts := result.Value.Data.GetBinary()
 
-	return bts, nil
+	return bts, result.Context.Slot, nil
+}
+
+func (r *accountDataReader) ReadProgramAccounts(ctx context.Context, pk ag_solana.PublicKey, opts *rpc.GetProgramAccountsOpts) ([][]byte, error) {
+	result, err := r.client.GetProgramAccountsWithOpts(ctx, pk, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([][]byte, 0, len(result))
+	for _, account := range result {
+		if account == nil || account.Account == nil {
+			continue
+		}
+
+		accounts = append(accounts, account.Account.Data.GetBinary())
+	}
+
+	return accounts, nil
 }
 
 func decodeAddressMappings(encoded string) (map[string][]string, error) {
@@ -898,7 +898,12 @@ func makeTestCodec(t *testing.T, rawIDL string, encoding config.EncodingType) ty
 		t.FailNow()
 	}
 
-	testCodec, err := codec.NewIDLAccountCodec(idl, config.BuilderForEncoding(encoding))
+	newCodec := codec.NewIDLAccountCodec
+	if encoding == config.EncodingTypeBincode {
+		newCodec = codec.NewIDLNativeAccountCodec
+	}
+
+	testCodec, err := newCodec(idl, config.BuilderForEncoding(encoding))
 	if err != nil {
 		t.Logf("failed to create new codec from test IDL: %s", err.Error())
 		t.FailNow()
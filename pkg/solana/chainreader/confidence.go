@@ -0,0 +1,87 @@
+package chainreader
+
+import (
+	"fmt"
+
+	"github.com/gagliardetto/solana-go/rpc"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+)
+
+// ReadResult can be passed as the return value of GetLatestValue to also get the commitment and the
+// context slot the value was read at. Value must be a pointer the value is decoded into, or a
+// *values.Value.
+type ReadResult struct {
+	Value any
+	// Commitment is the commitment of the read. For reads of multiple procedures, it's the lowest
+	// commitment of the procedures.
+	Commitment rpc.CommitmentType
+	// Slot is the context slot of the read. For reads of multiple procedures, it's the lowest slot
+	// of the procedures. It's 0 if the reader doesn't return the slot, e.g. for program accounts.
+	Slot uint64
+}
+
+// readInfo is the commitment and the context slot of a read
+type readInfo struct {
+	commitment rpc.CommitmentType
+	slot       uint64
+}
+
+// merge returns the lowest commitment and slot of both reads
+func (r readInfo) merge(other readInfo) readInfo {
+	if commitmentRank(other.commitment) < commitmentRank(r.commitment) {
+		r.commitment = other.commitment
+	}
+
+	if other.slot < r.slot {
+		r.slot = other.slot
+	}
+
+	return r
+}
+
+func commitmentRank(commitment rpc.CommitmentType) int {
+	switch commitment {
+	case rpc.CommitmentProcessed:
+		return 0
+	case rpc.CommitmentConfirmed:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// confidenceCommitment maps the confidence level to the commitment of a read. Finalized reads use
+// finalized, unconfirmed reads use processed if configured in the RPC opts and confirmed otherwise.
+func confidenceCommitment(confidence primitives.ConfidenceLevel, opts *rpc.GetAccountInfoOpts) (rpc.CommitmentType, error) {
+	switch confidence {
+	case primitives.Finalized:
+		return rpc.CommitmentFinalized, nil
+	case primitives.Unconfirmed:
+		if opts != nil && opts.Commitment == rpc.CommitmentProcessed {
+			return rpc.CommitmentProcessed, nil
+		}
+
+		return rpc.CommitmentConfirmed, nil
+	default:
+		return "", fmt.Errorf("%w: unknown confidence level %s", types.ErrInvalidType, confidence)
+	}
+}
+
+// confidenceOpts returns a copy of opts with the commitment of the confidence level
+func confidenceOpts(confidence primitives.ConfidenceLevel, opts *rpc.GetAccountInfoOpts) (*rpc.GetAccountInfoOpts, error) {
+	commitment, err := confidenceCommitment(confidence, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var result rpc.GetAccountInfoOpts
+	if opts != nil {
+		result = *opts
+	}
+
+	result.Commitment = commitment
+
+	return &result, nil
+}
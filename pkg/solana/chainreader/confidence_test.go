@@ -0,0 +1,103 @@
+package chainreader
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+)
+
+func TestConfidenceCommitment(t *testing.T) {
+	t.Parallel()
+
+	processed := &rpc.GetAccountInfoOpts{Commitment: rpc.CommitmentProcessed}
+	finalized := &rpc.GetAccountInfoOpts{Commitment: rpc.CommitmentFinalized}
+
+	for _, test := range []struct {
+		name       string
+		confidence primitives.ConfidenceLevel
+		opts       *rpc.GetAccountInfoOpts
+		expected   rpc.CommitmentType
+	}{
+		{name: "finalized", confidence: primitives.Finalized, expected: rpc.CommitmentFinalized},
+		{name: "finalized ignores configured commitment", confidence: primitives.Finalized, opts: processed, expected: rpc.CommitmentFinalized},
+		{name: "unconfirmed", confidence: primitives.Unconfirmed, expected: rpc.CommitmentConfirmed},
+		{name: "unconfirmed with processed", confidence: primitives.Unconfirmed, opts: processed, expected: rpc.CommitmentProcessed},
+		{name: "unconfirmed with finalized", confidence: primitives.Unconfirmed, opts: finalized, expected: rpc.CommitmentConfirmed},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			commitment, err := confidenceCommitment(test.confidence, test.opts)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, commitment)
+		})
+	}
+
+	t.Run("unknown confidence level", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := confidenceCommitment("unknown", nil)
+		assert.ErrorIs(t, err, types.ErrInvalidType)
+	})
+
+	t.Run("opts are copied", func(t *testing.T) {
+		t.Parallel()
+
+		opts, err := confidenceOpts(primitives.Unconfirmed, finalized)
+		require.NoError(t, err)
+		assert.Equal(t, rpc.CommitmentConfirmed, opts.Commitment)
+		assert.Equal(t, rpc.CommitmentFinalized, finalized.Commitment)
+	})
+}
+
+func TestReadInfo_Merge(t *testing.T) {
+	t.Parallel()
+
+	finalized := readInfo{commitment: rpc.CommitmentFinalized, slot: 10}
+	confirmed := readInfo{commitment: rpc.CommitmentConfirmed, slot: 12}
+	processed := readInfo{commitment: rpc.CommitmentProcessed, slot: 14}
+
+	assert.Equal(t, readInfo{commitment: rpc.CommitmentConfirmed, slot: 10}, finalized.merge(confirmed))
+	assert.Equal(t, readInfo{commitment: rpc.CommitmentConfirmed, slot: 10}, confirmed.merge(finalized))
+	assert.Equal(t, readInfo{commitment: rpc.CommitmentProcessed, slot: 12}, confirmed.merge(processed))
+}
+
+func TestAccountReadBinding_Confidence(t *testing.T) {
+	t.Parallel()
+
+	testCodec := makeTestCodec(t)
+	bts, err := testCodec.Encode(context.Background(), testStruct{A: true, B: 42}, testCodecKey)
+	require.NoError(t, err)
+
+	reader := &mockSlotReader{data: bts, slot: 42}
+	cache := newAccountCache(logger.Test(t), reader, nil, time.Minute, 0)
+	t.Cleanup(cache.Close)
+
+	binding := newAccountReadBinding(testCodecKey, testCodec, cache, nil, nil)
+	address := solana.NewWallet().PublicKey().String()
+
+	var result testStruct
+	info, err := binding.GetLatestValue(context.Background(), address, nil, &result, primitives.Finalized, nil)
+	require.NoError(t, err)
+	assert.Equal(t, readInfo{commitment: rpc.CommitmentFinalized, slot: 42}, info)
+	assert.Equal(t, testStruct{A: true, B: 42}, result)
+
+	// the unconfirmed value is cached separately from the finalized value
+	info, err = binding.GetLatestValue(context.Background(), address, nil, &result, primitives.Unconfirmed, nil)
+	require.NoError(t, err)
+	assert.Equal(t, readInfo{commitment: rpc.CommitmentConfirmed, slot: 42}, info)
+	assert.Equal(t, 2, reader.reads())
+
+	_, err = binding.GetLatestValue(context.Background(), address, nil, &result, primitives.Finalized, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, reader.reads())
+}
@@ -0,0 +1,131 @@
+package chainreader
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/gagliardetto/solana-go"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
+)
+
+// pdaResolver derives the account address of a read from the PDA seeds
+type pdaResolver struct {
+	programID solana.PublicKey
+	seeds     []config.PDASeed
+}
+
+func newPDAResolver(pda *config.PDA) (*pdaResolver, error) {
+	if pda == nil {
+		return nil, nil
+	}
+
+	programID, err := solana.PublicKeyFromBase58(pda.ProgramID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid PDA program ID: %s", types.ErrInvalidConfig, err.Error())
+	}
+
+	if len(pda.Seeds) == 0 {
+		return nil, fmt.Errorf("%w: PDA requires at least one seed", types.ErrInvalidConfig)
+	}
+
+	for idx, seed := range pda.Seeds {
+		set := 0
+		for _, isSet := range []bool{seed.Static != "", seed.Address, seed.Param != ""} {
+			if isSet {
+				set++
+			}
+		}
+
+		if set != 1 {
+			return nil, fmt.Errorf("%w: PDA seed at index %d must have exactly one of static, address or param", types.ErrInvalidConfig, idx)
+		}
+	}
+
+	return &pdaResolver{programID: programID, seeds: pda.Seeds}, nil
+}
+
+// address derives the PDA from the bound address and the params of the read
+func (r *pdaResolver) address(bound solana.PublicKey, params any) (solana.PublicKey, error) {
+	seeds := make([][]byte, len(r.seeds))
+	for idx, seed := range r.seeds {
+		switch {
+		case seed.Static != "":
+			seeds[idx] = []byte(seed.Static)
+		case seed.Address:
+			seeds[idx] = bound.Bytes()
+		default:
+			value, err := paramField(params, seed.Param)
+			if err != nil {
+				return solana.PublicKey{}, err
+			}
+
+			if seeds[idx], err = paramBytes(value); err != nil {
+				return solana.PublicKey{}, fmt.Errorf("%w: param %s: %s", types.ErrInvalidType, seed.Param, err.Error())
+			}
+		}
+	}
+
+	address, _, err := solana.FindProgramAddress(seeds, r.programID)
+	if err != nil {
+		return solana.PublicKey{}, fmt.Errorf("%w: failed to derive PDA: %s", types.ErrInvalidType, err.Error())
+	}
+
+	return address, nil
+}
+
+// paramField returns the named field of a params struct or map, names are case-insensitive
+func paramField(params any, name string) (reflect.Value, error) {
+	rParams := reflect.Indirect(reflect.ValueOf(params))
+
+	switch rParams.Kind() {
+	case reflect.Struct:
+		field := rParams.FieldByNameFunc(func(fieldName string) bool { return strings.EqualFold(fieldName, name) })
+		if field.IsValid() {
+			return reflect.Indirect(field), nil
+		}
+	case reflect.Map:
+		iter := rParams.MapRange()
+		for iter.Next() {
+			if key, ok := iter.Key().Interface().(string); ok && strings.EqualFold(key, name) {
+				return reflect.Indirect(reflect.ValueOf(iter.Value().Interface())), nil
+			}
+		}
+	default:
+	}
+
+	return reflect.Value{}, fmt.Errorf("%w: params do not have a field %s", types.ErrInvalidType, name)
+}
+
+// paramBytes returns the bytes of a param value used as PDA seed or memcmp filter
+func paramBytes(value reflect.Value) ([]byte, error) {
+	if !value.IsValid() {
+		return nil, errors.New("value must not be nil")
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		return []byte(value.String()), nil
+	case reflect.Slice, reflect.Array:
+		if value.Type().Elem().Kind() != reflect.Uint8 {
+			break
+		}
+
+		seed := make([]byte, value.Len())
+		reflect.Copy(reflect.ValueOf(seed), value)
+
+		return seed, nil
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uint:
+		return binary.LittleEndian.AppendUint64(nil, value.Uint())[:value.Type().Size()], nil
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Int:
+		return binary.LittleEndian.AppendUint64(nil, uint64(value.Int()))[:value.Type().Size()], nil //nolint:gosec // two's complement bytes
+	default:
+	}
+
+	return nil, fmt.Errorf("unsupported type %s", value.Type())
+}
@@ -0,0 +1,83 @@
+package chainreader
+
+import (
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
+)
+
+func TestPDAResolver(t *testing.T) {
+	t.Parallel()
+
+	programID := solana.NewWallet().PublicKey()
+	bound := solana.NewWallet().PublicKey()
+	oracle := solana.NewWallet().PublicKey()
+
+	resolver, err := newPDAResolver(&config.PDA{
+		ProgramID: programID.String(),
+		Seeds: []config.PDASeed{
+			{Static: "oracle"},
+			{Address: true},
+			{Param: "oracle"},
+			{Param: "Round"},
+		},
+	})
+	require.NoError(t, err)
+
+	expected, _, err := solana.FindProgramAddress([][]byte{
+		[]byte("oracle"), bound.Bytes(), oracle.Bytes(), {7, 0, 0, 0},
+	}, programID)
+	require.NoError(t, err)
+
+	t.Run("struct params", func(t *testing.T) {
+		t.Parallel()
+
+		address, err := resolver.address(bound, &struct {
+			Oracle solana.PublicKey
+			Round  uint32
+		}{Oracle: oracle, Round: 7})
+		require.NoError(t, err)
+		assert.Equal(t, expected, address)
+	})
+
+	t.Run("map params", func(t *testing.T) {
+		t.Parallel()
+
+		address, err := resolver.address(bound, map[string]any{"Oracle": oracle[:], "round": uint32(7)})
+		require.NoError(t, err)
+		assert.Equal(t, expected, address)
+	})
+
+	t.Run("missing or invalid params", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := resolver.address(bound, nil)
+		require.ErrorIs(t, err, types.ErrInvalidType)
+
+		_, err = resolver.address(bound, map[string]any{"Oracle": oracle, "Round": 1.5})
+		require.ErrorIs(t, err, types.ErrInvalidType)
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newPDAResolver(&config.PDA{ProgramID: "invalid", Seeds: []config.PDASeed{{Address: true}}})
+		require.ErrorIs(t, err, types.ErrInvalidConfig)
+
+		_, err = newPDAResolver(&config.PDA{ProgramID: programID.String()})
+		require.ErrorIs(t, err, types.ErrInvalidConfig)
+
+		_, err = newPDAResolver(&config.PDA{ProgramID: programID.String(), Seeds: []config.PDASeed{{Static: "a", Param: "b"}}})
+		require.ErrorIs(t, err, types.ErrInvalidConfig)
+
+		resolver, err := newPDAResolver(nil)
+		require.NoError(t, err)
+		assert.Nil(t, resolver)
+	})
+}
@@ -0,0 +1,177 @@
+package chainreader
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
+)
+
+// ProgramAccountsReader lists the data of the accounts owned by a program. The BinaryDataReader
+// of the chain reader must implement it for procedures with program accounts.
+type ProgramAccountsReader interface {
+	ReadProgramAccounts(context.Context, solana.PublicKey, *rpc.GetProgramAccountsOpts) ([][]byte, error)
+}
+
+// programAccountsBinding lists all accounts of the `idlAccount` type owned by a program with
+// getProgramAccounts and decodes them into a slice of the IDL account type.
+type programAccountsBinding struct {
+	idlAccount    string
+	codec         types.RemoteCodec
+	reader        ProgramAccountsReader
+	opts          *rpc.GetAccountInfoOpts
+	programID     *solana.PublicKey
+	discriminator []byte
+	filters       []config.MemcmpFilter
+	dataSize      uint64
+}
+
+func newProgramAccountsBinding(
+	acct string,
+	codec types.RemoteCodec,
+	reader ProgramAccountsReader,
+	opts *rpc.GetAccountInfoOpts,
+	discriminator []byte,
+	cfg *config.ProgramAccounts,
+) (*programAccountsBinding, error) {
+	binding := &programAccountsBinding{
+		idlAccount:    acct,
+		codec:         codec,
+		reader:        reader,
+		opts:          opts,
+		discriminator: discriminator,
+		filters:       cfg.Filters,
+		dataSize:      cfg.DataSize,
+	}
+
+	if cfg.ProgramID != "" {
+		programID, err := solana.PublicKeyFromBase58(cfg.ProgramID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid program accounts program ID: %s", types.ErrInvalidConfig, err.Error())
+		}
+
+		binding.programID = &programID
+	}
+
+	for idx, filter := range cfg.Filters {
+		if (len(filter.Bytes) == 0) == (filter.Param == "") {
+			return nil, fmt.Errorf("%w: program accounts filter at index %d must have exactly one of bytes or param", types.ErrInvalidConfig, idx)
+		}
+	}
+
+	return binding, nil
+}
+
+var _ readBinding = &programAccountsBinding{}
+
+// PreLoad does nothing, program accounts can't be combined with other bindings and are read in GetLatestValue.
+func (b *programAccountsBinding) PreLoad(context.Context, string, any, primitives.ConfidenceLevel, *loadedResult) {
+}
+
+// GetLatestValue returns a read without slot, getProgramAccounts doesn't return the context slot.
+func (b *programAccountsBinding) GetLatestValue(ctx context.Context, address string, params any, outVal any, confidence primitives.ConfidenceLevel, _ *loadedResult) (readInfo, error) {
+	rOut := reflect.ValueOf(outVal)
+	if rOut.Kind() != reflect.Pointer || rOut.Elem().Kind() != reflect.Slice {
+		return readInfo{}, fmt.Errorf("%w: program accounts must be read into a pointer to a slice, got %T", types.ErrInvalidType, outVal)
+	}
+
+	programID, err := b.program(address)
+	if err != nil {
+		return readInfo{}, err
+	}
+
+	opts, err := b.programAccountsOpts(params, confidence)
+	if err != nil {
+		return readInfo{}, err
+	}
+
+	accounts, err := b.reader.ReadProgramAccounts(ctx, programID, opts)
+	if err != nil {
+		return readInfo{}, fmt.Errorf("%w: failed to get program accounts", err)
+	}
+
+	values := reflect.MakeSlice(rOut.Elem().Type(), 0, len(accounts))
+	for _, bts := range accounts {
+		value := reflect.New(rOut.Elem().Type().Elem())
+		if err = b.codec.Decode(ctx, bts, value.Interface(), b.idlAccount); err != nil {
+			return readInfo{}, err
+		}
+
+		values = reflect.Append(values, value.Elem())
+	}
+
+	rOut.Elem().Set(values)
+
+	return readInfo{commitment: opts.Commitment}, nil
+}
+
+func (b *programAccountsBinding) CreateType(forEncoding bool) (any, error) {
+	itemType, err := b.codec.CreateType(b.idlAccount, forEncoding)
+	if err != nil {
+		return nil, err
+	}
+
+	tItem := reflect.TypeOf(itemType)
+	if tItem.Kind() == reflect.Pointer {
+		tItem = tItem.Elem()
+	}
+
+	return reflect.New(reflect.SliceOf(tItem)).Interface(), nil
+}
+
+// program returns the program owning the accounts, the configured program or the bound address
+func (b *programAccountsBinding) program(address string) (solana.PublicKey, error) {
+	if b.programID != nil {
+		return *b.programID, nil
+	}
+
+	return solana.PublicKeyFromBase58(address)
+}
+
+func (b *programAccountsBinding) programAccountsOpts(params any, confidence primitives.ConfidenceLevel) (*rpc.GetProgramAccountsOpts, error) {
+	commitment, err := confidenceCommitment(confidence, b.opts)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &rpc.GetProgramAccountsOpts{Commitment: commitment, Encoding: solana.EncodingBase64}
+	if b.opts != nil {
+		opts.DataSlice = b.opts.DataSlice
+		if b.opts.Encoding != "" {
+			opts.Encoding = b.opts.Encoding
+		}
+	}
+
+	if len(b.discriminator) > 0 {
+		opts.Filters = append(opts.Filters, rpc.RPCFilter{Memcmp: &rpc.RPCFilterMemcmp{Offset: 0, Bytes: b.discriminator}})
+	}
+
+	for _, filter := range b.filters {
+		bts := []byte(filter.Bytes)
+		if filter.Param != "" {
+			value, err := paramField(params, filter.Param)
+			if err != nil {
+				return nil, err
+			}
+
+			if bts, err = paramBytes(value); err != nil {
+				return nil, fmt.Errorf("%w: param %s: %s", types.ErrInvalidType, filter.Param, err.Error())
+			}
+		}
+
+		opts.Filters = append(opts.Filters, rpc.RPCFilter{Memcmp: &rpc.RPCFilterMemcmp{Offset: filter.Offset, Bytes: bts}})
+	}
+
+	if b.dataSize > 0 {
+		opts.Filters = append(opts.Filters, rpc.RPCFilter{DataSize: b.dataSize})
+	}
+
+	return opts, nil
+}
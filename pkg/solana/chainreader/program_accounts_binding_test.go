@@ -0,0 +1,143 @@
+package chainreader
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
+)
+
+func TestProgramAccountsBinding(t *testing.T) {
+	t.Parallel()
+
+	testCodec := makeTestCodec(t)
+	programID := solana.NewWallet().PublicKey()
+	owner := solana.NewWallet().PublicKey()
+	discriminator := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+
+	binding, err := newProgramAccountsBinding(testCodecKey, testCodec, nil, nil, discriminator, &config.ProgramAccounts{
+		Filters: []config.MemcmpFilter{
+			{Offset: 8, Bytes: solana.Base58{42}},
+			{Offset: 9, Param: "owner"},
+		},
+		DataSize: 17,
+	})
+	require.NoError(t, err)
+
+	expected := []testStruct{{A: true, B: 42}, {A: false, B: 7}}
+	accounts := make([][]byte, len(expected))
+	for i, value := range expected {
+		accounts[i], err = testCodec.Encode(context.Background(), value, testCodecKey)
+		require.NoError(t, err)
+	}
+
+	t.Run("decodes all program accounts with filters", func(t *testing.T) {
+		t.Parallel()
+
+		reader := new(mockProgramAccountsReader)
+		binding := *binding
+		binding.reader = reader
+
+		reader.On("ReadProgramAccounts", mock.Anything, programID, &rpc.GetProgramAccountsOpts{
+			Commitment: rpc.CommitmentFinalized,
+			Encoding:   solana.EncodingBase64,
+			Filters: []rpc.RPCFilter{
+				{Memcmp: &rpc.RPCFilterMemcmp{Offset: 0, Bytes: discriminator}},
+				{Memcmp: &rpc.RPCFilterMemcmp{Offset: 8, Bytes: solana.Base58{42}}},
+				{Memcmp: &rpc.RPCFilterMemcmp{Offset: 9, Bytes: owner.Bytes()}},
+				{DataSize: 17},
+			},
+		}).Return(accounts, nil)
+
+		var result []testStruct
+		info, err := binding.GetLatestValue(context.Background(), programID.String(), map[string]any{"Owner": owner}, &result, primitives.Finalized, nil)
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+		assert.Equal(t, readInfo{commitment: rpc.CommitmentFinalized}, info)
+	})
+
+	t.Run("configured program ID is used instead of the bound address", func(t *testing.T) {
+		t.Parallel()
+
+		reader := new(mockProgramAccountsReader)
+		binding, err := newProgramAccountsBinding(testCodecKey, testCodec, reader, nil, nil, &config.ProgramAccounts{ProgramID: programID.String()})
+		require.NoError(t, err)
+
+		reader.On("ReadProgramAccounts", mock.Anything, programID, mock.Anything).Return([][]byte{}, nil)
+
+		var result []testStruct
+		_, err = binding.GetLatestValue(context.Background(), solana.NewWallet().PublicKey().String(), nil, &result, primitives.Unconfirmed, nil)
+		require.NoError(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		t.Parallel()
+
+		reader := new(mockProgramAccountsReader)
+		binding := *binding
+		binding.reader = reader
+
+		expectedErr := errors.New("test error")
+		reader.On("ReadProgramAccounts", mock.Anything, mock.Anything, mock.Anything).Return(nil, expectedErr)
+
+		var single testStruct
+		_, err := binding.GetLatestValue(context.Background(), programID.String(), nil, &single, primitives.Unconfirmed, nil)
+		assert.ErrorIs(t, err, types.ErrInvalidType)
+
+		var result []testStruct
+		_, err = binding.GetLatestValue(context.Background(), programID.String(), nil, &result, primitives.Unconfirmed, nil)
+		assert.Error(t, err)
+		_, err = binding.GetLatestValue(context.Background(), programID.String(), map[string]any{"owner": owner}, &result, primitives.Unconfirmed, nil)
+		assert.ErrorIs(t, err, expectedErr)
+	})
+
+	t.Run("create type is a slice of the account type", func(t *testing.T) {
+		t.Parallel()
+
+		itemType, err := binding.CreateType(false)
+		require.NoError(t, err)
+		tItem := reflect.TypeOf(itemType)
+		require.Equal(t, reflect.Pointer, tItem.Kind())
+		require.Equal(t, reflect.Slice, tItem.Elem().Kind())
+		assert.Equal(t, reflect.Struct, tItem.Elem().Elem().Kind())
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newProgramAccountsBinding(testCodecKey, testCodec, nil, nil, nil, &config.ProgramAccounts{ProgramID: "invalid"})
+		assert.ErrorIs(t, err, types.ErrInvalidConfig)
+
+		_, err = newProgramAccountsBinding(testCodecKey, testCodec, nil, nil, nil, &config.ProgramAccounts{
+			Filters: []config.MemcmpFilter{{Offset: 8, Bytes: solana.Base58{42}, Param: "owner"}},
+		})
+		assert.ErrorIs(t, err, types.ErrInvalidConfig)
+	})
+}
+
+type mockProgramAccountsReader struct {
+	mock.Mock
+}
+
+func (_m *mockProgramAccountsReader) ReadProgramAccounts(ctx context.Context, pk solana.PublicKey, opts *rpc.GetProgramAccountsOpts) ([][]byte, error) {
+	ret := _m.Called(ctx, pk, opts)
+
+	var r0 [][]byte
+	if val, ok := ret.Get(0).([][]byte); ok {
+		r0 = val
+	}
+
+	return r0, ret.Error(1)
+}
@@ -31,14 +31,18 @@ type ReaderWriter interface {
 
 type Reader interface {
 	AccountReader
+	GetAccountInfoWithCommitment(ctx context.Context, addr solana.PublicKey, opts *rpc.GetAccountInfoOpts) (*rpc.GetAccountInfoResult, error)
 	Balance(ctx context.Context, addr solana.PublicKey) (uint64, error)
 	SlotHeight(ctx context.Context) (uint64, error)
 	LatestBlockhash(ctx context.Context) (*rpc.GetLatestBlockhashResult, error)
 	ChainID(ctx context.Context) (mn.StringID, error)
 	GetFeeForMessage(ctx context.Context, msg string) (uint64, error)
+	GetMinimumBalanceForRentExemption(ctx context.Context, dataSize uint64) (uint64, error)
 	GetLatestBlock(ctx context.Context) (*rpc.GetBlockResult, error)
 	GetBlocksWithLimit(ctx context.Context, startSlot uint64, limit uint64) (*rpc.BlocksResult, error)
 	GetBlock(ctx context.Context, slot uint64) (*rpc.GetBlockResult, error)
+	GetSignaturesForAddressWithOpts(ctx context.Context, addr solana.PublicKey, opts *rpc.GetSignaturesForAddressOpts) ([]*rpc.TransactionSignature, error)
+	GetTransaction(ctx context.Context, sig solana.Signature, opts *rpc.GetTransactionOpts) (*rpc.GetTransactionResult, error)
 }
 
 // AccountReader is an interface that allows users to pass either the solana rpc client or the relay client
@@ -132,6 +136,60 @@ func (c *Client) GetAccountInfoWithOpts(ctx context.Context, addr solana.PublicK
 	return c.rpc.GetAccountInfoWithOpts(ctx, addr, opts)
 }
 
+// GetAccountInfoWithCommitment reads the account at the passed commitment, the client commitment is only used if it is not set
+func (c *Client) GetAccountInfoWithCommitment(ctx context.Context, addr solana.PublicKey, opts *rpc.GetAccountInfoOpts) (*rpc.GetAccountInfoResult, error) {
+	done := c.latency("account_info")
+	defer done()
+
+	ctx, cancel := context.WithTimeout(ctx, c.contextDuration)
+	defer cancel()
+	if opts == nil {
+		opts = &rpc.GetAccountInfoOpts{}
+	}
+	if opts.Commitment == "" {
+		opts.Commitment = c.commitment
+	}
+	return c.rpc.GetAccountInfoWithOpts(ctx, addr, opts)
+}
+
+func (c *Client) GetSignaturesForAddressWithOpts(ctx context.Context, addr solana.PublicKey, opts *rpc.GetSignaturesForAddressOpts) ([]*rpc.TransactionSignature, error) {
+	done := c.latency("signatures_for_address")
+	defer done()
+
+	ctx, cancel := context.WithTimeout(ctx, c.contextDuration)
+	defer cancel()
+	if opts == nil {
+		opts = &rpc.GetSignaturesForAddressOpts{}
+	}
+	opts.Commitment = c.historyCommitment() // overrides passed in value - use defined client commitment type
+	return c.rpc.GetSignaturesForAddressWithOpts(ctx, addr, opts)
+}
+
+func (c *Client) GetTransaction(ctx context.Context, sig solana.Signature, opts *rpc.GetTransactionOpts) (*rpc.GetTransactionResult, error) {
+	done := c.latency("get_transaction")
+	defer done()
+
+	ctx, cancel := context.WithTimeout(ctx, c.contextDuration)
+	defer cancel()
+	if opts == nil {
+		opts = &rpc.GetTransactionOpts{}
+	}
+	opts.Commitment = c.historyCommitment() // overrides passed in value - use defined client commitment type
+	if opts.MaxSupportedTransactionVersion == nil {
+		version := uint64(0) // pull all tx types (legacy + v0)
+		opts.MaxSupportedTransactionVersion = &version
+	}
+	return c.rpc.GetTransaction(ctx, sig, opts)
+}
+
+// historyCommitment returns the client commitment for transaction history methods, which do not support processed
+func (c *Client) historyCommitment() rpc.CommitmentType {
+	if c.commitment == rpc.CommitmentProcessed {
+		return rpc.CommitmentConfirmed
+	}
+	return c.commitment
+}
+
 func (c *Client) LatestBlockhash(ctx context.Context) (*rpc.GetLatestBlockhashResult, error) {
 	done := c.latency("latest_blockhash")
 	defer done()
@@ -193,6 +251,19 @@ func (c *Client) GetFeeForMessage(ctx context.Context, msg string) (uint64, erro
 	return *res.Value, nil
 }
 
+func (c *Client) GetMinimumBalanceForRentExemption(ctx context.Context, dataSize uint64) (uint64, error) {
+	done := c.latency("minimum_balance_for_rent_exemption")
+	defer done()
+
+	ctx, cancel := context.WithTimeout(ctx, c.contextDuration)
+	defer cancel()
+	lamports, err := c.rpc.GetMinimumBalanceForRentExemption(ctx, dataSize, c.commitment)
+	if err != nil {
+		return 0, fmt.Errorf("error in GetMinimumBalanceForRentExemption: %w", err)
+	}
+	return lamports, nil
+}
+
 // https://docs.solana.com/developing/clients/jsonrpc-api#getsignaturestatuses
 func (c *Client) SignatureStatuses(ctx context.Context, sigs []solana.Signature) ([]*rpc.SignatureStatusesResult, error) {
 	done := c.latency("signature_statuses")
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/rand"
 	"net/http"
@@ -160,6 +161,103 @@ func TestClient_Reader_ChainID(t *testing.T) {
 	}
 }
 
+// newCommitmentServer returns a mock RPC server responding with result, and the commitment of the last request
+func newCommitmentServer(t *testing.T, result string) (string, func() rpc.CommitmentType) {
+	var mu sync.Mutex
+	var commitment rpc.CommitmentType
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Params []json.RawMessage `json:"params"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		var opts struct {
+			Commitment rpc.CommitmentType `json:"commitment"`
+		}
+		if len(req.Params) > 0 {
+			assert.NoError(t, json.Unmarshal(req.Params[len(req.Params)-1], &opts))
+		}
+		mu.Lock()
+		commitment = opts.Commitment
+		mu.Unlock()
+
+		_, err := w.Write([]byte(fmt.Sprintf(`{"jsonrpc":"2.0","result":%s,"id":1}`, result)))
+		assert.NoError(t, err)
+	}))
+	t.Cleanup(mockServer.Close)
+
+	return mockServer.URL, func() rpc.CommitmentType {
+		mu.Lock()
+		defer mu.Unlock()
+		return commitment
+	}
+}
+
+func TestClient_GetAccountInfo_Commitment(t *testing.T) {
+	ctx := tests.Context(t)
+	url, commitment := newCommitmentServer(t, `{"context":{"slot":1},"value":{"lamports":1,"owner":"11111111111111111111111111111111","data":["","base64"],"executable":false,"rentEpoch":0}}`)
+
+	c, err := NewClient(url, config.NewDefault(), 5*time.Second, logger.Test(t))
+	require.NoError(t, err)
+
+	// passed commitment is overridden by the client commitment
+	_, err = c.GetAccountInfoWithOpts(ctx, solana.PublicKey{}, &rpc.GetAccountInfoOpts{Commitment: rpc.CommitmentFinalized})
+	require.NoError(t, err)
+	assert.Equal(t, c.commitment, commitment())
+
+	// passed commitment is used
+	_, err = c.GetAccountInfoWithCommitment(ctx, solana.PublicKey{}, &rpc.GetAccountInfoOpts{Commitment: rpc.CommitmentFinalized})
+	require.NoError(t, err)
+	assert.Equal(t, rpc.CommitmentFinalized, commitment())
+
+	// defaults to the client commitment
+	_, err = c.GetAccountInfoWithCommitment(ctx, solana.PublicKey{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, c.commitment, commitment())
+}
+
+func TestClient_TransactionHistory_Commitment(t *testing.T) {
+	ctx := tests.Context(t)
+	url, commitment := newCommitmentServer(t, `null`)
+
+	cfg := config.NewDefault()
+	processed := string(rpc.CommitmentProcessed)
+	cfg.Chain.Commitment = &processed
+	c, err := NewClient(url, cfg, 5*time.Second, logger.Test(t))
+	require.NoError(t, err)
+
+	// processed is not supported, confirmed is used instead
+	_, err = c.GetSignaturesForAddressWithOpts(ctx, solana.PublicKey{}, &rpc.GetSignaturesForAddressOpts{Commitment: rpc.CommitmentProcessed})
+	require.NoError(t, err)
+	assert.Equal(t, rpc.CommitmentConfirmed, commitment())
+
+	_, err = c.GetTransaction(ctx, solana.Signature{}, nil)
+	require.ErrorIs(t, err, rpc.ErrNotFound)
+	assert.Equal(t, rpc.CommitmentConfirmed, commitment())
+
+	// other commitments are not changed
+	finalized := string(rpc.CommitmentFinalized)
+	cfg.Chain.Commitment = &finalized
+	c, err = NewClient(url, cfg, 5*time.Second, logger.Test(t))
+	require.NoError(t, err)
+	_, err = c.GetSignaturesForAddressWithOpts(ctx, solana.PublicKey{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, rpc.CommitmentFinalized, commitment())
+}
+
+func TestClient_GetMinimumBalanceForRentExemption(t *testing.T) {
+	ctx := tests.Context(t)
+	url, commitment := newCommitmentServer(t, `2039280`)
+
+	c, err := NewClient(url, config.NewDefault(), 5*time.Second, logger.Test(t))
+	require.NoError(t, err)
+
+	rent, err := c.GetMinimumBalanceForRentExemption(ctx, 165)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2_039_280), rent)
+	assert.Equal(t, c.commitment, commitment())
+}
+
 func TestClient_Writer_Integration(t *testing.T) {
 	url := SetupLocalSolNode(t)
 	privKey, err := solana.NewRandomPrivateKey()
@@ -73,6 +73,36 @@ func (_m *ReaderWriter) ChainID(ctx context.Context) (multinode.StringID, error)
 	return r0, r1
 }
 
+// GetAccountInfoWithCommitment provides a mock function with given fields: ctx, addr, opts
+func (_m *ReaderWriter) GetAccountInfoWithCommitment(ctx context.Context, addr solana.PublicKey, opts *rpc.GetAccountInfoOpts) (*rpc.GetAccountInfoResult, error) {
+	ret := _m.Called(ctx, addr, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccountInfoWithCommitment")
+	}
+
+	var r0 *rpc.GetAccountInfoResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, solana.PublicKey, *rpc.GetAccountInfoOpts) (*rpc.GetAccountInfoResult, error)); ok {
+		return rf(ctx, addr, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, solana.PublicKey, *rpc.GetAccountInfoOpts) *rpc.GetAccountInfoResult); ok {
+		r0 = rf(ctx, addr, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rpc.GetAccountInfoResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, solana.PublicKey, *rpc.GetAccountInfoOpts) error); ok {
+		r1 = rf(ctx, addr, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAccountInfoWithOpts provides a mock function with given fields: ctx, addr, opts
 func (_m *ReaderWriter) GetAccountInfoWithOpts(ctx context.Context, addr solana.PublicKey, opts *rpc.GetAccountInfoOpts) (*rpc.GetAccountInfoResult, error) {
 	ret := _m.Called(ctx, addr, opts)
@@ -221,6 +251,94 @@ func (_m *ReaderWriter) GetLatestBlock(ctx context.Context) (*rpc.GetBlockResult
 	return r0, r1
 }
 
+// GetMinimumBalanceForRentExemption provides a mock function with given fields: ctx, dataSize
+func (_m *ReaderWriter) GetMinimumBalanceForRentExemption(ctx context.Context, dataSize uint64) (uint64, error) {
+	ret := _m.Called(ctx, dataSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMinimumBalanceForRentExemption")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (uint64, error)); ok {
+		return rf(ctx, dataSize)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) uint64); ok {
+		r0 = rf(ctx, dataSize)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, dataSize)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSignaturesForAddressWithOpts provides a mock function with given fields: ctx, addr, opts
+func (_m *ReaderWriter) GetSignaturesForAddressWithOpts(ctx context.Context, addr solana.PublicKey, opts *rpc.GetSignaturesForAddressOpts) ([]*rpc.TransactionSignature, error) {
+	ret := _m.Called(ctx, addr, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSignaturesForAddressWithOpts")
+	}
+
+	var r0 []*rpc.TransactionSignature
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, solana.PublicKey, *rpc.GetSignaturesForAddressOpts) ([]*rpc.TransactionSignature, error)); ok {
+		return rf(ctx, addr, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, solana.PublicKey, *rpc.GetSignaturesForAddressOpts) []*rpc.TransactionSignature); ok {
+		r0 = rf(ctx, addr, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*rpc.TransactionSignature)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, solana.PublicKey, *rpc.GetSignaturesForAddressOpts) error); ok {
+		r1 = rf(ctx, addr, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTransaction provides a mock function with given fields: ctx, sig, opts
+func (_m *ReaderWriter) GetTransaction(ctx context.Context, sig solana.Signature, opts *rpc.GetTransactionOpts) (*rpc.GetTransactionResult, error) {
+	ret := _m.Called(ctx, sig, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransaction")
+	}
+
+	var r0 *rpc.GetTransactionResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, solana.Signature, *rpc.GetTransactionOpts) (*rpc.GetTransactionResult, error)); ok {
+		return rf(ctx, sig, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, solana.Signature, *rpc.GetTransactionOpts) *rpc.GetTransactionResult); ok {
+		r0 = rf(ctx, sig, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rpc.GetTransactionResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, solana.Signature, *rpc.GetTransactionOpts) error); ok {
+		r1 = rf(ctx, sig, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // LatestBlockhash provides a mock function with given fields: ctx
 func (_m *ReaderWriter) LatestBlockhash(ctx context.Context) (*rpc.GetLatestBlockhashResult, error) {
 	ret := _m.Called(ctx)
@@ -91,6 +91,13 @@ func (c *MultiNode[CHAIN_ID, RPC]) ChainID() CHAIN_ID {
 }
 
 func (c *MultiNode[CHAIN_ID, RPC]) DoAll(ctx context.Context, do func(ctx context.Context, rpc RPC, isSendOnly bool)) error {
+	return c.DoAllNamed(ctx, func(ctx context.Context, _ string, rpc RPC, isSendOnly bool) {
+		do(ctx, rpc, isSendOnly)
+	})
+}
+
+// DoAllNamed is DoAll with the unique node name, used to track per node statistics
+func (c *MultiNode[CHAIN_ID, RPC]) DoAllNamed(ctx context.Context, do func(ctx context.Context, name string, rpc RPC, isSendOnly bool)) error {
 	var err error
 	ok := c.IfNotStopped(func() {
 		callsCompleted := 0
@@ -103,7 +110,7 @@ func (c *MultiNode[CHAIN_ID, RPC]) DoAll(ctx context.Context, do func(ctx contex
 				if n.State() != NodeStateAlive {
 					continue
 				}
-				do(ctx, n.RPC(), false)
+				do(ctx, n.Name(), n.RPC(), false)
 				callsCompleted++
 			}
 		}
@@ -120,7 +127,7 @@ func (c *MultiNode[CHAIN_ID, RPC]) DoAll(ctx context.Context, do func(ctx contex
 				if n.State() != NodeStateAlive {
 					continue
 				}
-				do(ctx, n.RPC(), true)
+				do(ctx, n.Name(), n.RPC(), true)
 			}
 		}
 	})
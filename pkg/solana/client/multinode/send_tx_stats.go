@@ -0,0 +1,119 @@
+package client
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	PromMultiNodeSendTxLandingRate = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "solana_multi_node_send_tx_landing_rate",
+		Help: "Ratio of transactions accepted by the node that landed onchain",
+	}, []string{"network", "chainId", "nodeName"})
+	PromMultiNodeSendTxLatency = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "solana_multi_node_send_tx_latency_seconds",
+		Help: "Moving average of the time taken by the node to respond to SendTransaction",
+	}, []string{"network", "chainId", "nodeName"})
+)
+
+// sendTxLatencyWeight is the weight of the latest observation in the latency moving average
+const sendTxLatencyWeight = 0.2
+
+// SendTxNodeStats are the transaction broadcasting statistics observed for a node
+type SendTxNodeStats struct {
+	Sent     uint64
+	Accepted uint64
+	Landed   uint64
+	Dropped  uint64
+	Latency  time.Duration // moving average
+}
+
+// LandingRate returns the ratio of accepted transactions that landed onchain
+// smoothed so nodes without landing observations start at 0.5
+func (s SendTxNodeStats) LandingRate() float64 {
+	return (float64(s.Landed) + 1) / (float64(s.Landed+s.Dropped) + 2)
+}
+
+// better returns true if the node is preferred for broadcasting: higher landing rate, then lower latency
+func (s SendTxNodeStats) better(other SendTxNodeStats) bool {
+	if s.LandingRate() != other.LandingRate() {
+		return s.LandingRate() > other.LandingRate()
+	}
+	return s.Latency != 0 && (other.Latency == 0 || s.Latency < other.Latency)
+}
+
+type sendTxStats struct {
+	chainFamily string
+	chainID     string
+
+	lock  sync.RWMutex
+	nodes map[string]SendTxNodeStats
+}
+
+func newSendTxStats(chainFamily, chainID string) *sendTxStats {
+	return &sendTxStats{
+		chainFamily: chainFamily,
+		chainID:     chainID,
+		nodes:       map[string]SendTxNodeStats{},
+	}
+}
+
+func (s *sendTxStats) observeSend(name string, latency time.Duration, accepted bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	stats := s.nodes[name]
+	stats.Sent++
+	if accepted {
+		stats.Accepted++
+	}
+	if stats.Latency == 0 {
+		stats.Latency = latency
+	} else {
+		stats.Latency = time.Duration(sendTxLatencyWeight*float64(latency) + (1-sendTxLatencyWeight)*float64(stats.Latency))
+	}
+	s.nodes[name] = stats
+	PromMultiNodeSendTxLatency.WithLabelValues(s.chainFamily, s.chainID, name).Set(stats.Latency.Seconds())
+}
+
+func (s *sendTxStats) observeLanding(name string, landed bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	stats := s.nodes[name]
+	if landed {
+		stats.Landed++
+	} else {
+		stats.Dropped++
+	}
+	s.nodes[name] = stats
+	PromMultiNodeSendTxLandingRate.WithLabelValues(s.chainFamily, s.chainID, name).Set(stats.LandingRate())
+}
+
+// best returns the index of the preferred node, the first node is returned if there are no observations
+func (s *sendTxStats) best(names []string) int {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	best := 0
+	for i := 1; i < len(names); i++ {
+		if s.nodes[names[i]].better(s.nodes[names[best]]) {
+			best = i
+		}
+	}
+	return best
+}
+
+func (s *sendTxStats) snapshot() map[string]SendTxNodeStats {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	out := make(map[string]SendTxNodeStats, len(s.nodes))
+	for name, stats := range s.nodes {
+		out[name] = stats
+	}
+	return out
+}
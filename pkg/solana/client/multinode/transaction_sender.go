@@ -32,6 +32,39 @@ type SendTxResult interface {
 
 const sendTxQuorum = 0.7
 
+// sentTxRetention is how long broadcast transactions are tracked for tiering and landing reports
+const sentTxRetention = 5 * time.Minute
+
+// SendTxTiering configures tiered broadcasting. Transactions are broadcast to the first tier, and fan out to the
+// remaining nodes once FanoutDelay has passed since the first broadcast or if every node in the first tier fails.
+// The first tier consists of the alive priority nodes and the node with the best observed landing rate, which is a
+// priority node unless a primary node outperforms all of them.
+type SendTxTiering[TX any] struct {
+	// PriorityNodes are the names of the nodes in the priority tier (e.g. staked connections or SWQoS endpoints),
+	// tiering is disabled if there are none
+	PriorityNodes []string
+	// FanoutDelay is the time after the first broadcast of a transaction before it is sent to all nodes, 0 disables tiering
+	FanoutDelay time.Duration
+	// TxKey identifies a transaction across rebroadcasts (e.g. signature), required for tiering and landing statistics
+	TxKey func(tx TX) string
+}
+
+// sentTx tracks a broadcast transaction
+type sentTx struct {
+	firstSeen time.Time
+	fanout    bool                // broadcast to all nodes
+	scheduled bool                // delayed fan out is scheduled
+	first     map[string]struct{} // nodes in the first tier
+	accepted  map[string]struct{} // nodes that accepted the transaction
+}
+
+type sendTxNode[RPC any] struct {
+	name     string
+	rpc      RPC
+	sendOnly bool
+	priority bool
+}
+
 // SendTxRPCClient - defines interface of an RPC used by TransactionSender to broadcast transaction
 type SendTxRPCClient[TX any, RESULT SendTxResult] interface {
 	// SendTransaction errors returned should include name or other unique identifier of the RPC
@@ -45,10 +78,15 @@ func NewTransactionSender[TX any, RESULT SendTxResult, CHAIN_ID ID, RPC SendTxRP
 	multiNode *MultiNode[CHAIN_ID, RPC],
 	newResult func(err error) RESULT,
 	sendTxSoftTimeout time.Duration,
+	tiering SendTxTiering[TX],
 ) *TransactionSender[TX, RESULT, CHAIN_ID, RPC] {
 	if sendTxSoftTimeout == 0 {
 		sendTxSoftTimeout = QueryTimeout / 2
 	}
+	priorityNodes := map[string]struct{}{}
+	for _, name := range tiering.PriorityNodes {
+		priorityNodes[name] = struct{}{}
+	}
 	return &TransactionSender[TX, RESULT, CHAIN_ID, RPC]{
 		chainID:           chainID,
 		chainFamily:       chainFamily,
@@ -56,6 +94,10 @@ func NewTransactionSender[TX any, RESULT SendTxResult, CHAIN_ID ID, RPC SendTxRP
 		multiNode:         multiNode,
 		newResult:         newResult,
 		sendTxSoftTimeout: sendTxSoftTimeout,
+		tiering:           tiering,
+		priorityNodes:     priorityNodes,
+		stats:             newSendTxStats(chainFamily, chainID.String()),
+		sentTxs:           map[string]*sentTx{},
 		chStop:            make(services.StopChan),
 	}
 }
@@ -68,6 +110,13 @@ type TransactionSender[TX any, RESULT SendTxResult, CHAIN_ID ID, RPC SendTxRPCCl
 	multiNode         *MultiNode[CHAIN_ID, RPC]
 	newResult         func(err error) RESULT
 	sendTxSoftTimeout time.Duration // defines max waiting time from first response til responses evaluation
+	tiering           SendTxTiering[TX]
+	priorityNodes     map[string]struct{}
+	stats             *sendTxStats
+
+	sentTxsMu     sync.Mutex
+	sentTxs       map[string]*sentTx
+	sentTxsPruned time.Time
 
 	wg     sync.WaitGroup // waits for all reporting goroutines to finish
 	chStop services.StopChan
@@ -78,7 +127,10 @@ type TransactionSender[TX any, RESULT SendTxResult, CHAIN_ID ID, RPC SendTxRPCCl
 // performed to determine the final state.
 //
 // Send-only nodes' results are ignored as they tend to return false-positive responses. Broadcast to them is necessary
-// to speed up the propagation of TX in the network.
+// to speed up the propagation of TX in the network. Results of send-only nodes in the priority tier are trusted.
+//
+// If tiering is enabled, the transaction is only broadcast to the first tier until the fan out delay has passed
+// since the first broadcast or every node in the first tier returned an error (see SendTxTiering).
 //
 // Handling of primary nodes' results consists of collection and aggregation.
 // In the collection step, we gather as many results as possible while minimizing waiting time. This operation succeeds
@@ -92,14 +144,61 @@ type TransactionSender[TX any, RESULT SendTxResult, CHAIN_ID ID, RPC SendTxRPCCl
 // * If there is both success and terminal error - returns success and reports invariant violation
 // * Otherwise, returns any (effectively random) of the errors.
 func (txSender *TransactionSender[TX, RESULT, CHAIN_ID, RPC]) SendTransaction(ctx context.Context, tx TX) RESULT {
-	txResults := make(chan RESULT)
-	txResultsToReport := make(chan RESULT)
-	primaryNodeWg := sync.WaitGroup{}
-
 	if txSender.State() != "Started" {
 		return txSender.newResult(errors.New("TransactionSender not started"))
 	}
 
+	nodes, err := txSender.aliveNodes(ctx)
+	if err != nil && !(errors.Is(err, ErroringNodeError) && hasPriorityNode(nodes)) {
+		// broadcast to send-only nodes to speed up propagation
+		txSender.broadcast(ctx, tx, "", nodes)
+		return txSender.newResult(err)
+	}
+
+	key := txSender.txKey(tx)
+	first, rest := txSender.tiers(key, nodes)
+	result := txSender.broadcast(ctx, tx, key, first)
+	if len(rest) == 0 {
+		return result
+	}
+
+	// first tier failed, fan out immediately
+	if !slices.Contains(sendTxSuccessfulCodes, result.Code()) {
+		if !txSender.markFanout(key) {
+			return result // fan out already triggered by another broadcast
+		}
+		txSender.lggr.Debugw("First tier failed to accept transaction, broadcasting to all nodes", "tx", tx, "err", result.TxError())
+		fanoutResult := txSender.broadcast(ctx, tx, key, rest)
+		if !slices.ContainsFunc(rest, func(n sendTxNode[RPC]) bool { return !n.sendOnly }) {
+			return result // only send-only nodes remain, their results are ignored
+		}
+		return fanoutResult
+	}
+
+	txSender.scheduleFanout(tx, key)
+	return result
+}
+
+// aliveNodes returns the alive primary and send-only nodes
+func (txSender *TransactionSender[TX, RESULT, CHAIN_ID, RPC]) aliveNodes(ctx context.Context) ([]sendTxNode[RPC], error) {
+	var nodes []sendTxNode[RPC]
+	err := txSender.multiNode.DoAllNamed(ctx, func(_ context.Context, name string, rpc RPC, isSendOnly bool) {
+		_, priority := txSender.priorityNodes[name]
+		nodes = append(nodes, sendTxNode[RPC]{name: name, rpc: rpc, sendOnly: isSendOnly, priority: priority})
+	})
+	return nodes, err
+}
+
+func hasPriorityNode[RPC any](nodes []sendTxNode[RPC]) bool {
+	return slices.ContainsFunc(nodes, func(n sendTxNode[RPC]) bool { return n.priority })
+}
+
+// broadcast sends the transaction to the nodes and collects the results
+func (txSender *TransactionSender[TX, RESULT, CHAIN_ID, RPC]) broadcast(ctx context.Context, tx TX, key string, nodes []sendTxNode[RPC]) RESULT {
+	txResults := make(chan RESULT)
+	txResultsToReport := make(chan RESULT)
+	primaryNodeWg := sync.WaitGroup{}
+
 	txSenderCtx, cancel := txSender.chStop.NewCtx()
 	reportWg := sync.WaitGroup{}
 	defer func() {
@@ -110,16 +209,16 @@ func (txSender *TransactionSender[TX, RESULT, CHAIN_ID, RPC]) SendTransaction(ct
 	}()
 
 	healthyNodesNum := 0
-	err := txSender.multiNode.DoAll(txSenderCtx, func(ctx context.Context, rpc RPC, isSendOnly bool) {
-		if isSendOnly {
+	for _, n := range nodes {
+		if n.sendOnly && !n.priority {
 			txSender.wg.Add(1)
 			go func() {
 				defer txSender.wg.Done()
 				// Send-only nodes' results are ignored as they tend to return false-positive responses.
 				// Broadcast to them is necessary to speed up the propagation of TX in the network.
-				_ = txSender.broadcastTxAsync(ctx, rpc, tx)
+				_ = txSender.broadcastTxAsync(txSenderCtx, n, tx, key)
 			}()
-			return
+			continue
 		}
 
 		// Primary Nodes
@@ -127,20 +226,20 @@ func (txSender *TransactionSender[TX, RESULT, CHAIN_ID, RPC]) SendTransaction(ct
 		primaryNodeWg.Add(1)
 		go func() {
 			defer primaryNodeWg.Done()
-			r := txSender.broadcastTxAsync(ctx, rpc, tx)
+			r := txSender.broadcastTxAsync(txSenderCtx, n, tx, key)
 			select {
-			case <-ctx.Done():
+			case <-txSenderCtx.Done():
 				return
 			case txResults <- r:
 			}
 
 			select {
-			case <-ctx.Done():
+			case <-txSenderCtx.Done():
 				return
 			case txResultsToReport <- r:
 			}
 		}()
-	})
+	}
 
 	// This needs to be done in parallel so the reporting knows when it's done (when the channel is closed)
 	txSender.wg.Add(1)
@@ -151,10 +250,6 @@ func (txSender *TransactionSender[TX, RESULT, CHAIN_ID, RPC]) SendTransaction(ct
 		close(txResults)
 	}()
 
-	if err != nil {
-		return txSender.newResult(err)
-	}
-
 	txSender.wg.Add(1)
 	reportWg.Add(1)
 	go func() {
@@ -165,15 +260,183 @@ func (txSender *TransactionSender[TX, RESULT, CHAIN_ID, RPC]) SendTransaction(ct
 	return txSender.collectTxResults(ctx, tx, healthyNodesNum, txResults)
 }
 
-func (txSender *TransactionSender[TX, RESULT, CHAIN_ID, RPC]) broadcastTxAsync(ctx context.Context, rpc RPC, tx TX) RESULT {
-	result := rpc.SendTransaction(ctx, tx)
-	txSender.lggr.Debugw("Node sent transaction", "tx", tx, "err", result.TxError())
-	if !slices.Contains(sendTxSuccessfulCodes, result.Code()) {
-		txSender.lggr.Warnw("RPC returned error", "tx", tx, "err", result.TxError())
+func (txSender *TransactionSender[TX, RESULT, CHAIN_ID, RPC]) broadcastTxAsync(ctx context.Context, node sendTxNode[RPC], tx TX, key string) RESULT {
+	start := time.Now()
+	result := node.rpc.SendTransaction(ctx, tx)
+	accepted := slices.Contains(sendTxSuccessfulCodes, result.Code())
+	if ctx.Err() == nil {
+		txSender.stats.observeSend(node.name, time.Since(start), accepted)
+	}
+	if accepted {
+		txSender.observeAccepted(key, node.name)
+	}
+	txSender.lggr.Debugw("Node sent transaction", "tx", tx, "node", node.name, "err", result.TxError())
+	if !accepted {
+		txSender.lggr.Warnw("RPC returned error", "tx", tx, "node", node.name, "err", result.TxError())
 	}
 	return result
 }
 
+func (txSender *TransactionSender[TX, RESULT, CHAIN_ID, RPC]) txKey(tx TX) string {
+	if txSender.tiering.TxKey == nil {
+		return ""
+	}
+	return txSender.tiering.TxKey(tx)
+}
+
+// tiers splits the nodes into the first broadcast and the delayed fan out
+func (txSender *TransactionSender[TX, RESULT, CHAIN_ID, RPC]) tiers(key string, nodes []sendTxNode[RPC]) (first, rest []sendTxNode[RPC]) {
+	if key == "" || txSender.tiering.FanoutDelay == 0 || len(txSender.priorityNodes) == 0 {
+		return nodes, nil
+	}
+
+	txSender.sentTxsMu.Lock()
+	defer txSender.sentTxsMu.Unlock()
+	sent := txSender.getSentTx(key)
+	if sent.fanout || time.Since(sent.firstSeen) >= txSender.tiering.FanoutDelay {
+		sent.fanout = true
+		return nodes, nil
+	}
+
+	// rank the priority nodes ahead of the primary nodes, so a primary node is only picked if it outperforms them
+	var ranked []int
+	for i, n := range nodes {
+		if n.priority {
+			ranked = append(ranked, i)
+		}
+	}
+	for i, n := range nodes {
+		if !n.priority && !n.sendOnly {
+			ranked = append(ranked, i)
+		}
+	}
+	if len(ranked) == 0 {
+		return nodes, nil
+	}
+	names := make([]string, len(ranked))
+	for j, i := range ranked {
+		names[j] = nodes[i].name
+	}
+	best := ranked[txSender.stats.best(names)]
+
+	for i, n := range nodes {
+		if n.priority || i == best {
+			first = append(first, n)
+			sent.first[n.name] = struct{}{}
+		} else {
+			rest = append(rest, n)
+		}
+	}
+	return first, rest
+}
+
+// getSentTx returns the tracked transaction, must be called with sentTxsMu held
+func (txSender *TransactionSender[TX, RESULT, CHAIN_ID, RPC]) getSentTx(key string) *sentTx {
+	if time.Since(txSender.sentTxsPruned) > sentTxRetention {
+		for k, sent := range txSender.sentTxs {
+			if time.Since(sent.firstSeen) > sentTxRetention {
+				delete(txSender.sentTxs, k)
+			}
+		}
+		txSender.sentTxsPruned = time.Now()
+	}
+
+	sent, exists := txSender.sentTxs[key]
+	if !exists {
+		sent = &sentTx{firstSeen: time.Now(), first: map[string]struct{}{}, accepted: map[string]struct{}{}}
+		txSender.sentTxs[key] = sent
+	}
+	return sent
+}
+
+// markFanout returns true if the transaction was not yet broadcast to all nodes
+func (txSender *TransactionSender[TX, RESULT, CHAIN_ID, RPC]) markFanout(key string) bool {
+	txSender.sentTxsMu.Lock()
+	defer txSender.sentTxsMu.Unlock()
+	sent := txSender.getSentTx(key)
+	if sent.fanout {
+		return false
+	}
+	sent.fanout = true
+	return true
+}
+
+// scheduleFanout broadcasts the transaction to all nodes once the fan out delay has passed
+func (txSender *TransactionSender[TX, RESULT, CHAIN_ID, RPC]) scheduleFanout(tx TX, key string) {
+	txSender.sentTxsMu.Lock()
+	sent := txSender.getSentTx(key)
+	if sent.scheduled || sent.fanout {
+		txSender.sentTxsMu.Unlock()
+		return
+	}
+	sent.scheduled = true
+	delay := txSender.tiering.FanoutDelay - time.Since(sent.firstSeen)
+	txSender.sentTxsMu.Unlock()
+
+	txSender.wg.Add(1)
+	go func() {
+		defer txSender.wg.Done()
+		ctx, cancel := txSender.chStop.NewCtx()
+		defer cancel()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+
+		if !txSender.markFanout(key) {
+			return // already broadcast to all nodes by a rebroadcast
+		}
+		nodes, err := txSender.aliveNodes(ctx)
+		if err != nil && len(nodes) == 0 {
+			txSender.lggr.Debugw("Failed to fan out transaction", "tx", tx, "err", err)
+			return
+		}
+		txSender.sentTxsMu.Lock()
+		first := txSender.getSentTx(key).first
+		var rest []sendTxNode[RPC]
+		for _, n := range nodes {
+			if _, ok := first[n.name]; !ok && !n.priority {
+				rest = append(rest, n)
+			}
+		}
+		txSender.sentTxsMu.Unlock()
+		if len(rest) > 0 {
+			txSender.broadcast(ctx, tx, key, rest)
+		}
+	}()
+}
+
+func (txSender *TransactionSender[TX, RESULT, CHAIN_ID, RPC]) observeAccepted(key, name string) {
+	if key == "" {
+		return
+	}
+	txSender.sentTxsMu.Lock()
+	defer txSender.sentTxsMu.Unlock()
+	txSender.getSentTx(key).accepted[name] = struct{}{}
+}
+
+// ReportLanding records whether a transaction identified by SendTxTiering.TxKey landed onchain or was dropped.
+// Landing is attributed to every node that accepted the transaction, only the first report for a transaction is used.
+func (txSender *TransactionSender[TX, RESULT, CHAIN_ID, RPC]) ReportLanding(key string, landed bool) {
+	txSender.sentTxsMu.Lock()
+	sent, exists := txSender.sentTxs[key]
+	delete(txSender.sentTxs, key)
+	txSender.sentTxsMu.Unlock()
+	if !exists {
+		return
+	}
+	for name := range sent.accepted {
+		txSender.stats.observeLanding(name, landed)
+	}
+}
+
+// NodeStats returns the transaction broadcasting statistics observed for each node
+func (txSender *TransactionSender[TX, RESULT, CHAIN_ID, RPC]) NodeStats() map[string]SendTxNodeStats {
+	return txSender.stats.snapshot()
+}
+
 func (txSender *TransactionSender[TX, RESULT, CHAIN_ID, RPC]) reportSendTxAnomalies(tx TX, txResults <-chan RESULT) {
 	defer txSender.wg.Done()
 	resultsByCode := sendTxResults[RESULT]{}
@@ -0,0 +1,236 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+)
+
+type testTx string
+
+type testSendTxResult struct {
+	code SendTxReturnCode
+	err  error
+}
+
+func (r *testSendTxResult) Code() SendTxReturnCode { return r.code }
+func (r *testSendTxResult) TxError() error         { return r.err }
+func (r *testSendTxResult) Error() error           { return r.err }
+
+type testSendTxRPC struct {
+	name string
+	code SendTxReturnCode
+
+	mu   sync.Mutex
+	sent []time.Time
+}
+
+func (r *testSendTxRPC) SendTransaction(_ context.Context, _ testTx) *testSendTxResult {
+	r.mu.Lock()
+	r.sent = append(r.sent, time.Now())
+	r.mu.Unlock()
+	if r.code == Successful {
+		return &testSendTxResult{code: Successful}
+	}
+	return &testSendTxResult{code: r.code, err: fmt.Errorf("%s: rejected", r.name)}
+}
+
+func (r *testSendTxRPC) sentTimes() []time.Time {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]time.Time{}, r.sent...)
+}
+
+type testNode struct {
+	Node[StringID, *testSendTxRPC]
+	rpc   *testSendTxRPC
+	state NodeState
+}
+
+func (n *testNode) State() NodeState            { return n.state }
+func (n *testNode) Name() string                { return n.rpc.name }
+func (n *testNode) RPC() *testSendTxRPC         { return n.rpc }
+func (n *testNode) String() string              { return n.rpc.name }
+func (n *testNode) ConfiguredChainID() StringID { return "test" }
+
+func newTestTransactionSender(t *testing.T, tiering SendTxTiering[testTx], nodes ...*testNode) *TransactionSender[testTx, *testSendTxResult, StringID, *testSendTxRPC] {
+	lggr := logger.Test(t)
+	primaryNodes := make([]Node[StringID, *testSendTxRPC], len(nodes))
+	for i, n := range nodes {
+		primaryNodes[i] = n
+	}
+	multiNode := NewMultiNode[StringID, *testSendTxRPC](lggr, NodeSelectionModeRoundRobin, 0, primaryNodes, nil, "test", "solana", 0)
+	tiering.TxKey = func(tx testTx) string { return string(tx) }
+	txSender := NewTransactionSender[testTx, *testSendTxResult, StringID, *testSendTxRPC](
+		lggr,
+		"test",
+		"solana",
+		multiNode,
+		func(err error) *testSendTxResult { return &testSendTxResult{code: Unknown, err: err} },
+		0,
+		tiering,
+	)
+	require.NoError(t, txSender.Start(tests.Context(t)))
+	t.Cleanup(func() { require.NoError(t, txSender.Close()) })
+	return txSender
+}
+
+func newTestNode(name string, code SendTxReturnCode) *testNode {
+	return &testNode{rpc: &testSendTxRPC{name: name, code: code}, state: NodeStateAlive}
+}
+
+func TestTransactionSender_SendTransaction_PriorityFirst(t *testing.T) {
+	t.Parallel()
+
+	staked := newTestNode("staked", Successful)
+	a := newTestNode("a", Successful)
+	b := newTestNode("b", Successful)
+	txSender := newTestTransactionSender(t, SendTxTiering[testTx]{PriorityNodes: []string{"staked"}, FanoutDelay: time.Hour}, staked, a, b)
+
+	result := txSender.SendTransaction(tests.Context(t), "tx")
+	require.NoError(t, result.Error())
+	assert.Len(t, staked.rpc.sentTimes(), 1)
+	assert.Empty(t, a.rpc.sentTimes())
+	assert.Empty(t, b.rpc.sentTimes())
+
+	t.Run("no priority nodes configured", func(t *testing.T) {
+		c := newTestNode("c", Successful)
+		d := newTestNode("d", Successful)
+		txSender := newTestTransactionSender(t, SendTxTiering[testTx]{FanoutDelay: time.Hour}, c, d)
+
+		result := txSender.SendTransaction(tests.Context(t), "tx")
+		require.NoError(t, result.Error())
+		// tiering is disabled, the transaction is broadcast to all nodes at once
+		require.Eventually(t, func() bool {
+			return len(c.rpc.sentTimes()) == 1 && len(d.rpc.sentTimes()) == 1
+		}, tests.WaitTimeout(t), 10*time.Millisecond)
+	})
+}
+
+func TestTransactionSender_SendTransaction_FanoutDelay(t *testing.T) {
+	t.Parallel()
+
+	const delay = 200 * time.Millisecond
+	staked := newTestNode("staked", Successful)
+	a := newTestNode("a", Successful)
+	txSender := newTestTransactionSender(t, SendTxTiering[testTx]{PriorityNodes: []string{"staked"}, FanoutDelay: delay}, staked, a)
+
+	start := time.Now()
+	result := txSender.SendTransaction(tests.Context(t), "tx")
+	require.NoError(t, result.Error())
+	assert.Empty(t, a.rpc.sentTimes())
+
+	require.Eventually(t, func() bool { return len(a.rpc.sentTimes()) == 1 }, tests.WaitTimeout(t), 10*time.Millisecond)
+	assert.GreaterOrEqual(t, a.rpc.sentTimes()[0].Sub(start), delay)
+	// the first tier is not broadcast to again
+	assert.Len(t, staked.rpc.sentTimes(), 1)
+
+	// rebroadcasts after the delay go to all nodes at once
+	result = txSender.SendTransaction(tests.Context(t), "tx")
+	require.NoError(t, result.Error())
+	require.Eventually(t, func() bool {
+		return len(staked.rpc.sentTimes()) == 2 && len(a.rpc.sentTimes()) == 2
+	}, tests.WaitTimeout(t), 10*time.Millisecond)
+}
+
+func TestTransactionSender_SendTransaction_PriorityFailure(t *testing.T) {
+	t.Parallel()
+
+	staked := newTestNode("staked", Fatal)
+	a := newTestNode("a", Successful)
+	b := newTestNode("b", Successful)
+	txSender := newTestTransactionSender(t, SendTxTiering[testTx]{PriorityNodes: []string{"staked"}, FanoutDelay: time.Hour}, staked, a, b)
+
+	// the first tier rejected the transaction, so it fans out without waiting for the delay
+	result := txSender.SendTransaction(tests.Context(t), "tx")
+	require.NoError(t, result.Error())
+	assert.Equal(t, Successful, result.Code())
+	require.Eventually(t, func() bool {
+		return len(a.rpc.sentTimes()) == 1 && len(b.rpc.sentTimes()) == 1
+	}, tests.WaitTimeout(t), 10*time.Millisecond)
+	assert.Len(t, staked.rpc.sentTimes(), 1)
+}
+
+func TestTransactionSender_ReportLanding(t *testing.T) {
+	t.Parallel()
+
+	staked := newTestNode("staked", Successful)
+	rejecting := newTestNode("rejecting", Fatal)
+	txSender := newTestTransactionSender(t, SendTxTiering[testTx]{PriorityNodes: []string{"staked", "rejecting"}, FanoutDelay: time.Hour}, staked, rejecting)
+
+	result := txSender.SendTransaction(tests.Context(t), "tx")
+	require.NoError(t, result.Error())
+	require.Eventually(t, func() bool {
+		return txSender.NodeStats()["staked"].Sent == 1 && txSender.NodeStats()["rejecting"].Sent == 1
+	}, tests.WaitTimeout(t), 10*time.Millisecond)
+
+	txSender.ReportLanding("tx", true)
+	stats := txSender.NodeStats()
+	assert.Equal(t, uint64(1), stats["staked"].Accepted)
+	assert.Equal(t, uint64(1), stats["staked"].Landed)
+	assert.InDelta(t, 2.0/3, stats["staked"].LandingRate(), 1e-9)
+	// landing is only attributed to nodes that accepted the transaction
+	assert.Equal(t, uint64(0), stats["rejecting"].Accepted)
+	assert.Equal(t, uint64(0), stats["rejecting"].Landed)
+
+	// only the first report of a transaction is used
+	txSender.ReportLanding("tx", false)
+	txSender.ReportLanding("unknown", false)
+	assert.Equal(t, uint64(0), txSender.NodeStats()["staked"].Dropped)
+}
+
+func TestTransactionSender_SendTransaction_LandingRate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no priority node alive", func(t *testing.T) {
+		staked := newTestNode("staked", Successful)
+		staked.state = NodeStateUnreachable
+		a := newTestNode("a", Successful)
+		b := newTestNode("b", Successful)
+		txSender := newTestTransactionSender(t, SendTxTiering[testTx]{PriorityNodes: []string{"staked"}, FanoutDelay: time.Hour}, staked, a, b)
+		txSender.stats.observeLanding("a", false)
+		txSender.stats.observeLanding("b", true)
+
+		result := txSender.SendTransaction(tests.Context(t), "tx")
+		require.NoError(t, result.Error())
+		assert.Empty(t, a.rpc.sentTimes())
+		assert.Len(t, b.rpc.sentTimes(), 1)
+	})
+
+	t.Run("primary node outperforms the priority nodes", func(t *testing.T) {
+		staked := newTestNode("staked", Successful)
+		a := newTestNode("a", Successful)
+		b := newTestNode("b", Successful)
+		txSender := newTestTransactionSender(t, SendTxTiering[testTx]{PriorityNodes: []string{"staked"}, FanoutDelay: time.Hour}, staked, a, b)
+		txSender.stats.observeLanding("staked", false)
+		txSender.stats.observeLanding("a", true)
+
+		result := txSender.SendTransaction(tests.Context(t), "tx")
+		require.NoError(t, result.Error())
+		require.Eventually(t, func() bool {
+			return len(staked.rpc.sentTimes()) == 1 && len(a.rpc.sentTimes()) == 1
+		}, tests.WaitTimeout(t), 10*time.Millisecond)
+		assert.Empty(t, b.rpc.sentTimes())
+	})
+
+	t.Run("priority nodes win ties", func(t *testing.T) {
+		staked := newTestNode("staked", Successful)
+		a := newTestNode("a", Successful)
+		txSender := newTestTransactionSender(t, SendTxTiering[testTx]{PriorityNodes: []string{"staked"}, FanoutDelay: time.Hour}, staked, a)
+		txSender.stats.observeLanding("staked", true)
+		txSender.stats.observeLanding("a", true)
+
+		result := txSender.SendTransaction(tests.Context(t), "tx")
+		require.NoError(t, result.Error())
+		assert.Len(t, staked.rpc.sentTimes(), 1)
+		assert.Empty(t, a.rpc.sentTimes())
+	})
+}
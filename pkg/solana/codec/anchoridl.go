@@ -8,15 +8,20 @@ in the `main` package.
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/gagliardetto/utilz"
 )
 
 // https://github.com/project-serum/anchor/blob/97e9e03fb041b8b888a9876a7c0676d9bb4736f3/ts/src/idl.ts
+// IDLs in the Anchor 0.30+ format are normalized to this model when unmarshalled, see IDL.UnmarshalJSON.
 type IDL struct {
 	Version      string           `json:"version"`
 	Name         string           `json:"name"`
+	Address      string           `json:"address,omitempty"`  // @custom
+	Metadata     *IdlMetadata     `json:"metadata,omitempty"` // @custom
+	Docs         []string         `json:"docs,omitempty"`     // @custom
 	Instructions []IdlInstruction `json:"instructions"`
 	Accounts     IdlTypeDefSlice  `json:"accounts,omitempty"`
 	Types        IdlTypeDefSlice  `json:"types,omitempty"`
@@ -25,6 +30,95 @@ type IDL struct {
 	Constants    []IdlConstant    `json:"constants,omitempty"`
 }
 
+// IdlMetadata is the program metadata of an Anchor 0.30+ IDL
+type IdlMetadata struct {
+	Name        string `json:"name"`
+	Version     string `json:"version"`
+	Spec        string `json:"spec"`
+	Description string `json:"description,omitempty"`
+}
+
+// idlV030 is the Anchor 0.30+ IDL format. Accounts and events reference their type in types by name.
+type idlV030 struct {
+	Address      string           `json:"address"`
+	Metadata     IdlMetadata      `json:"metadata"`
+	Docs         []string         `json:"docs"`
+	Instructions []IdlInstruction `json:"instructions"`
+	Accounts     []idlTypeRef     `json:"accounts"`
+	Events       []idlTypeRef     `json:"events"`
+	Errors       []IdlErrorCode   `json:"errors"`
+	Types        IdlTypeDefSlice  `json:"types"`
+	Constants    []IdlConstant    `json:"constants"`
+}
+
+type idlTypeRef struct {
+	Name          string `json:"name"`
+	Discriminator []byte `json:"discriminator"`
+}
+
+// UnmarshalJSON accepts both the legacy and the Anchor 0.30+ IDL formats. The 0.30+ format is identified by its metadata
+// spec and normalized: account and event types are resolved from types and keep their declared discriminators.
+func (idl *IDL) UnmarshalJSON(data []byte) error {
+	type legacyIDL IDL
+
+	var format struct {
+		Metadata *IdlMetadata `json:"metadata"`
+	}
+	if err := json.Unmarshal(data, &format); err != nil {
+		return err
+	}
+
+	// legacy IDLs may have metadata with the program address, but not the spec version
+	if format.Metadata == nil || format.Metadata.Spec == "" {
+		return json.Unmarshal(data, (*legacyIDL)(idl))
+	}
+
+	var raw idlV030
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	normalized := IDL{
+		Version:      raw.Metadata.Version,
+		Name:         raw.Metadata.Name,
+		Address:      raw.Address,
+		Metadata:     &raw.Metadata,
+		Docs:         raw.Docs,
+		Instructions: raw.Instructions,
+		Types:        raw.Types,
+		Errors:       raw.Errors,
+		Constants:    raw.Constants,
+	}
+
+	for _, ref := range raw.Accounts {
+		def := raw.Types.GetByName(ref.Name)
+		if def == nil {
+			return fmt.Errorf("type of account %s is not defined", ref.Name)
+		}
+
+		def.Discriminator = ref.Discriminator
+		normalized.Accounts = append(normalized.Accounts, *def)
+	}
+
+	for _, ref := range raw.Events {
+		def := raw.Types.GetByName(ref.Name)
+		if def == nil || def.Type.Fields == nil {
+			return fmt.Errorf("type of event %s is not a defined struct", ref.Name)
+		}
+
+		event := IdlEvent{Name: ref.Name, Discriminator: ref.Discriminator}
+		for _, field := range *def.Type.Fields {
+			event.Fields = append(event.Fields, IdlEventField{Name: field.Name, Type: field.Type})
+		}
+
+		normalized.Events = append(normalized.Events, event)
+	}
+
+	*idl = normalized
+
+	return nil
+}
+
 type IdlConstant struct {
 	Name  string
 	Type  IdlType
@@ -44,8 +138,9 @@ func (named IdlTypeDefSlice) GetByName(name string) *IdlTypeDef {
 }
 
 type IdlEvent struct {
-	Name   string          `json:"name"`
-	Fields []IdlEventField `json:"fields"`
+	Name          string          `json:"name"`
+	Discriminator []byte          `json:"discriminator,omitempty"` // @custom
+	Fields        []IdlEventField `json:"fields"`
 }
 
 type IdlEventField struct {
@@ -55,10 +150,11 @@ type IdlEventField struct {
 }
 
 type IdlInstruction struct {
-	Name     string              `json:"name"`
-	Docs     []string            `json:"docs"` // @custom
-	Accounts IdlAccountItemSlice `json:"accounts"`
-	Args     []IdlField          `json:"args"`
+	Name          string              `json:"name"`
+	Docs          []string            `json:"docs"`                    // @custom
+	Discriminator []byte              `json:"discriminator,omitempty"` // @custom
+	Accounts      IdlAccountItemSlice `json:"accounts"`
+	Args          []IdlField          `json:"args"`
 }
 
 type IdlAccountItemSlice []IdlAccountItem
@@ -77,6 +173,22 @@ func (slice IdlAccountItemSlice) NumAccounts() (count int) {
 	return count
 }
 
+// Flatten returns the accounts in instruction order, nested accounts are in place of their group
+func (slice IdlAccountItemSlice) Flatten() []IdlAccount {
+	accounts := make([]IdlAccount, 0, slice.NumAccounts())
+	for _, item := range slice {
+		if item.IdlAccount != nil {
+			accounts = append(accounts, *item.IdlAccount)
+		}
+
+		if item.IdlAccounts != nil {
+			accounts = append(accounts, item.IdlAccounts.Accounts.Flatten()...)
+		}
+	}
+
+	return accounts
+}
+
 // type IdlAccountItem = IdlAccount | IdlAccounts;
 type IdlAccountItem struct {
 	IdlAccount  *IdlAccount
@@ -105,12 +217,19 @@ func (env *IdlAccountItem) UnmarshalJSON(data []byte) error {
 				return err
 			}
 		}
-		// Single account:
-		// TODO: check both isMut and isSigner
-		if _, ok := v["isMut"]; ok {
-			if err := utilz.TranscodeJSON(temp, &env.IdlAccount); err != nil {
+		// Single account, Anchor 0.30+ uses writable and signer instead of isMut and isSigner:
+		if _, ok := v["accounts"]; !ok {
+			var target struct {
+				IdlAccount
+				Writable bool `json:"writable"`
+				Signer   bool `json:"signer"`
+			}
+			if err := utilz.TranscodeJSON(temp, &target); err != nil {
 				return err
 			}
+			target.IsMut = target.IsMut || target.Writable
+			target.IsSigner = target.IsSigner || target.Signer
+			env.IdlAccount = &target.IdlAccount
 		}
 	default:
 		return fmt.Errorf("Unknown kind: %s", spew.Sdump(temp))
@@ -124,7 +243,8 @@ type IdlAccount struct {
 	Name     string   `json:"name"`
 	IsMut    bool     `json:"isMut"`
 	IsSigner bool     `json:"isSigner"`
-	Optional bool     `json:"optional"` // @custom
+	Optional bool     `json:"optional"`          // @custom
+	Address  string   `json:"address,omitempty"` // @custom
 }
 
 // A nested/recursive version of IdlAccount.
@@ -158,6 +278,9 @@ const (
 	IdlTypeString    IdlTypeAsString = "string"
 	IdlTypePublicKey IdlTypeAsString = "publicKey"
 
+	// Anchor 0.30+ name of publicKey, normalized to IdlTypePublicKey
+	idlTypePubkey IdlTypeAsString = "pubkey"
+
 	// Custom additions:
 	IdlTypeUnixTimestamp IdlTypeAsString = "unixTimestamp"
 	IdlTypeHash          IdlTypeAsString = "hash"
@@ -172,15 +295,37 @@ type IdlTypeOption struct {
 	Option IdlType `json:"option"`
 }
 
+// IdlTypeCOption is a custom addition for SPL COption values, e.g. {"coption": "publicKey"}
+type IdlTypeCOption struct {
+	COption IdlType `json:"coption"`
+}
+
 // User defined type.
 type IdlTypeDefined struct {
-	Defined string `json:"defined"`
+	Defined  string              `json:"defined"`
+	Generics []IdlDefinedGeneric `json:"generics,omitempty"` // @custom
 }
 
+// IdlDefinedGeneric is an argument of a generic defined type, a type for kind "type" or a value for kind "const"
+type IdlDefinedGeneric struct {
+	Kind  IdlGenericKind `json:"kind"`
+	Type  *IdlType       `json:"type,omitempty"`
+	Value string         `json:"value,omitempty"`
+}
+
+type IdlGenericKind string
+
+const (
+	IdlGenericKindType  IdlGenericKind = "type"
+	IdlGenericKindConst IdlGenericKind = "const"
+)
+
 // Wrapper type:
 type IdlTypeArray struct {
 	Thing IdlType
 	Num   int
+	// Generic is the name of the const generic length, if the length is not a number
+	Generic string
 }
 
 func (env *IdlType) UnmarshalJSON(data []byte) error {
@@ -196,11 +341,21 @@ func (env *IdlType) UnmarshalJSON(data []byte) error {
 	switch v := temp.(type) {
 	case string:
 		env.asString = IdlTypeAsString(v)
+		if env.asString == idlTypePubkey {
+			env.asString = IdlTypePublicKey
+		}
 	case map[string]interface{}:
 		if len(v) == 0 {
 			return nil
 		}
 
+		if got, ok := v["generic"]; ok {
+			name, ok := got.(string)
+			if !ok {
+				return fmt.Errorf("generic is not in expected format:\n%s", spew.Sdump(got))
+			}
+			env.asGeneric = name
+		}
 		if _, ok := v["vec"]; ok {
 			var target IdlTypeVec
 			if err := utilz.TranscodeJSON(temp, &target); err != nil {
@@ -215,11 +370,30 @@ func (env *IdlType) UnmarshalJSON(data []byte) error {
 			}
 			env.asIdlTypeOption = &target
 		}
-		if _, ok := v["defined"]; ok {
-			var target IdlTypeDefined
+		if _, ok := v["coption"]; ok {
+			var target IdlTypeCOption
 			if err := utilz.TranscodeJSON(temp, &target); err != nil {
 				return err
 			}
+			env.asIdlTypeCOption = &target
+		}
+		if got, ok := v["defined"]; ok {
+			var target IdlTypeDefined
+			if _, ok := got.(string); ok {
+				if err := utilz.TranscodeJSON(temp, &target); err != nil {
+					return err
+				}
+			} else {
+				// Anchor 0.30+: {"defined": {"name": "...", "generics": [...]}}
+				var ref struct {
+					Name     string              `json:"name"`
+					Generics []IdlDefinedGeneric `json:"generics"`
+				}
+				if err := utilz.TranscodeJSON(got, &ref); err != nil {
+					return err
+				}
+				target = IdlTypeDefined{Defined: ref.Name, Generics: ref.Generics}
+			}
 			env.asIdlTypeDefined = &target
 		}
 		if got, ok := v["array"]; ok {
@@ -235,7 +409,19 @@ func (env *IdlType) UnmarshalJSON(data []byte) error {
 				return err
 			}
 
-			target.Num = int(arrVal[1].(float64))
+			switch length := arrVal[1].(type) {
+			case float64:
+				target.Num = int(length)
+			case map[string]interface{}:
+				// Anchor 0.30+ const generic length: {"generic": "N"}
+				name, ok := length["generic"].(string)
+				if !ok {
+					return fmt.Errorf("array length is not in expected format:\n%s", spew.Sdump(length))
+				}
+				target.Generic = name
+			default:
+				return fmt.Errorf("array length is not in expected format:\n%s", spew.Sdump(length))
+			}
 
 			env.asIdlTypeArray = &target
 		}
@@ -251,8 +437,10 @@ type IdlType struct {
 	asString         IdlTypeAsString
 	asIdlTypeVec     *IdlTypeVec
 	asIdlTypeOption  *IdlTypeOption
+	asIdlTypeCOption *IdlTypeCOption
 	asIdlTypeDefined *IdlTypeDefined
 	asIdlTypeArray   *IdlTypeArray
+	asGeneric        string
 }
 
 func (env *IdlType) IsString() bool {
@@ -264,12 +452,18 @@ func (env *IdlType) IsIdlTypeVec() bool {
 func (env *IdlType) IsIdlTypeOption() bool {
 	return env.asIdlTypeOption != nil
 }
+func (env *IdlType) IsIdlTypeCOption() bool {
+	return env.asIdlTypeCOption != nil
+}
 func (env *IdlType) IsIdlTypeDefined() bool {
 	return env.asIdlTypeDefined != nil
 }
 func (env *IdlType) IsArray() bool {
 	return env.asIdlTypeArray != nil
 }
+func (env *IdlType) IsGeneric() bool {
+	return env.asGeneric != ""
+}
 
 // Getters:
 func (env *IdlType) GetString() IdlTypeAsString {
@@ -281,16 +475,80 @@ func (env *IdlType) GetIdlTypeVec() *IdlTypeVec {
 func (env *IdlType) GetIdlTypeOption() *IdlTypeOption {
 	return env.asIdlTypeOption
 }
+func (env *IdlType) GetIdlTypeCOption() *IdlTypeCOption {
+	return env.asIdlTypeCOption
+}
 func (env *IdlType) GetIdlTypeDefined() *IdlTypeDefined {
 	return env.asIdlTypeDefined
 }
 func (env *IdlType) GetArray() *IdlTypeArray {
 	return env.asIdlTypeArray
 }
+func (env *IdlType) GetGeneric() string {
+	return env.asGeneric
+}
 
 type IdlTypeDef struct {
-	Name string       `json:"name"`
-	Type IdlTypeDefTy `json:"type"`
+	Name          string           `json:"name"`
+	Docs          []string         `json:"docs,omitempty"`          // @custom
+	Generics      []IdlTypeGeneric `json:"generics,omitempty"`      // @custom
+	Discriminator []byte           `json:"discriminator,omitempty"` // @custom
+	Serialization IdlSerialization `json:"serialization,omitempty"` // @custom
+	Repr          *IdlRepr         `json:"repr,omitempty"`          // @custom
+	Type          IdlTypeDefTy     `json:"type"`
+}
+
+// IdlSerialization is the Anchor 0.30+ serialization of a type, Borsh if not set. Zero-copy types use bytemuck.
+type IdlSerialization string
+
+const (
+	IdlSerializationBorsh          IdlSerialization = "borsh"
+	IdlSerializationBytemuck       IdlSerialization = "bytemuck"
+	IdlSerializationBytemuckUnsafe IdlSerialization = "bytemuckunsafe"
+	// IdlSerializationCustom is a custom serialization, declared as {"custom": ...}
+	IdlSerializationCustom IdlSerialization = "custom"
+)
+
+func (s *IdlSerialization) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err == nil {
+		*s = IdlSerialization(str)
+
+		return nil
+	}
+
+	var custom struct {
+		Custom json.RawMessage `json:"custom"`
+	}
+	if err := json.Unmarshal(data, &custom); err != nil || custom.Custom == nil {
+		return fmt.Errorf("invalid serialization %s", string(data))
+	}
+
+	*s = IdlSerializationCustom
+
+	return nil
+}
+
+// IdlRepr is the Anchor 0.30+ memory representation of a type, declared with #[repr(...)]
+type IdlRepr struct {
+	Kind   IdlReprKind `json:"kind"`
+	Packed bool        `json:"packed,omitempty"`
+	Align  int         `json:"align,omitempty"`
+}
+
+type IdlReprKind string
+
+const (
+	IdlReprKindRust        IdlReprKind = "rust"
+	IdlReprKindC           IdlReprKind = "c"
+	IdlReprKindTransparent IdlReprKind = "transparent"
+)
+
+// IdlTypeGeneric is a generic parameter of a type definition, Type is the type of a const parameter
+type IdlTypeGeneric struct {
+	Kind IdlGenericKind `json:"kind"`
+	Name string         `json:"name"`
+	Type *IdlType       `json:"type,omitempty"`
 }
 
 type IdlTypeDefTyKind string
@@ -298,6 +556,8 @@ type IdlTypeDefTyKind string
 const (
 	IdlTypeDefTyKindStruct IdlTypeDefTyKind = "struct"
 	IdlTypeDefTyKindEnum   IdlTypeDefTyKind = "enum"
+	// IdlTypeDefTyKindType is an Anchor 0.30+ type alias
+	IdlTypeDefTyKindType IdlTypeDefTyKind = "type"
 )
 
 type IdlTypeDefTyStruct struct {
@@ -317,6 +577,46 @@ type IdlTypeDefTy struct {
 
 	Fields   *IdlTypeDefStruct   `json:"fields,omitempty"`
 	Variants IdlEnumVariantSlice `json:"variants,omitempty"`
+	Alias    *IdlType            `json:"alias,omitempty"` // @custom
+}
+
+// UnmarshalJSON normalizes Anchor 0.30+ tuple struct fields to named fields field0, field1, etc. and unit structs
+// without fields to structs with empty fields.
+func (env *IdlTypeDefTy) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Kind     IdlTypeDefTyKind    `json:"kind"`
+		Fields   []json.RawMessage   `json:"fields,omitempty"`
+		Variants IdlEnumVariantSlice `json:"variants,omitempty"`
+		Alias    *IdlType            `json:"alias,omitempty"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*env = IdlTypeDefTy{Kind: raw.Kind, Variants: raw.Variants, Alias: raw.Alias}
+	if raw.Fields == nil && raw.Kind != IdlTypeDefTyKindStruct {
+		return nil
+	}
+
+	fields := make(IdlTypeDefStruct, len(raw.Fields))
+	for i, rawField := range raw.Fields {
+		var named map[string]json.RawMessage
+		if err := json.Unmarshal(rawField, &named); err == nil && named["name"] != nil {
+			if err = json.Unmarshal(rawField, &fields[i]); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		fields[i].Name = "field" + strconv.Itoa(i)
+		if err := json.Unmarshal(rawField, &fields[i].Type); err != nil {
+			return err
+		}
+	}
+	env.Fields = &fields
+
+	return nil
 }
 
 type IdlEnumVariantSlice []IdlEnumVariant
@@ -374,9 +674,9 @@ func (env *IdlEnumFields) UnmarshalJSON(data []byte) error {
 			return nil
 		}
 
-		firstItem := v[0]
+		firstItem, _ := v[0].(map[string]interface{})
 
-		if _, ok := firstItem.(map[string]interface{})["name"]; ok {
+		if _, ok := firstItem["name"]; ok {
 			// TODO:
 			// If has `name` field, then it's most likely a IdlEnumFieldsNamed.
 			if err := utilz.TranscodeJSON(temp, &env.IdlEnumFieldsNamed); err != nil {
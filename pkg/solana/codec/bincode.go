@@ -0,0 +1,154 @@
+package codec
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/codec/encodings"
+	"github.com/smartcontractkit/chainlink-common/pkg/codec/encodings/binary"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+)
+
+const (
+	borshLengthPrefixSize   = 4
+	bincodeLengthPrefixSize = 8
+)
+
+// layoutBuilder is implemented by builders with a container layout other than Borsh, see Bincode
+type layoutBuilder interface {
+	// LengthPrefix is the codec of the length of vectors and bytes
+	LengthPrefix() (encodings.TypeCodec, error)
+	// EnumTag is the codec of the variant index of enums
+	EnumTag() encodings.TypeCodec
+}
+
+// Bincode returns the builder for bincode, as used by native Solana programs (e.g. stake, vote and config accounts).
+// Bincode is little endian like Borsh, but vectors, bytes and strings have a u64 length prefix and enums a u32 tag.
+// Options have a u8 tag like Borsh.
+func Bincode() encodings.Builder {
+	return &bincodeBuilder{Builder: binary.LittleEndian()}
+}
+
+type bincodeBuilder struct {
+	encodings.Builder
+}
+
+var _ layoutBuilder = &bincodeBuilder{}
+
+func (b *bincodeBuilder) String(maxLen uint) (encodings.TypeCodec, error) {
+	prefix, err := b.LengthPrefix()
+	if err != nil {
+		return nil, err
+	}
+
+	return &lengthPrefixedString{prefix: prefix, maxLen: maxLen}, nil
+}
+
+func (b *bincodeBuilder) LengthPrefix() (encodings.TypeCodec, error) {
+	return b.Int(bincodeLengthPrefixSize)
+}
+
+func (b *bincodeBuilder) EnumTag() encodings.TypeCodec {
+	return b.Uint32()
+}
+
+// lengthPrefix returns the codec of the length of vectors and bytes for the builder, Borsh uses a u32
+func lengthPrefix(builder encodings.Builder) (encodings.TypeCodec, error) {
+	if layout, ok := builder.(layoutBuilder); ok {
+		return layout.LengthPrefix()
+	}
+
+	return builder.Int(borshLengthPrefixSize)
+}
+
+// enumTag returns the codec of the enum variant index for the builder, Borsh uses a u8
+func enumTag(builder encodings.Builder) encodings.TypeCodec {
+	if layout, ok := builder.(layoutBuilder); ok {
+		return layout.EnumTag()
+	}
+
+	return builder.Uint8()
+}
+
+// lengthPrefixedString is a string prefixed with its length in bytes
+type lengthPrefixedString struct {
+	prefix encodings.TypeCodec
+	maxLen uint
+}
+
+var _ encodings.TypeCodec = &lengthPrefixedString{}
+
+func (s *lengthPrefixedString) Encode(value any, into []byte) ([]byte, error) {
+	str, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("%w: expected string, got %T", types.ErrInvalidType, value)
+	}
+
+	if uint(len(str)) > s.maxLen {
+		return nil, fmt.Errorf("%w: string length %d exceeds %d", types.ErrInvalidType, len(str), s.maxLen)
+	}
+
+	into, err := s.prefix.Encode(reflect.ValueOf(len(str)).Convert(s.prefix.GetType()).Interface(), into)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(into, str...), nil
+}
+
+func (s *lengthPrefixedString) Decode(encoded []byte) (any, []byte, error) {
+	rawLength, remaining, err := s.prefix.Decode(encoded)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	length, err := toInt(rawLength)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if uint(length) > s.maxLen {
+		return nil, nil, fmt.Errorf("%w: string length %d exceeds %d", types.ErrInvalidEncoding, length, s.maxLen)
+	}
+
+	return encodings.SafeDecode(remaining, length, func(raw []byte) string { return string(raw) })
+}
+
+func (s *lengthPrefixedString) GetType() reflect.Type {
+	return reflect.TypeOf("")
+}
+
+func (s *lengthPrefixedString) Size(numItems int) (int, error) {
+	prefixSize, err := s.prefix.Size(numItems)
+	if err != nil {
+		return 0, err
+	}
+
+	return prefixSize + numItems, nil
+}
+
+func (s *lengthPrefixedString) FixedSize() (int, error) {
+	return 0, fmt.Errorf("%w: strings do not have a fixed size", types.ErrInvalidType)
+}
+
+// toInt converts a decoded length prefix or enum tag to an int
+func toInt(raw any) (int, error) {
+	rValue := reflect.ValueOf(raw)
+	switch {
+	case rValue.CanInt():
+		if rValue.Int() < 0 {
+			return 0, fmt.Errorf("%w: negative value %d", types.ErrInvalidEncoding, rValue.Int())
+		}
+
+		return int(rValue.Int()), nil
+	case rValue.CanUint():
+		if rValue.Uint() > math.MaxInt {
+			return 0, fmt.Errorf("%w: value %d is too large", types.ErrInvalidEncoding, rValue.Uint())
+		}
+
+		return int(rValue.Uint()), nil
+	default:
+		return 0, fmt.Errorf("%w: value must be an integer, got %T", types.ErrInvalidEncoding, raw)
+	}
+}
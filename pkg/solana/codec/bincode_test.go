@@ -0,0 +1,99 @@
+package codec_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	ag_solana "github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/codec"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/codec/testutils"
+)
+
+const nativeAccountIDL = `{
+	"version": "0.1.0",
+	"name": "native",
+	"accounts": [{
+		"name": "NativeAccount",
+		"type": {
+			"kind": "struct",
+			"fields": [
+				{"name": "state", "type": {"defined": "State"}},
+				{"name": "keys", "type": {"vec": "publicKey"}},
+				{"name": "info", "type": "string"},
+				{"name": "data", "type": "bytes"},
+				{"name": "lockup", "type": {"option": "u64"}}
+			]
+		}
+	}],
+	"types": [{
+		"name": "State",
+		"type": {
+			"kind": "enum",
+			"variants": [{"name": "Uninitialized"}, {"name": "Initialized"}]
+		}
+	}]
+}`
+
+type nativeAccount struct {
+	State  uint32
+	Keys   []ag_solana.PublicKey
+	Info   string
+	Data   []byte
+	Lockup *uint64
+}
+
+func TestBincode(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+
+	t.Run("native account", func(t *testing.T) {
+		var idl codec.IDL
+		require.NoError(t, json.Unmarshal([]byte(nativeAccountIDL), &idl))
+
+		entry, err := codec.NewIDLNativeAccountCodec(idl, codec.Bincode())
+		require.NoError(t, err)
+
+		lockup := uint64(2)
+		expected := nativeAccount{State: 1, Keys: []ag_solana.PublicKey{{7}}, Info: "ab", Data: []byte{3}, Lockup: &lockup}
+		bts, err := entry.Encode(ctx, expected, "NativeAccount")
+		require.NoError(t, err)
+
+		var want []byte
+		want = append(want, 1, 0, 0, 0)             // u32 enum tag
+		want = append(want, 1, 0, 0, 0, 0, 0, 0, 0) // u64 vec length, no discriminator
+		want = append(want, expected.Keys[0].Bytes()...)
+		want = append(want, 2, 0, 0, 0, 0, 0, 0, 0, 'a', 'b') // u64 string length
+		want = append(want, 1, 0, 0, 0, 0, 0, 0, 0, 3)        // u64 bytes length
+		want = append(want, 1, 2, 0, 0, 0, 0, 0, 0, 0)        // u8 option tag
+		require.Equal(t, want, bts)
+
+		var decoded nativeAccount
+		require.NoError(t, entry.Decode(ctx, bts, &decoded, "NativeAccount"))
+		require.Equal(t, expected, decoded)
+
+		// truncated bytes are invalid
+		require.Error(t, entry.Decode(ctx, bts[:len(bts)-15], &decoded, "NativeAccount"))
+	})
+
+	t.Run("enums with variants", func(t *testing.T) {
+		var idl codec.IDL
+		require.NoError(t, json.Unmarshal([]byte(testutils.EnumIDL), &idl))
+
+		entry, err := codec.NewIDLDefinedTypesCodec(idl, codec.Bincode())
+		require.NoError(t, err)
+
+		expected := testutils.Shape{Variant: "Rect", Rect: &testutils.ShapeRect{Width: 2, Height: 3}}
+		bts, err := entry.Encode(ctx, expected, testutils.TestShape)
+		require.NoError(t, err)
+		require.Equal(t, []byte{2, 0, 0, 0, 2, 0, 3, 0}, bts)
+
+		var decoded testutils.Shape
+		require.NoError(t, entry.Decode(ctx, bts, &decoded, testutils.TestShape))
+		require.Equal(t, expected, decoded)
+	})
+}
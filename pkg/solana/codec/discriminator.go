@@ -12,9 +12,40 @@ import (
 
 const discriminatorLength = 8
 
+// Anchor discriminator namespaces, the discriminator is the prefix of sha256("<namespace>:<name>")
+const (
+	accountNamespace     = "account"
+	instructionNamespace = "global"
+	eventNamespace       = "event"
+)
+
 func NewDiscriminator(name string) encodings.TypeCodec {
-	sum := sha256.Sum256([]byte("account:" + name))
-	return &discriminator{hashPrefix: sum[:discriminatorLength]}
+	return &discriminator{hashPrefix: hashDiscriminator(accountNamespace, name)}
+}
+
+// AccountDiscriminator returns the discriminator of the IDL account, declared in Anchor 0.30+ IDLs or computed from the
+// account name.
+func AccountDiscriminator(idl IDL, name string) ([]byte, error) {
+	def := idl.Accounts.GetByName(name)
+	if def == nil {
+		return nil, fmt.Errorf("%w: unknown IDL account %s", types.ErrInvalidType, name)
+	}
+
+	if len(def.Discriminator) > 0 {
+		return def.Discriminator, nil
+	}
+
+	return hashDiscriminator(accountNamespace, name), nil
+}
+
+func hashDiscriminator(namespace, name string) []byte {
+	sum := sha256.Sum256([]byte(namespace + ":" + name))
+	return sum[:discriminatorLength]
+}
+
+// NewDeclaredDiscriminator uses the discriminator declared in an Anchor 0.30+ IDL, which is not required to be 8 bytes
+func NewDeclaredDiscriminator(declared []byte) encodings.TypeCodec {
+	return &discriminator{hashPrefix: declared}
 }
 
 type discriminator struct {
@@ -45,7 +76,7 @@ func (d discriminator) Encode(value any, into []byte) ([]byte, error) {
 }
 
 func (d discriminator) Decode(encoded []byte) (any, []byte, error) {
-	raw, remaining, err := encodings.SafeDecode(encoded, discriminatorLength, func(raw []byte) []byte { return raw })
+	raw, remaining, err := encodings.SafeDecode(encoded, len(d.hashPrefix), func(raw []byte) []byte { return raw })
 	if err != nil {
 		return nil, nil, err
 	}
@@ -63,9 +94,9 @@ func (d discriminator) GetType() reflect.Type {
 }
 
 func (d discriminator) Size(_ int) (int, error) {
-	return discriminatorLength, nil
+	return len(d.hashPrefix), nil
 }
 
 func (d discriminator) FixedSize() (int, error) {
-	return discriminatorLength, nil
+	return len(d.hashPrefix), nil
 }
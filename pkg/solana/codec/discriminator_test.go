@@ -81,4 +81,38 @@ func TestDiscriminator(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, 8, size)
 	})
+
+	t.Run("declared discriminators are used as is", func(t *testing.T) {
+		declared := []byte{0x01, 0x02, 0x03, 0x04}
+		c := codec.NewDeclaredDiscriminator(declared)
+		encoded, err := c.Encode(nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, declared, encoded)
+		actual, remaining, err := c.Decode(append(encoded, 0x05))
+		require.NoError(t, err)
+		require.Equal(t, &declared, actual)
+		require.Equal(t, []byte{0x05}, remaining)
+		size, err := c.FixedSize()
+		require.NoError(t, err)
+		require.Equal(t, 4, size)
+	})
+}
+
+func TestAccountDiscriminator(t *testing.T) {
+	idl := codec.IDL{Accounts: codec.IdlTypeDefSlice{
+		{Name: "Foo"},
+		{Name: "Bar", Discriminator: []byte{0x01, 0x02}},
+	}}
+
+	tmp := sha256.Sum256([]byte("account:Foo"))
+	actual, err := codec.AccountDiscriminator(idl, "Foo")
+	require.NoError(t, err)
+	require.Equal(t, tmp[:8], actual)
+
+	actual, err = codec.AccountDiscriminator(idl, "Bar")
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x01, 0x02}, actual)
+
+	_, err = codec.AccountDiscriminator(idl, "Baz")
+	require.True(t, errors.Is(err, types.ErrInvalidType))
 }
@@ -0,0 +1,259 @@
+package codec
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/cases"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/codec/encodings"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+)
+
+// EnumVariantField is the name of the field holding the variant name of an enum with variants
+const EnumVariantField = "Variant"
+
+// enumVariant is a variant of an enum, payload is nil for unit variants
+type enumVariant struct {
+	name    string
+	field   string
+	payload encodings.TypeCodec
+}
+
+// enumCodec is a tagged union codec for Anchor (Borsh) enums with variants: a uint8 variant index (uint32 with bincode)
+// followed by the variant fields. The Go representation is a struct with the variant name in Variant and a pointer field per variant
+// with fields, only the field of the selected variant is set. Tuple variant fields are named Field0, Field1, etc.
+type enumCodec struct {
+	tag      encodings.TypeCodec
+	variants []enumVariant
+	tpe      reflect.Type
+}
+
+var _ encodings.TypeCodec = &enumCodec{}
+
+func asEnum(def IdlTypeDef, refs *codecRefs, caser cases.Caser) (encodings.TypeCodec, error) {
+	tag := enumTag(refs.builder)
+	tagSize, err := tag.FixedSize()
+	if err != nil {
+		return nil, err
+	}
+
+	if tagSize < 8 && len(def.Type.Variants) > 1<<(8*tagSize) {
+		return nil, fmt.Errorf("%w: enum %s has more than %d variants", types.ErrInvalidConfig, def.Name, 1<<(8*tagSize))
+	}
+
+	fields := []reflect.StructField{{Name: EnumVariantField, Type: reflect.TypeOf("")}}
+	variants := make([]enumVariant, len(def.Type.Variants))
+	for i, v := range def.Type.Variants {
+		variant := enumVariant{name: v.Name, field: caser.String(v.Name)}
+		if strings.EqualFold(variant.field, EnumVariantField) {
+			return nil, fmt.Errorf("%w: enum %s variant name %s is reserved", types.ErrInvalidConfig, def.Name, v.Name)
+		}
+
+		if v.Fields != nil {
+			var named []encodings.NamedTypeCodec
+			switch {
+			case v.Fields.IdlEnumFieldsNamed != nil:
+				for _, field := range *v.Fields.IdlEnumFieldsNamed {
+					fieldCodec, err := processFieldType(def.Name, field.Type, refs)
+					if err != nil {
+						return nil, err
+					}
+					named = append(named, encodings.NamedTypeCodec{Name: caser.String(field.Name), Codec: fieldCodec})
+				}
+			case v.Fields.IdlEnumFieldsTuple != nil:
+				for idx, fieldType := range *v.Fields.IdlEnumFieldsTuple {
+					fieldCodec, err := processFieldType(def.Name, fieldType, refs)
+					if err != nil {
+						return nil, err
+					}
+					named = append(named, encodings.NamedTypeCodec{Name: "Field" + strconv.Itoa(idx), Codec: fieldCodec})
+				}
+			}
+
+			if len(named) > 0 {
+				payload, err := encodings.NewStructCodec(named)
+				if err != nil {
+					return nil, err
+				}
+				variant.payload = payload
+
+				payloadType := payload.GetType()
+				if payloadType.Kind() != reflect.Pointer {
+					payloadType = reflect.PointerTo(payloadType)
+				}
+				fields = append(fields, reflect.StructField{Name: variant.field, Type: payloadType})
+			}
+		}
+
+		variants[i] = variant
+	}
+
+	return &enumCodec{
+		tag:      tag,
+		variants: variants,
+		tpe:      reflect.PointerTo(reflect.StructOf(fields)),
+	}, nil
+}
+
+func (e *enumCodec) Encode(value any, into []byte) ([]byte, error) {
+	rValue := reflect.ValueOf(value)
+	if rValue.Kind() == reflect.Pointer {
+		if rValue.IsNil() {
+			return nil, fmt.Errorf("%w: enum value must not be nil", types.ErrInvalidType)
+		}
+		rValue = rValue.Elem()
+	}
+
+	if rValue.Type() != e.tpe.Elem() {
+		return nil, fmt.Errorf("%w: expected %v, got %T", types.ErrInvalidType, e.tpe, value)
+	}
+
+	idx, err := e.selected(rValue)
+	if err != nil {
+		return nil, err
+	}
+
+	if into, err = e.tag.Encode(reflect.ValueOf(idx).Convert(e.tag.GetType()).Interface(), into); err != nil {
+		return nil, err
+	}
+
+	variant := e.variants[idx]
+	if variant.payload == nil {
+		return into, nil
+	}
+
+	payload := rValue.FieldByName(variant.field)
+	if payload.IsNil() {
+		return nil, fmt.Errorf("%w: enum variant %s is missing its fields", types.ErrInvalidType, variant.name)
+	}
+
+	return variant.payload.Encode(payload.Interface(), into)
+}
+
+// selected returns the index of the variant named in Variant. If Variant is empty, the variant with fields set is used.
+func (e *enumCodec) selected(rValue reflect.Value) (int, error) {
+	name := rValue.FieldByName(EnumVariantField).String()
+	if name != "" {
+		for i, variant := range e.variants {
+			if strings.EqualFold(variant.name, name) {
+				return i, nil
+			}
+		}
+
+		return 0, fmt.Errorf("%w: unknown enum variant %s", types.ErrInvalidType, name)
+	}
+
+	selected := -1
+	for i, variant := range e.variants {
+		if variant.payload == nil || rValue.FieldByName(variant.field).IsNil() {
+			continue
+		}
+
+		if selected >= 0 {
+			return 0, fmt.Errorf("%w: enum variants %s and %s are both set", types.ErrInvalidType, e.variants[selected].name, variant.name)
+		}
+
+		selected = i
+	}
+
+	if selected < 0 {
+		return 0, fmt.Errorf("%w: enum %s is not set", types.ErrInvalidType, EnumVariantField)
+	}
+
+	return selected, nil
+}
+
+func (e *enumCodec) Decode(encoded []byte) (any, []byte, error) {
+	rawTag, remaining, err := e.tag.Decode(encoded)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	idx, err := toInt(rawTag)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if idx >= len(e.variants) {
+		return nil, nil, fmt.Errorf("%w: unknown enum variant index %d", types.ErrInvalidEncoding, idx)
+	}
+
+	variant := e.variants[idx]
+	value := reflect.New(e.tpe.Elem())
+	value.Elem().FieldByName(EnumVariantField).SetString(variant.name)
+
+	if variant.payload != nil {
+		var payload any
+		if payload, remaining, err = variant.payload.Decode(remaining); err != nil {
+			return nil, nil, err
+		}
+
+		field := value.Elem().FieldByName(variant.field)
+		rPayload := reflect.ValueOf(payload)
+		if rPayload.Kind() != reflect.Pointer {
+			ptr := reflect.New(rPayload.Type())
+			ptr.Elem().Set(rPayload)
+			rPayload = ptr
+		}
+		field.Set(rPayload)
+	}
+
+	return value.Interface(), remaining, nil
+}
+
+func (e *enumCodec) GetType() reflect.Type {
+	return e.tpe
+}
+
+// Size returns the size of the largest variant, the space allocated for an enum in an account
+func (e *enumCodec) Size(numItems int) (int, error) {
+	tagSize, err := e.tag.Size(numItems)
+	if err != nil {
+		return 0, err
+	}
+
+	largest := 0
+	for _, variant := range e.variants {
+		if variant.payload == nil {
+			continue
+		}
+
+		size, err := variant.payload.Size(numItems)
+		if err != nil {
+			return 0, err
+		}
+
+		largest = max(largest, size)
+	}
+
+	return tagSize + largest, nil
+}
+
+// FixedSize is only defined when all variants encode to the same size
+func (e *enumCodec) FixedSize() (int, error) {
+	tagSize, err := e.tag.FixedSize()
+	if err != nil {
+		return 0, err
+	}
+
+	size := -1
+	for _, variant := range e.variants {
+		variantSize := 0
+		if variant.payload != nil {
+			if variantSize, err = variant.payload.FixedSize(); err != nil {
+				return 0, err
+			}
+		}
+
+		if size >= 0 && size != variantSize {
+			return 0, fmt.Errorf("%w: enum variants have different sizes", types.ErrInvalidType)
+		}
+
+		size = variantSize
+	}
+
+	return tagSize + max(size, 0), nil
+}
@@ -0,0 +1,100 @@
+package codec
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/codec/encodings"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+)
+
+const programDataLogPrefix = "Program data: "
+
+// EventCodec decodes Anchor events emitted with emit!, logged by the program as "Program data: <base64>".
+// The item type is the IDL event name.
+type EventCodec struct {
+	types.RemoteCodec
+	discriminators map[string][]byte
+}
+
+// NewIDLEventCodec is for Anchor events. The discriminator is declared in Anchor 0.30+ IDLs, or is the prefix of
+// sha256("event:<name>").
+func NewIDLEventCodec(idl IDL, builder encodings.Builder) (*EventCodec, error) {
+	defs := make(IdlTypeDefSlice, len(idl.Events))
+	discriminators := make(map[string][]byte, len(idl.Events))
+
+	for i, event := range idl.Events {
+		discriminator := event.Discriminator
+		if len(discriminator) == 0 {
+			discriminator = hashDiscriminator(eventNamespace, event.Name)
+		}
+
+		fields := make(IdlTypeDefStruct, len(event.Fields))
+		for j, field := range event.Fields {
+			fields[j] = IdlField{Name: field.Name, Type: field.Type}
+		}
+
+		defs[i] = IdlTypeDef{
+			Name:          event.Name,
+			Discriminator: discriminator,
+			Type:          IdlTypeDefTy{Kind: IdlTypeDefTyKindStruct, Fields: &fields},
+		}
+		discriminators[event.Name] = discriminator
+	}
+
+	remoteCodec, err := newIDLCoded(idl, builder, defs, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventCodec{RemoteCodec: remoteCodec, discriminators: discriminators}, nil
+}
+
+// ProgramData returns the payload of a "Program data: <base64>" program log
+func ProgramData(log string) ([]byte, bool) {
+	encoded, ok := strings.CutPrefix(log, programDataLogPrefix)
+	if !ok {
+		return nil, false
+	}
+
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, false
+	}
+
+	return data, true
+}
+
+// EventName returns the name of the event encoded in data, identified by its discriminator
+func (c *EventCodec) EventName(data []byte) (string, error) {
+	for name, discriminator := range c.discriminators {
+		if bytes.HasPrefix(data, discriminator) {
+			return name, nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: unknown event discriminator %x", types.ErrInvalidEncoding, data[:min(len(data), discriminatorLength)])
+}
+
+// DecodeLog decodes the event from a "Program data: <base64>" program log into into. It returns false if the log is
+// not the event, e.g. another event or a log of another kind.
+func (c *EventCodec) DecodeLog(ctx context.Context, log string, into any, event string) (bool, error) {
+	discriminator, ok := c.discriminators[event]
+	if !ok {
+		return false, fmt.Errorf("%w: unknown event %s", types.ErrInvalidType, event)
+	}
+
+	data, ok := ProgramData(log)
+	if !ok || !bytes.HasPrefix(data, discriminator) {
+		return false, nil
+	}
+
+	if err := c.Decode(ctx, data, into, event); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
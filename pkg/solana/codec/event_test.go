@@ -0,0 +1,68 @@
+package codec_test
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/codec/encodings/binary"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/codec"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/codec/testutils"
+)
+
+type valueSet struct {
+	Value       uint8
+	InnerStruct testutils.ObjectRef1
+}
+
+func TestNewIDLEventCodec(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	var idl codec.IDL
+	require.NoError(t, json.Unmarshal([]byte(testutils.JSONIDLWithAllTypes), &idl))
+
+	entry, err := codec.NewIDLEventCodec(idl, binary.LittleEndian())
+	require.NoError(t, err)
+
+	expected := valueSet{Value: 3, InnerStruct: testutils.DefaultTestStruct.InnerStruct}
+	bts, err := entry.Encode(ctx, expected, "ValueSet")
+	require.NoError(t, err)
+	sum := sha256.Sum256([]byte("event:ValueSet"))
+	require.Equal(t, sum[:8], bts[:8])
+
+	log := "Program data: " + base64.StdEncoding.EncodeToString(bts)
+	data, ok := codec.ProgramData(log)
+	require.True(t, ok)
+	name, err := entry.EventName(data)
+	require.NoError(t, err)
+	assert.Equal(t, "ValueSet", name)
+
+	var decoded valueSet
+	ok, err = entry.DecodeLog(ctx, log, &decoded, "ValueSet")
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, expected, decoded)
+
+	// other logs and events are skipped
+	for _, other := range []string{
+		"Program log: Instruction: SetValue",
+		"Program data: " + base64.StdEncoding.EncodeToString(make([]byte, 16)),
+	} {
+		ok, err = entry.DecodeLog(ctx, other, &decoded, "ValueSet")
+		require.NoError(t, err)
+		assert.False(t, ok)
+	}
+
+	_, err = entry.EventName(make([]byte, 16))
+	require.ErrorIs(t, err, types.ErrInvalidEncoding)
+	_, err = entry.DecodeLog(ctx, log, &decoded, "Unknown")
+	require.ErrorIs(t, err, types.ErrInvalidType)
+}
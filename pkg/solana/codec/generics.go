@@ -0,0 +1,165 @@
+package codec
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+)
+
+// instantiate returns a copy of the generic type definition with its generic parameters replaced by args
+func (def IdlTypeDef) instantiate(args []IdlDefinedGeneric) (IdlTypeDef, error) {
+	if len(args) != len(def.Generics) {
+		return IdlTypeDef{}, fmt.Errorf("%w: type %s expects %d generic arguments, got %d", types.ErrInvalidConfig, def.Name, len(def.Generics), len(args))
+	}
+
+	params := make(map[string]IdlDefinedGeneric, len(args))
+	for i, generic := range def.Generics {
+		if args[i].Kind != generic.Kind {
+			return IdlTypeDef{}, fmt.Errorf("%w: generic %s of type %s is a %s, got a %s", types.ErrInvalidConfig, generic.Name, def.Name, generic.Kind, args[i].Kind)
+		}
+
+		if args[i].Kind == IdlGenericKindType && args[i].Type == nil {
+			return IdlTypeDef{}, fmt.Errorf("%w: generic %s of type %s has no type", types.ErrInvalidConfig, generic.Name, def.Name)
+		}
+
+		params[generic.Name] = args[i]
+	}
+
+	instance := IdlTypeDef{
+		Name:          def.Name,
+		Docs:          def.Docs,
+		Serialization: def.Serialization,
+		Repr:          def.Repr,
+		Type:          IdlTypeDefTy{Kind: def.Type.Kind},
+	}
+
+	if def.Type.Fields != nil {
+		fields, err := substituteFields(*def.Type.Fields, params)
+		if err != nil {
+			return IdlTypeDef{}, err
+		}
+
+		instance.Type.Fields = &fields
+	}
+
+	if def.Type.Alias != nil {
+		alias, err := def.Type.Alias.substitute(params)
+		if err != nil {
+			return IdlTypeDef{}, err
+		}
+
+		instance.Type.Alias = &alias
+	}
+
+	for _, variant := range def.Type.Variants {
+		if variant.Fields != nil {
+			var fields IdlEnumFields
+			switch {
+			case variant.Fields.IdlEnumFieldsNamed != nil:
+				named, err := substituteFields(*variant.Fields.IdlEnumFieldsNamed, params)
+				if err != nil {
+					return IdlTypeDef{}, err
+				}
+
+				fields.IdlEnumFieldsNamed = (*IdlEnumFieldsNamed)(&named)
+			case variant.Fields.IdlEnumFieldsTuple != nil:
+				tuple := make(IdlEnumFieldsTuple, len(*variant.Fields.IdlEnumFieldsTuple))
+				for i, fieldType := range *variant.Fields.IdlEnumFieldsTuple {
+					var err error
+					if tuple[i], err = fieldType.substitute(params); err != nil {
+						return IdlTypeDef{}, err
+					}
+				}
+
+				fields.IdlEnumFieldsTuple = &tuple
+			}
+
+			variant.Fields = &fields
+		}
+
+		instance.Type.Variants = append(instance.Type.Variants, variant)
+	}
+
+	return instance, nil
+}
+
+func substituteFields(fields []IdlField, params map[string]IdlDefinedGeneric) ([]IdlField, error) {
+	substituted := make([]IdlField, len(fields))
+	for i, field := range fields {
+		substituted[i] = field
+
+		var err error
+		if substituted[i].Type, err = field.Type.substitute(params); err != nil {
+			return nil, err
+		}
+	}
+
+	return substituted, nil
+}
+
+// substitute returns a copy of the type with type generics and const generic array lengths replaced by params
+func (env IdlType) substitute(params map[string]IdlDefinedGeneric) (IdlType, error) {
+	switch {
+	case env.IsGeneric():
+		param, ok := params[env.asGeneric]
+		if !ok || param.Kind != IdlGenericKindType {
+			return IdlType{}, fmt.Errorf("%w: type generic %s is not defined", types.ErrInvalidConfig, env.asGeneric)
+		}
+
+		return *param.Type, nil
+	case env.IsIdlTypeVec():
+		inner, err := env.asIdlTypeVec.Vec.substitute(params)
+		return IdlType{asIdlTypeVec: &IdlTypeVec{Vec: inner}}, err
+	case env.IsIdlTypeOption():
+		inner, err := env.asIdlTypeOption.Option.substitute(params)
+		return IdlType{asIdlTypeOption: &IdlTypeOption{Option: inner}}, err
+	case env.IsIdlTypeCOption():
+		inner, err := env.asIdlTypeCOption.COption.substitute(params)
+		return IdlType{asIdlTypeCOption: &IdlTypeCOption{COption: inner}}, err
+	case env.IsArray():
+		thing, err := env.asIdlTypeArray.Thing.substitute(params)
+		if err != nil {
+			return IdlType{}, err
+		}
+
+		array := IdlTypeArray{Thing: thing, Num: env.asIdlTypeArray.Num}
+		if name := env.asIdlTypeArray.Generic; name != "" {
+			param, ok := params[name]
+			if !ok || param.Kind != IdlGenericKindConst {
+				return IdlType{}, fmt.Errorf("%w: const generic %s is not defined", types.ErrInvalidConfig, name)
+			}
+
+			if array.Num, err = strconv.Atoi(param.Value); err != nil {
+				return IdlType{}, fmt.Errorf("%w: const generic %s is not an array length: %w", types.ErrInvalidConfig, name, err)
+			}
+		}
+
+		return IdlType{asIdlTypeArray: &array}, nil
+	case env.IsIdlTypeDefined():
+		defined := IdlTypeDefined{Defined: env.asIdlTypeDefined.Defined}
+		for _, arg := range env.asIdlTypeDefined.Generics {
+			switch {
+			case arg.Type != nil && arg.Type.IsGeneric() && params[arg.Type.asGeneric].Kind == IdlGenericKindConst:
+				// const generic passed through to the nested type as {"kind": "type", "type": {"generic": "N"}}
+				arg = IdlDefinedGeneric{Kind: IdlGenericKindConst, Value: params[arg.Type.asGeneric].Value}
+			case arg.Type != nil:
+				argType, err := arg.Type.substitute(params)
+				if err != nil {
+					return IdlType{}, err
+				}
+
+				arg.Type = &argType
+			case params[arg.Value].Kind == IdlGenericKindConst:
+				// const generic passed through to the nested type as {"kind": "const", "value": "N"}
+				arg.Value = params[arg.Value].Value
+			}
+
+			defined.Generics = append(defined.Generics, arg)
+		}
+
+		return IdlType{asIdlTypeDefined: &defined}, nil
+	default:
+		return env, nil
+	}
+}
@@ -0,0 +1,112 @@
+package codec
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/gagliardetto/solana-go"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/codec/encodings"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+)
+
+// InstructionCodec encodes Anchor instruction data, the instruction discriminator followed by the instruction args.
+// The item type is the IDL instruction name.
+type InstructionCodec struct {
+	types.RemoteCodec
+	accounts map[string][]IdlAccount
+}
+
+// NewIDLInstructionCodec is for Anchor instructions. The discriminator is declared in Anchor 0.30+ IDLs, or is the
+// prefix of sha256("global:<snake_case name>").
+func NewIDLInstructionCodec(idl IDL, builder encodings.Builder) (*InstructionCodec, error) {
+	defs := make(IdlTypeDefSlice, len(idl.Instructions))
+	accounts := make(map[string][]IdlAccount, len(idl.Instructions))
+
+	for i, instruction := range idl.Instructions {
+		discriminator := instruction.Discriminator
+		if len(discriminator) == 0 {
+			discriminator = hashDiscriminator(instructionNamespace, toSnakeCase(instruction.Name))
+		}
+
+		args := IdlTypeDefStruct(instruction.Args)
+		defs[i] = IdlTypeDef{
+			Name:          instruction.Name,
+			Discriminator: discriminator,
+			Type:          IdlTypeDefTy{Kind: IdlTypeDefTyKindStruct, Fields: &args},
+		}
+		accounts[instruction.Name] = instruction.Accounts.Flatten()
+	}
+
+	remoteCodec, err := newIDLCoded(idl, builder, defs, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &InstructionCodec{RemoteCodec: remoteCodec, accounts: accounts}, nil
+}
+
+// Accounts returns the accounts of the instruction in the order expected by the program
+func (c *InstructionCodec) Accounts(instruction string) ([]IdlAccount, error) {
+	accounts, ok := c.accounts[instruction]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown instruction %s", types.ErrInvalidType, instruction)
+	}
+
+	return accounts, nil
+}
+
+// AccountMetas returns the account metas of the instruction from the account addresses by IDL account name.
+// Optional accounts without an address are set to the program ID, as expected by Anchor.
+func (c *InstructionCodec) AccountMetas(instruction string, programID solana.PublicKey, addresses map[string]solana.PublicKey) (solana.AccountMetaSlice, error) {
+	accounts, err := c.Accounts(instruction)
+	if err != nil {
+		return nil, err
+	}
+
+	metas := make(solana.AccountMetaSlice, len(accounts))
+	for i, account := range accounts {
+		address, ok := addresses[account.Name]
+		switch {
+		case ok:
+			metas[i] = solana.Meta(address)
+		case account.Optional:
+			metas[i] = solana.Meta(programID)
+			continue
+		default:
+			return nil, fmt.Errorf("%w: missing account %s of instruction %s", types.ErrInvalidType, account.Name, instruction)
+		}
+
+		if account.IsMut {
+			metas[i] = metas[i].WRITE()
+		}
+
+		if account.IsSigner {
+			metas[i] = metas[i].SIGNER()
+		}
+	}
+
+	return metas, nil
+}
+
+// toSnakeCase converts a legacy IDL instruction name (e.g. setConfig or setOCRConfig) to the name of the program
+// function (set_config or set_ocr_config) used in the instruction discriminator.
+func toSnakeCase(name string) string {
+	runes := []rune(name)
+
+	var sb strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prev := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextIsLower) {
+				sb.WriteRune('_')
+			}
+		}
+
+		sb.WriteRune(unicode.ToLower(r))
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,92 @@
+package codec_test
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"testing"
+
+	ag_solana "github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/codec/encodings/binary"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/codec"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/codec/testutils"
+)
+
+type setValueArgs struct {
+	Value       uint8
+	Option      *string
+	InnerStruct testutils.ObjectRef1
+}
+
+func TestNewIDLInstructionCodec(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	var idl codec.IDL
+	require.NoError(t, json.Unmarshal([]byte(testutils.JSONIDLWithAllTypes), &idl))
+
+	entry, err := codec.NewIDLInstructionCodec(idl, binary.LittleEndian())
+	require.NoError(t, err)
+
+	t.Run("encodes args after the instruction discriminator", func(t *testing.T) {
+		expected := setValueArgs{Value: 1, Option: &testutils.DefaultStringRef, InnerStruct: testutils.DefaultTestStruct.InnerStruct}
+		bts, err := entry.Encode(ctx, expected, "setValue")
+		require.NoError(t, err)
+
+		// the discriminator uses the snake case name of the program function
+		sum := sha256.Sum256([]byte("global:set_value"))
+		require.Equal(t, sum[:8], bts[:8])
+
+		var decoded setValueArgs
+		require.NoError(t, entry.Decode(ctx, bts, &decoded, "setValue"))
+		require.Equal(t, expected, decoded)
+
+		bts, err = entry.Encode(ctx, struct{}{}, "reset")
+		require.NoError(t, err)
+		sum = sha256.Sum256([]byte("global:reset"))
+		require.Equal(t, sum[:8], bts)
+	})
+
+	t.Run("accounts are in instruction order", func(t *testing.T) {
+		accounts, err := entry.Accounts("setValue")
+		require.NoError(t, err)
+
+		var names []string
+		for _, account := range accounts {
+			names = append(names, account.Name)
+		}
+		assert.Equal(t, []string{"state", "authority", "transmissions", "store", "owner"}, names)
+
+		_, err = entry.Accounts("unknown")
+		require.ErrorIs(t, err, types.ErrInvalidType)
+	})
+
+	t.Run("account metas", func(t *testing.T) {
+		programID := ag_solana.PublicKey{9}
+		addresses := map[string]ag_solana.PublicKey{
+			"state":         {1},
+			"authority":     {2},
+			"transmissions": {3},
+			"store":         {4},
+		}
+
+		_, err := entry.AccountMetas("setValue", programID, addresses)
+		require.ErrorIs(t, err, types.ErrInvalidType)
+
+		addresses["owner"] = ag_solana.PublicKey{5}
+		metas, err := entry.AccountMetas("setValue", programID, addresses)
+		require.NoError(t, err)
+		assert.Equal(t, ag_solana.AccountMetaSlice{
+			ag_solana.Meta(ag_solana.PublicKey{1}).WRITE(),
+			ag_solana.Meta(ag_solana.PublicKey{2}).SIGNER(),
+			ag_solana.Meta(ag_solana.PublicKey{3}).WRITE(),
+			ag_solana.Meta(ag_solana.PublicKey{4}),
+			ag_solana.Meta(ag_solana.PublicKey{5}),
+		}, metas)
+	})
+}
@@ -0,0 +1,180 @@
+package codec
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/codec/encodings"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+)
+
+const (
+	optionNone = 0
+	optionSome = 1
+)
+
+// optionCodec encodes a Borsh Option<T> or an SPL COption<T>. The Go representation is a pointer to the inner type, nil
+// for None. A Borsh Option is a uint8 tag followed by the value if set. A COption is a uint32 tag followed by the value,
+// the value is always allocated and zeroed for None, so the inner type must have a fixed size.
+type optionCodec struct {
+	tag   encodings.TypeCodec
+	inner encodings.TypeCodec
+	// padded is true for COption, None is followed by zeroed space for the value
+	padded bool
+	tpe    reflect.Type
+}
+
+var _ encodings.TypeCodec = &optionCodec{}
+
+// NewOption returns a codec for a Borsh Option of inner
+func NewOption(inner encodings.TypeCodec, builder encodings.Builder) encodings.TypeCodec {
+	return newOptionCodec(builder.Uint8(), inner, false)
+}
+
+// NewCOption returns a codec for an SPL COption of inner, inner must have a fixed size
+func NewCOption(inner encodings.TypeCodec, builder encodings.Builder) (encodings.TypeCodec, error) {
+	if _, err := inner.FixedSize(); err != nil {
+		return nil, fmt.Errorf("%w: COption value must have a fixed size: %w", types.ErrInvalidConfig, err)
+	}
+
+	return newOptionCodec(builder.Uint32(), inner, true), nil
+}
+
+func newOptionCodec(tag, inner encodings.TypeCodec, padded bool) *optionCodec {
+	tpe := inner.GetType()
+	if tpe.Kind() != reflect.Pointer {
+		tpe = reflect.PointerTo(tpe)
+	}
+
+	return &optionCodec{tag: tag, inner: inner, padded: padded, tpe: tpe}
+}
+
+func (o *optionCodec) Encode(value any, into []byte) ([]byte, error) {
+	rValue := reflect.ValueOf(value)
+	switch {
+	case !rValue.IsValid() || (rValue.Type() == o.tpe && rValue.IsNil()):
+		return o.encodeNone(into)
+	case rValue.Type() == o.tpe && o.tpe != o.inner.GetType():
+		value = rValue.Elem().Interface()
+	case rValue.Type() != o.tpe && rValue.Type() != o.inner.GetType():
+		return nil, fmt.Errorf("%w: expected %v, got %T", types.ErrInvalidType, o.tpe, value)
+	}
+
+	into, err := o.tag.Encode(o.tagValue(optionSome), into)
+	if err != nil {
+		return nil, err
+	}
+
+	return o.inner.Encode(value, into)
+}
+
+func (o *optionCodec) encodeNone(into []byte) ([]byte, error) {
+	into, err := o.tag.Encode(o.tagValue(optionNone), into)
+	if err != nil || !o.padded {
+		return into, err
+	}
+
+	size, err := o.inner.FixedSize()
+	if err != nil {
+		return nil, err
+	}
+
+	return append(into, make([]byte, size)...), nil
+}
+
+func (o *optionCodec) tagValue(tag uint8) any {
+	if o.padded {
+		return uint32(tag)
+	}
+
+	return tag
+}
+
+func (o *optionCodec) Decode(encoded []byte) (any, []byte, error) {
+	rawTag, remaining, err := o.tag.Decode(encoded)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var tag uint64
+	switch t := rawTag.(type) {
+	case uint8:
+		tag = uint64(t)
+	case uint32:
+		tag = uint64(t)
+	default:
+		return nil, nil, fmt.Errorf("%w: option tag must be an unsigned integer, got %T", types.ErrInvalidEncoding, rawTag)
+	}
+
+	switch tag {
+	case optionNone:
+		if o.padded {
+			size, err := o.inner.FixedSize()
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if len(remaining) < size {
+				return nil, nil, fmt.Errorf("%w: not enough bytes for COption value, expected %d got %d", types.ErrInvalidEncoding, size, len(remaining))
+			}
+
+			remaining = remaining[size:]
+		}
+
+		return reflect.Zero(o.tpe).Interface(), remaining, nil
+	case optionSome:
+		value, remaining, err := o.inner.Decode(remaining)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		rValue := reflect.ValueOf(value)
+		if rValue.Kind() != reflect.Pointer {
+			ptr := reflect.New(rValue.Type())
+			ptr.Elem().Set(rValue)
+			rValue = ptr
+		}
+
+		return rValue.Interface(), remaining, nil
+	default:
+		return nil, nil, fmt.Errorf("%w: invalid option tag %d", types.ErrInvalidEncoding, tag)
+	}
+}
+
+func (o *optionCodec) GetType() reflect.Type {
+	return o.tpe
+}
+
+// Size returns the size of the option when set
+func (o *optionCodec) Size(numItems int) (int, error) {
+	tagSize, err := o.tag.Size(numItems)
+	if err != nil {
+		return 0, err
+	}
+
+	size, err := o.inner.Size(numItems)
+	if err != nil {
+		return 0, err
+	}
+
+	return tagSize + size, nil
+}
+
+// FixedSize is only defined for a COption, a Borsh Option is shorter when not set
+func (o *optionCodec) FixedSize() (int, error) {
+	if !o.padded {
+		return 0, fmt.Errorf("%w: option does not have a fixed size", types.ErrInvalidType)
+	}
+
+	tagSize, err := o.tag.FixedSize()
+	if err != nil {
+		return 0, err
+	}
+
+	size, err := o.inner.FixedSize()
+	if err != nil {
+		return 0, err
+	}
+
+	return tagSize + size, nil
+}
@@ -12,8 +12,38 @@ unixTimestamp -> int64
 publicKey -> [32]byte
 hash -> [32]byte
 
-Enums as an Anchor data structure are only supported in their basic form of uint8 values. Enums with variants are not
-supported at this time.
+Options map to a pointer to the inner type, nil for None. Borsh options are encoded with a 1 byte tag, SPL COption
+values use a 4 byte tag and always allocate space for the value. COption fields are declared with the custom
+{"coption": <type>} IDL type.
+
+Enums without fields map to uint8 values (uint32 with bincode). Enums with variants carrying fields (tuple or struct variants) map to a struct
+with the variant name in a Variant string field and a pointer field per variant with fields, only the pointer of the
+selected variant is set. Tuple variant fields are named Field0, Field1, etc. For example, the store program Scope enum
+maps to:
+
+	type Scope struct {
+		Variant   string
+		RoundData *struct{ RoundID uint32 }
+	}
+
+Both the legacy and the Anchor 0.30+ IDL formats are supported. Generic types are created for each use with their type
+and const generic arguments, and declared account discriminators are used instead of computing them from the account name.
+
+Account, defined type, instruction and event codecs can be created from an IDL. Instruction codecs encode the instruction
+data and provide the ordered accounts of each instruction, event codecs decode "Program data: <base64>" program logs.
+
+Anchor zero_copy accounts are decoded with their repr(C) memory layout: fields are aligned with zeroed padding (8 bytes
+for 64 and 128 bit integers), structs are padded to their alignment and packed structs have no padding. Types of
+Anchor 0.30+ IDLs declare the layout with their serialization and repr, NewIDLZeroCopyAccountCodec uses it for all types
+of legacy IDLs. An xs array followed by a len field, the arrayvec! layout of the OCR2 program, maps to a slice of the
+first len items in the Xs field, e.g. the Oracles of the OCR2 State account maps to:
+
+	type Oracles struct {
+		Xs []Oracle
+	}
+
+Codecs are created with an encodings.Builder, binary.LittleEndian() for Borsh or Bincode() for native Solana programs
+using bincode.
 
 Modifiers can be provided to assist in modifying property names, adding properties, etc.
 */
@@ -65,22 +95,46 @@ func NewIDLAccountCodec(idl IDL, builder encodings.Builder) (types.RemoteCodec,
 	return newIDLCoded(idl, builder, idl.Accounts, true)
 }
 
+// NewIDLNativeAccountCodec is for accounts of native (non-Anchor) programs, which don't have a discriminator
+func NewIDLNativeAccountCodec(idl IDL, builder encodings.Builder) (types.RemoteCodec, error) {
+	return newIDLCoded(idl, builder, idl.Accounts, false)
+}
+
+// NewIDLZeroCopyAccountCodec is for Anchor zero_copy accounts of legacy IDLs, which don't declare the serialization of
+// types. All structs are decoded with the repr(C) layout of zero-copy types.
+func NewIDLZeroCopyAccountCodec(idl IDL, builder encodings.Builder) (types.RemoteCodec, error) {
+	return newIDLCodedWithRefs(idl.Accounts, true, newCodecRefs(idl, builder, true))
+}
+
 func NewIDLDefinedTypesCodec(idl IDL, builder encodings.Builder) (types.RemoteCodec, error) {
 	return newIDLCoded(idl, builder, idl.Types, false)
 }
 
 func newIDLCoded(
 	idl IDL, builder encodings.Builder, from IdlTypeDefSlice, includeDiscriminator bool) (types.RemoteCodec, error) {
-	typeCodecs := make(encodings.LenientCodecFromTypeCodec)
+	return newIDLCodedWithRefs(from, includeDiscriminator, newCodecRefs(idl, builder, false))
+}
 
-	refs := &codecRefs{
+func newCodecRefs(idl IDL, builder encodings.Builder, zeroCopy bool) *codecRefs {
+	return &codecRefs{
 		builder:      builder,
 		codecs:       make(map[string]encodings.TypeCodec),
 		typeDefs:     idl.Types,
 		dependencies: make(map[string][]string),
+		zeroCopy:     zeroCopy,
 	}
+}
+
+func newIDLCodedWithRefs(
+	from IdlTypeDefSlice, includeDiscriminator bool, refs *codecRefs) (types.RemoteCodec, error) {
+	typeCodecs := make(encodings.LenientCodecFromTypeCodec)
 
 	for _, def := range from {
+		// generic types are only created when referenced with their generic arguments
+		if len(def.Generics) > 0 {
+			continue
+		}
+
 		var (
 			name     string
 			accCodec encodings.TypeCodec
@@ -103,6 +157,8 @@ type codecRefs struct {
 	codecs       map[string]encodings.TypeCodec
 	typeDefs     IdlTypeDefSlice
 	dependencies map[string][]string
+	// zeroCopy decodes all structs with the repr(C) layout, types of Anchor 0.30+ IDLs declare it instead
+	zeroCopy bool
 }
 
 func createNamedCodec(
@@ -119,10 +175,18 @@ func createNamedCodec(
 	case IdlTypeDefTyKindEnum:
 		variants := def.Type.Variants
 		if !variants.IsAllUint8() {
-			return name, nil, fmt.Errorf("%w: variants are not supported", types.ErrInvalidConfig)
+			enumCodec, err := asEnum(def, refs, caser)
+			return name, enumCodec, err
 		}
 
-		return name, refs.builder.Uint8(), nil
+		return name, enumTag(refs.builder), nil
+	case IdlTypeDefTyKindType:
+		if def.Type.Alias == nil {
+			return name, nil, fmt.Errorf("%w: type alias %s has no aliased type", types.ErrInvalidConfig, name)
+		}
+
+		aliasCodec, err := processFieldType(name, *def.Type.Alias, refs)
+		return name, aliasCodec, err
 	default:
 		return name, nil, fmt.Errorf(unknownIDLFormat, types.ErrInvalidConfig, def.Type.Kind)
 	}
@@ -135,6 +199,10 @@ func asStruct(
 	caser cases.Caser,
 	includeDiscriminator bool,
 ) (string, encodings.TypeCodec, error) {
+	if refs.zeroCopy || def.zeroCopy() {
+		return asZeroCopyStruct(def, refs, name, caser, includeDiscriminator)
+	}
+
 	desLen := 0
 	if includeDiscriminator {
 		desLen = 1
@@ -142,7 +210,7 @@ func asStruct(
 	named := make([]encodings.NamedTypeCodec, len(*def.Type.Fields)+desLen)
 
 	if includeDiscriminator {
-		named[0] = encodings.NamedTypeCodec{Name: "Discriminator" + name, Codec: NewDiscriminator(name)}
+		named[0] = discriminatorField(def, name)
 	}
 
 	for idx, field := range *def.Type.Fields {
@@ -164,20 +232,31 @@ func asStruct(
 	return name, structCodec, nil
 }
 
+func discriminatorField(def IdlTypeDef, name string) encodings.NamedTypeCodec {
+	discriminator := NewDiscriminator(name)
+	if len(def.Discriminator) > 0 {
+		discriminator = NewDeclaredDiscriminator(def.Discriminator)
+	}
+
+	return encodings.NamedTypeCodec{Name: "Discriminator" + name, Codec: discriminator}
+}
+
 func processFieldType(parentTypeName string, idlType IdlType, refs *codecRefs) (encodings.TypeCodec, error) {
 	switch true {
 	case idlType.IsString():
 		return getCodecByStringType(idlType.GetString(), refs.builder)
 	case idlType.IsIdlTypeOption():
-		// Go doesn't have an `Option` type; use pointer to type instead
-		// this should be automatic in the codec
-		return processFieldType(parentTypeName, idlType.GetIdlTypeOption().Option, refs)
+		return asOption(parentTypeName, idlType.GetIdlTypeOption(), refs)
+	case idlType.IsIdlTypeCOption():
+		return asCOption(parentTypeName, idlType.GetIdlTypeCOption(), refs)
 	case idlType.IsIdlTypeDefined():
 		return asDefined(parentTypeName, idlType.GetIdlTypeDefined(), refs)
 	case idlType.IsArray():
 		return asArray(parentTypeName, idlType.GetArray(), refs)
 	case idlType.IsIdlTypeVec():
 		return asVec(parentTypeName, idlType.GetIdlTypeVec(), refs)
+	case idlType.IsGeneric():
+		return nil, fmt.Errorf("%w: generic %s is not resolved in %s", types.ErrInvalidConfig, idlType.GetGeneric(), parentTypeName)
 	default:
 		return nil, fmt.Errorf("%w: unknown IDL type def", types.ErrInvalidConfig)
 	}
@@ -188,8 +267,8 @@ func asDefined(parentTypeName string, definedName *IdlTypeDefined, refs *codecRe
 		return nil, fmt.Errorf("%w: defined type name should not be nil", types.ErrInvalidConfig)
 	}
 
-	// already exists as a type in the typed codecs
-	if savedCodec, ok := refs.codecs[definedName.Defined]; ok {
+	// already exists as a type in the typed codecs, generic types are created for each use
+	if savedCodec, ok := refs.codecs[definedName.Defined]; ok && len(definedName.Generics) == 0 {
 		return savedCodec, nil
 	}
 
@@ -207,6 +286,16 @@ func asDefined(parentTypeName string, definedName *IdlTypeDefined, refs *codecRe
 
 	saveDependency(refs, parentTypeName, definedName.Defined)
 
+	if len(nextDef.Generics) > 0 || len(definedName.Generics) > 0 {
+		instance, err := nextDef.instantiate(definedName.Generics)
+		if err != nil {
+			return nil, err
+		}
+
+		_, instanceCodec, err := createNamedCodec(instance, refs, false)
+		return instanceCodec, err
+	}
+
 	newTypeName, newTypeCodec, err := createNamedCodec(*nextDef, refs, false)
 	if err != nil {
 		return nil, err
@@ -218,7 +307,29 @@ func asDefined(parentTypeName string, definedName *IdlTypeDefined, refs *codecRe
 	return newTypeCodec, nil
 }
 
+func asOption(parentTypeName string, idlOption *IdlTypeOption, refs *codecRefs) (encodings.TypeCodec, error) {
+	codec, err := processFieldType(parentTypeName, idlOption.Option, refs)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewOption(codec, refs.builder), nil
+}
+
+func asCOption(parentTypeName string, idlCOption *IdlTypeCOption, refs *codecRefs) (encodings.TypeCodec, error) {
+	codec, err := processFieldType(parentTypeName, idlCOption.COption, refs)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCOption(codec, refs.builder)
+}
+
 func asArray(parentTypeName string, idlArray *IdlTypeArray, refs *codecRefs) (encodings.TypeCodec, error) {
+	if idlArray.Generic != "" {
+		return nil, fmt.Errorf("%w: array length generic %s is not resolved in %s", types.ErrInvalidConfig, idlArray.Generic, parentTypeName)
+	}
+
 	codec, err := processFieldType(parentTypeName, idlArray.Thing, refs)
 	if err != nil {
 		return nil, err
@@ -233,7 +344,7 @@ func asVec(parentTypeName string, idlVec *IdlTypeVec, refs *codecRefs) (encoding
 		return nil, err
 	}
 
-	b, err := refs.builder.Int(4)
+	b, err := lengthPrefix(refs.builder)
 	if err != nil {
 		return nil, err
 	}
@@ -308,7 +419,7 @@ func getTimeCodecByStringType(curType IdlTypeAsString, builder encodings.Builder
 func getByteCodecByStringType(curType IdlTypeAsString, builder encodings.Builder) (encodings.TypeCodec, error) {
 	switch curType {
 	case IdlTypeBytes:
-		b, err := builder.Int(4)
+		b, err := lengthPrefix(builder)
 		if err != nil {
 			return nil, err
 		}
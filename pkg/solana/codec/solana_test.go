@@ -29,7 +29,7 @@ func TestNewIDLAccountCodec(t *testing.T) {
 	bts, err := entry.Encode(ctx, expected, testutils.TestStructWithNestedStruct)
 
 	// length of fields + discriminator
-	require.Equal(t, 262, len(bts))
+	require.Equal(t, 263, len(bts))
 
 	require.NoError(t, err)
 
@@ -50,7 +50,7 @@ func TestNewIDLDefinedTypesCodecCodec(t *testing.T) {
 	bts, err := entry.Encode(ctx, expected, testutils.TestStructWithNestedStructType)
 
 	// length of fields without a discriminator
-	require.Equal(t, 254, len(bts))
+	require.Equal(t, 255, len(bts))
 
 	require.NoError(t, err)
 
@@ -143,6 +143,185 @@ func TestNewIDLCodec_CircularDependency(t *testing.T) {
 	assert.ErrorIs(t, err, types.ErrInvalidConfig)
 }
 
+func TestNewIDLCodec_EnumWithVariants(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	var idl codec.IDL
+	require.NoError(t, json.Unmarshal([]byte(testutils.EnumIDL), &idl))
+
+	accountCodec, err := codec.NewIDLAccountCodec(idl, binary.LittleEndian())
+	require.NoError(t, err)
+	typesCodec, err := codec.NewIDLDefinedTypesCodec(idl, binary.LittleEndian())
+	require.NoError(t, err)
+
+	expected := testutils.StructWithEnums{
+		Value: 1,
+		Scope: testutils.Scope{Variant: "RoundData", RoundData: &testutils.ScopeRoundData{RoundID: 7}},
+		Shapes: []testutils.Shape{
+			{Variant: "Empty"},
+			{Variant: "Circle", Circle: &testutils.ShapeCircle{Field0: 5}},
+			{Variant: "Rect", Rect: &testutils.ShapeRect{Width: 2, Height: 3}},
+		},
+	}
+
+	bts, err := accountCodec.Encode(ctx, expected, testutils.TestStructWithEnums)
+	require.NoError(t, err)
+	// discriminator + value + (tag + round id) + vec length + (tag) + (tag + u32) + (tag + 2 * u16)
+	require.Equal(t, []byte{1, 3, 7, 0, 0, 0, 3, 0, 0, 0, 0, 1, 5, 0, 0, 0, 2, 2, 0, 3, 0}, bts[8:])
+
+	var decoded testutils.StructWithEnums
+	require.NoError(t, accountCodec.Decode(ctx, bts, &decoded, testutils.TestStructWithEnums))
+	require.Equal(t, expected, decoded)
+
+	// the variant is inferred from the set variant fields
+	inferred := testutils.Shape{Circle: &testutils.ShapeCircle{Field0: 5}}
+	shapeBts, err := typesCodec.Encode(ctx, inferred, testutils.TestShape)
+	require.NoError(t, err)
+	require.Equal(t, []byte{1, 5, 0, 0, 0}, shapeBts)
+
+	var shape testutils.Shape
+	require.NoError(t, typesCodec.Decode(ctx, shapeBts, &shape, testutils.TestShape))
+	require.Equal(t, testutils.Shape{Variant: "Circle", Circle: inferred.Circle}, shape)
+
+	scopeBts, err := typesCodec.Encode(ctx, testutils.Scope{Variant: "Aggregator"}, testutils.TestScope)
+	require.NoError(t, err)
+	require.Equal(t, []byte{5}, scopeBts)
+
+	_, err = typesCodec.Encode(ctx, testutils.Scope{Variant: "Unknown"}, testutils.TestScope)
+	require.ErrorIs(t, err, types.ErrInvalidType)
+	_, err = typesCodec.Encode(ctx, testutils.Scope{Variant: "RoundData"}, testutils.TestScope)
+	require.ErrorIs(t, err, types.ErrInvalidType)
+	require.Error(t, typesCodec.Decode(ctx, []byte{6}, &testutils.Scope{}, testutils.TestScope))
+
+	t.Run("with modifiers", func(t *testing.T) {
+		modConfig := codeccommon.ModifiersConfig{
+			&codeccommon.RenameModifierConfig{Fields: map[string]string{"Value": "V"}},
+		}
+		renameMod, err := modConfig.ToModifier(codec.DecoderHooks...)
+		require.NoError(t, err)
+
+		withMods, err := codec.NewNamedModifierCodec(accountCodec, testutils.TestStructWithEnums, renameMod)
+		require.NoError(t, err)
+
+		type modifiedStruct struct {
+			V      uint8
+			Scope  testutils.Scope
+			Shapes []testutils.Shape
+		}
+
+		modified := modifiedStruct{V: expected.Value, Scope: expected.Scope, Shapes: expected.Shapes}
+		withModsBts, err := withMods.Encode(ctx, modified, testutils.TestStructWithEnums)
+		require.NoError(t, err)
+		require.Equal(t, bts, withModsBts)
+
+		var decodedModified modifiedStruct
+		require.NoError(t, withMods.Decode(ctx, bts, &decodedModified, testutils.TestStructWithEnums))
+		require.Equal(t, modified, decodedModified)
+	})
+}
+
+func TestNewIDLCodec_Options(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	var idl codec.IDL
+	require.NoError(t, json.Unmarshal([]byte(testutils.OptionIDL), &idl))
+
+	entry, err := codec.NewIDLAccountCodec(idl, binary.LittleEndian())
+	require.NoError(t, err)
+
+	count := uint64(3)
+	authority := ag_solana.PublicKey{1, 2, 3}
+	for _, run := range []struct {
+		name     string
+		value    testutils.StructWithOptions
+		expected []byte
+	}{
+		{
+			name:     "set",
+			value:    testutils.StructWithOptions{Count: &count, Inner: &testutils.OptionInner{A: 5}, Authority: &authority, Value: 9},
+			expected: append(append([]byte{1, 3, 0, 0, 0, 0, 0, 0, 0, 1, 5, 0, 1, 0, 0, 0}, authority.Bytes()...), 9),
+		},
+		{
+			name:  "none",
+			value: testutils.StructWithOptions{Value: 9},
+			// the COption value is always allocated
+			expected: append(append([]byte{0, 0, 0, 0, 0, 0}, make([]byte, 32)...), 9),
+		},
+	} {
+		t.Run(run.name, func(t *testing.T) {
+			bts, err := entry.Encode(ctx, run.value, testutils.TestStructWithOptions)
+			require.NoError(t, err)
+			require.Equal(t, run.expected, bts[8:])
+
+			var decoded testutils.StructWithOptions
+			require.NoError(t, entry.Decode(ctx, bts, &decoded, testutils.TestStructWithOptions))
+			require.Equal(t, run.value, decoded)
+		})
+	}
+
+	t.Run("invalid tag", func(t *testing.T) {
+		bts, err := entry.Encode(ctx, testutils.StructWithOptions{Value: 9}, testutils.TestStructWithOptions)
+		require.NoError(t, err)
+
+		bts[8] = 2
+		require.ErrorIs(t, entry.Decode(ctx, bts, &testutils.StructWithOptions{}, testutils.TestStructWithOptions), types.ErrInvalidEncoding)
+	})
+
+	t.Run("unsized COption", func(t *testing.T) {
+		_, err := codec.NewIDLDefinedTypesCodec(idl, binary.LittleEndian())
+		require.ErrorIs(t, err, types.ErrInvalidConfig)
+	})
+}
+
+func TestNewIDLCodec_AnchorV030(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	var idl codec.IDL
+	require.NoError(t, json.Unmarshal([]byte(testutils.AnchorV030IDL), &idl))
+
+	// normalized to the legacy model
+	assert.Equal(t, "generic_test_idl", idl.Name)
+	assert.Equal(t, "0.1.0", idl.Version)
+	require.Len(t, idl.Instructions, 1)
+	assert.Equal(t, []byte{175, 175, 109, 31, 13, 152, 155, 237}, idl.Instructions[0].Discriminator)
+	assert.Equal(t, 3, idl.Instructions[0].Accounts.NumAccounts())
+	assert.True(t, idl.Instructions[0].Accounts[0].IdlAccount.IsMut)
+	assert.True(t, idl.Instructions[0].Accounts[1].IdlAccount.IsSigner)
+	require.Len(t, idl.Events, 1)
+	assert.Equal(t, []byte{25, 18, 23, 7, 172, 116, 130, 28}, idl.Events[0].Discriminator)
+	require.Len(t, idl.Accounts, 1)
+	assert.Equal(t, []byte{1, 2, 3, 4, 5, 6, 7, 8}, idl.Accounts[0].Discriminator)
+
+	entry, err := codec.NewIDLAccountCodec(idl, binary.LittleEndian())
+	require.NoError(t, err)
+
+	last := uint64(7)
+	expected := testutils.GenericAccount{
+		Value:   1,
+		Key:     ag_solana.PublicKey{2},
+		Wrapped: testutils.Wrapper{Items: [3]uint64{4, 5, 6}, Last: &last},
+		Tuple:   testutils.TupleStruct{Field0: 8, Field1: true},
+		Amount:  9,
+	}
+
+	bts, err := entry.Encode(ctx, expected, testutils.TestGenericAccount)
+	require.NoError(t, err)
+	// declared discriminator + value + key + 3 generic items + option + tuple + alias
+	require.Len(t, bts, 8+1+32+24+9+3+4)
+	require.Equal(t, []byte{1, 2, 3, 4, 5, 6, 7, 8}, bts[:8])
+
+	var decoded testutils.GenericAccount
+	require.NoError(t, entry.Decode(ctx, bts, &decoded, testutils.TestGenericAccount))
+	require.Equal(t, expected, decoded)
+
+	// the generic Wrapper is only created where it is used
+	_, err = codec.NewIDLDefinedTypesCodec(idl, binary.LittleEndian())
+	require.NoError(t, err)
+}
+
 func newTestIDLAndCodec(t *testing.T, account bool) (string, codec.IDL, types.RemoteCodec) {
 	t.Helper()
 
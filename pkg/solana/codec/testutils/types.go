@@ -86,3 +86,137 @@ var JSONIDLWithAllTypes string
 
 //go:embed circularDepIDL.json
 var CircularDepIDL string
+
+var (
+	TestStructWithEnums = "StructWithEnums"
+	TestScope           = "Scope"
+	TestShape           = "Shape"
+)
+
+// StructWithEnums uses enums with variants, Scope mirrors the store program Scope enum
+type StructWithEnums struct {
+	Value  uint8
+	Scope  Scope
+	Shapes []Shape
+}
+
+type Scope struct {
+	Variant   string
+	RoundData *ScopeRoundData
+}
+
+type ScopeRoundData struct {
+	RoundID uint32
+}
+
+type Shape struct {
+	Variant string
+	Circle  *ShapeCircle
+	Rect    *ShapeRect
+}
+
+type ShapeCircle struct {
+	Field0 uint32
+}
+
+type ShapeRect struct {
+	Width  uint16
+	Height uint16
+}
+
+//go:embed enumIDL.json
+var EnumIDL string
+
+var (
+	TestStructWithOptions = "StructWithOptions"
+)
+
+// StructWithOptions uses a Borsh Option and an SPL COption, nil values are None
+type StructWithOptions struct {
+	Count     *uint64
+	Inner     *OptionInner
+	Authority *ag_solana.PublicKey
+	Value     uint8
+}
+
+type OptionInner struct {
+	A uint16
+}
+
+//go:embed optionIDL.json
+var OptionIDL string
+
+var (
+	TestGenericAccount = "GenericAccount"
+)
+
+// GenericAccount is defined in the Anchor 0.30 IDL format, Wrapper is a generic type instantiated with u64 and 3
+type GenericAccount struct {
+	Value   uint8
+	Key     ag_solana.PublicKey
+	Wrapped Wrapper
+	Tuple   TupleStruct
+	Amount  uint32
+}
+
+type Wrapper struct {
+	Items [3]uint64
+	Last  *uint64
+}
+
+type TupleStruct struct {
+	Field0 uint16
+	Field1 bool
+}
+
+//go:embed anchorV030IDL.json
+var AnchorV030IDL string
+
+var (
+	TestZeroCopyAccount = "ZeroCopyAccount"
+	TestPackedAccount   = "PackedAccount"
+)
+
+// ZeroCopyAccount is an Anchor zero_copy account with the repr(C) layout, Oracles and Config use the arrayvec! layout
+type ZeroCopyAccount struct {
+	Version uint8
+	State   uint8
+	Amount  uint64
+	Owner   ag_solana.PublicKey
+	Total   *big.Int
+	Oracles ZeroCopyOracles
+	Config  ZeroCopyConfig
+	Count   uint16
+}
+
+type ZeroCopyOracles struct {
+	Xs []ZeroCopyOracle
+}
+
+type ZeroCopyOracle struct {
+	Signer  [20]uint8
+	Payment uint64
+}
+
+type ZeroCopyConfig struct {
+	Version uint8
+	Xs      []uint8
+}
+
+//go:embed zeroCopyIDL.json
+var ZeroCopyIDL string
+
+// PackedAccount is defined in the Anchor 0.30 IDL format with bytemuck serialization, PackedPair is repr(C, packed)
+type PackedAccount struct {
+	Kind  uint8
+	Pair  PackedPair
+	Value uint32
+}
+
+type PackedPair struct {
+	A uint8
+	B uint64
+}
+
+//go:embed zeroCopyV030IDL.json
+var ZeroCopyV030IDL string
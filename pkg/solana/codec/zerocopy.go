@@ -0,0 +1,412 @@
+package codec
+
+import (
+	"fmt"
+	"reflect"
+
+	"golang.org/x/text/cases"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/codec/encodings"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+)
+
+const (
+	// arrayVecItems and arrayVecLength are the fields of the structs created with the arrayvec! macro of the
+	// contracts, e.g. Oracles {xs: [Oracle; MAX_ORACLES], len: u64}
+	arrayVecItems  = "xs"
+	arrayVecLength = "len"
+)
+
+// zeroCopy returns true if the type is laid out in memory like Anchor zero_copy types, declared with bytemuck
+// serialization or repr(C) in Anchor 0.30+ IDLs.
+func (def IdlTypeDef) zeroCopy() bool {
+	switch def.Serialization {
+	case IdlSerializationBytemuck, IdlSerializationBytemuckUnsafe:
+		return true
+	case IdlSerializationBorsh, IdlSerializationCustom:
+		return false
+	default:
+		return def.Repr != nil && def.Repr.Kind == IdlReprKindC
+	}
+}
+
+func (def IdlTypeDef) packed() bool {
+	return def.Repr != nil && def.Repr.Packed
+}
+
+// asZeroCopyStruct creates a codec for a repr(C) struct. Fields are aligned to their alignment with zeroed padding and
+// the struct is padded to a multiple of its alignment. Arrays of the arrayvec! layout, xs followed by len, map to a
+// slice of the first len items in the Xs field.
+func asZeroCopyStruct(
+	def IdlTypeDef,
+	refs *codecRefs,
+	name string,
+	caser cases.Caser,
+	includeDiscriminator bool,
+) (string, encodings.TypeCodec, error) {
+	named := make([]encodings.NamedTypeCodec, 0, len(*def.Type.Fields)+1)
+	if includeDiscriminator {
+		named = append(named, discriminatorField(def, name))
+	}
+
+	fields := *def.Type.Fields
+	offset, structAlign := 0, 1
+
+	var last *paddedCodec
+	for idx := 0; idx < len(fields); idx++ {
+		field := fields[idx]
+
+		fieldCodec, err := processFieldType(name, field.Type, refs)
+		if err != nil {
+			return name, nil, err
+		}
+
+		align, err := fieldAlignment(name, field.Type, def, refs)
+		if err != nil {
+			return name, nil, err
+		}
+
+		before := padding(offset, align)
+
+		if isArrayVec(fields, idx) {
+			var lengthAlign int
+			if fieldCodec, lengthAlign, err = asArrayVec(name, fields[idx], fields[idx+1], offset+before, def, refs); err != nil {
+				return name, nil, err
+			}
+
+			structAlign = max(structAlign, lengthAlign)
+			idx++
+		}
+
+		size, err := fieldCodec.FixedSize()
+		if err != nil {
+			return name, nil, fmt.Errorf("%w: field %s of zero-copy type %s must have a fixed size: %w", types.ErrInvalidConfig, field.Name, name, err)
+		}
+
+		last = &paddedCodec{inner: fieldCodec, before: before}
+		named = append(named, encodings.NamedTypeCodec{Name: caser.String(field.Name), Codec: last})
+
+		offset += before + size
+		structAlign = max(structAlign, align)
+	}
+
+	if def.Repr != nil && def.Repr.Align > structAlign {
+		structAlign = def.Repr.Align
+	}
+
+	if last != nil {
+		last.after = padding(offset, structAlign)
+	}
+
+	structCodec, err := encodings.NewStructCodec(named)
+	if err != nil {
+		return name, nil, err
+	}
+
+	return name, structCodec, nil
+}
+
+// isArrayVec returns true if the field at idx is the xs array of the arrayvec! layout and followed by the len field
+func isArrayVec(fields []IdlField, idx int) bool {
+	if idx+1 >= len(fields) || fields[idx].Name != arrayVecItems || fields[idx+1].Name != arrayVecLength {
+		return false
+	}
+
+	items, length := fields[idx].Type, fields[idx+1].Type
+	if !items.IsArray() || !length.IsString() {
+		return false
+	}
+
+	switch length.GetString() {
+	case IdlTypeU8, IdlTypeU16, IdlTypeU32, IdlTypeU64:
+		return true
+	default:
+		return false
+	}
+}
+
+// asArrayVec creates the codec of the xs and len fields of the arrayvec! layout, xs starts at offset in the struct
+func asArrayVec(
+	parentTypeName string,
+	items, length IdlField,
+	offset int,
+	def IdlTypeDef,
+	refs *codecRefs,
+) (encodings.TypeCodec, int, error) {
+	array := items.Type.GetArray()
+
+	itemCodec, err := processFieldType(parentTypeName, array.Thing, refs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	itemSize, err := itemCodec.FixedSize()
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: field %s of zero-copy type %s must have a fixed size: %w", types.ErrInvalidConfig, items.Name, parentTypeName, err)
+	}
+
+	lengthCodec, err := processFieldType(parentTypeName, length.Type, refs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	lengthAlign, err := fieldAlignment(parentTypeName, length.Type, def, refs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return &arrayVecCodec{
+		item:          itemCodec,
+		capacity:      array.Num,
+		length:        lengthCodec,
+		lengthPadding: padding(offset+array.Num*itemSize, lengthAlign),
+	}, lengthAlign, nil
+}
+
+// fieldAlignment returns the alignment of a field of the struct def, 1 for packed structs
+func fieldAlignment(parentTypeName string, idlType IdlType, def IdlTypeDef, refs *codecRefs) (int, error) {
+	align, err := alignment(parentTypeName, idlType, refs)
+	if err != nil || def.packed() {
+		return 1, err
+	}
+
+	return align, nil
+}
+
+// alignment returns the alignment of a zero-copy type on the Solana SBF target
+func alignment(parentTypeName string, idlType IdlType, refs *codecRefs) (int, error) {
+	switch {
+	case idlType.IsString():
+		switch idlType.GetString() {
+		case IdlTypeBool, IdlTypeU8, IdlTypeI8, IdlTypePublicKey, IdlTypeHash:
+			return 1, nil
+		case IdlTypeU16, IdlTypeI16:
+			return 2, nil
+		case IdlTypeU32, IdlTypeI32:
+			return 4, nil
+		// 128 bit integers are 8 byte aligned on SBF
+		case IdlTypeU64, IdlTypeI64, IdlTypeU128, IdlTypeI128, IdlTypeUnixTimestamp, IdlTypeDuration:
+			return 8, nil
+		case IdlTypeString, IdlTypeBytes:
+			return 0, fmt.Errorf("%w: %s in %s is not a zero-copy type", types.ErrInvalidConfig, idlType.GetString(), parentTypeName)
+		default:
+			return 0, fmt.Errorf(unknownIDLFormat, types.ErrInvalidConfig, idlType.GetString())
+		}
+	case idlType.IsArray():
+		return alignment(parentTypeName, idlType.GetArray().Thing, refs)
+	case idlType.IsIdlTypeDefined():
+		defined := idlType.GetIdlTypeDefined()
+
+		def := refs.typeDefs.GetByName(defined.Defined)
+		if def == nil {
+			return 0, fmt.Errorf("%w: IDL type does not exist for name %s", types.ErrInvalidConfig, defined.Defined)
+		}
+
+		if len(def.Generics) > 0 || len(defined.Generics) > 0 {
+			instance, err := def.instantiate(defined.Generics)
+			if err != nil {
+				return 0, err
+			}
+
+			def = &instance
+		}
+
+		return defAlignment(*def, refs)
+	default:
+		return 0, fmt.Errorf("%w: field type in %s is not a zero-copy type", types.ErrInvalidConfig, parentTypeName)
+	}
+}
+
+func defAlignment(def IdlTypeDef, refs *codecRefs) (int, error) {
+	switch def.Type.Kind {
+	case IdlTypeDefTyKindStruct:
+		align := 1
+		if def.Type.Fields != nil && !def.packed() {
+			for _, field := range *def.Type.Fields {
+				fieldAlign, err := alignment(def.Name, field.Type, refs)
+				if err != nil {
+					return 0, err
+				}
+
+				align = max(align, fieldAlign)
+			}
+		}
+
+		if def.Repr != nil && def.Repr.Align > align {
+			align = def.Repr.Align
+		}
+
+		return align, nil
+	case IdlTypeDefTyKindType:
+		if def.Type.Alias == nil {
+			return 0, fmt.Errorf("%w: type alias %s has no aliased type", types.ErrInvalidConfig, def.Name)
+		}
+
+		return alignment(def.Name, *def.Type.Alias, refs)
+	case IdlTypeDefTyKindEnum:
+		return 0, fmt.Errorf("%w: enum %s is not a zero-copy type", types.ErrInvalidConfig, def.Name)
+	default:
+		return 0, fmt.Errorf(unknownIDLFormat, types.ErrInvalidConfig, def.Type.Kind)
+	}
+}
+
+// padding returns the number of bytes to add to offset to align it
+func padding(offset, align int) int {
+	return (align - offset%align) % align
+}
+
+// paddedCodec adds zeroed alignment padding before and after a field of a repr(C) struct, padding is ignored when
+// decoding
+type paddedCodec struct {
+	inner  encodings.TypeCodec
+	before int
+	after  int
+}
+
+var _ encodings.TypeCodec = &paddedCodec{}
+
+func (p *paddedCodec) Encode(value any, into []byte) ([]byte, error) {
+	into, err := p.inner.Encode(value, append(into, make([]byte, p.before)...))
+	if err != nil {
+		return nil, err
+	}
+
+	return append(into, make([]byte, p.after)...), nil
+}
+
+func (p *paddedCodec) Decode(encoded []byte) (any, []byte, error) {
+	if len(encoded) < p.before {
+		return nil, nil, fmt.Errorf("%w: not enough bytes for padding, expected %d got %d", types.ErrInvalidEncoding, p.before, len(encoded))
+	}
+
+	value, remaining, err := p.inner.Decode(encoded[p.before:])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(remaining) < p.after {
+		return nil, nil, fmt.Errorf("%w: not enough bytes for padding, expected %d got %d", types.ErrInvalidEncoding, p.after, len(remaining))
+	}
+
+	return value, remaining[p.after:], nil
+}
+
+func (p *paddedCodec) GetType() reflect.Type {
+	return p.inner.GetType()
+}
+
+func (p *paddedCodec) Size(numItems int) (int, error) {
+	size, err := p.inner.Size(numItems)
+	if err != nil {
+		return 0, err
+	}
+
+	return p.before + size + p.after, nil
+}
+
+func (p *paddedCodec) FixedSize() (int, error) {
+	size, err := p.inner.FixedSize()
+	if err != nil {
+		return 0, err
+	}
+
+	return p.before + size + p.after, nil
+}
+
+// arrayVecCodec is a fixed size array of capacity items followed by the number of used items, the Go type is a slice
+// of the used items. Unused items are zeroed when encoding.
+type arrayVecCodec struct {
+	item     encodings.TypeCodec
+	capacity int
+	length   encodings.TypeCodec
+	// lengthPadding is the alignment padding between the items and the length
+	lengthPadding int
+}
+
+var _ encodings.TypeCodec = &arrayVecCodec{}
+
+func (a *arrayVecCodec) Encode(value any, into []byte) ([]byte, error) {
+	rValue := reflect.ValueOf(value)
+	if rValue.Kind() != reflect.Slice && rValue.Kind() != reflect.Array {
+		return nil, fmt.Errorf("%w: expected a slice of %v, got %T", types.ErrInvalidType, a.item.GetType(), value)
+	}
+
+	if rValue.Len() > a.capacity {
+		return nil, fmt.Errorf("%w: %d items exceed the capacity %d", types.ErrInvalidType, rValue.Len(), a.capacity)
+	}
+
+	itemSize, err := a.item.FixedSize()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < rValue.Len(); i++ {
+		if into, err = a.item.Encode(rValue.Index(i).Interface(), into); err != nil {
+			return nil, err
+		}
+	}
+
+	into = append(into, make([]byte, (a.capacity-rValue.Len())*itemSize+a.lengthPadding)...)
+
+	return a.length.Encode(reflect.ValueOf(rValue.Len()).Convert(a.length.GetType()).Interface(), into)
+}
+
+func (a *arrayVecCodec) Decode(encoded []byte) (any, []byte, error) {
+	itemSize, err := a.item.FixedSize()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	lengthOffset := a.capacity*itemSize + a.lengthPadding
+	if len(encoded) < lengthOffset {
+		return nil, nil, fmt.Errorf("%w: not enough bytes for %d items, expected %d got %d", types.ErrInvalidEncoding, a.capacity, lengthOffset, len(encoded))
+	}
+
+	rawLength, remaining, err := a.length.Decode(encoded[lengthOffset:])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	length, err := toInt(rawLength)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if length > a.capacity {
+		return nil, nil, fmt.Errorf("%w: length %d exceeds the capacity %d", types.ErrInvalidEncoding, length, a.capacity)
+	}
+
+	items := reflect.MakeSlice(a.GetType(), length, length)
+	for i := 0; i < length; i++ {
+		item, _, err := a.item.Decode(encoded[i*itemSize:])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		items.Index(i).Set(reflect.ValueOf(item))
+	}
+
+	return items.Interface(), remaining, nil
+}
+
+func (a *arrayVecCodec) GetType() reflect.Type {
+	return reflect.SliceOf(a.item.GetType())
+}
+
+func (a *arrayVecCodec) Size(int) (int, error) {
+	return a.FixedSize()
+}
+
+func (a *arrayVecCodec) FixedSize() (int, error) {
+	itemSize, err := a.item.FixedSize()
+	if err != nil {
+		return 0, err
+	}
+
+	lengthSize, err := a.length.FixedSize()
+	if err != nil {
+		return 0, err
+	}
+
+	return a.capacity*itemSize + a.lengthPadding + lengthSize, nil
+}
@@ -0,0 +1,121 @@
+package codec_test
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	ag_solana "github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonbinary "github.com/smartcontractkit/chainlink-common/pkg/codec/encodings/binary"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/codec"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/codec/testutils"
+)
+
+func TestNewIDLZeroCopyAccountCodec(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	var idl codec.IDL
+	require.NoError(t, json.Unmarshal([]byte(testutils.ZeroCopyIDL), &idl))
+
+	entry, err := codec.NewIDLZeroCopyAccountCodec(idl, commonbinary.LittleEndian())
+	require.NoError(t, err)
+
+	expected := testutils.ZeroCopyAccount{
+		Version: 1,
+		State:   2,
+		Amount:  3,
+		Owner:   ag_solana.PublicKey{4},
+		Total:   big.NewInt(5),
+		Oracles: testutils.ZeroCopyOracles{Xs: []testutils.ZeroCopyOracle{
+			{Signer: [20]uint8{6}, Payment: 7},
+			{Signer: [20]uint8{8}, Payment: 9},
+		}},
+		Config: testutils.ZeroCopyConfig{Version: 10, Xs: []uint8{11, 12, 13}},
+		Count:  14,
+	}
+
+	bts, err := entry.Encode(ctx, expected, testutils.TestZeroCopyAccount)
+	require.NoError(t, err)
+	// discriminator + fields padded to 8 bytes:
+	// version, state, 6 padding, amount, owner, total, oracles (3 * 32 + len + 4 padding),
+	// config (version + 5 items + 2 padding + len), count + 6 padding
+	require.Len(t, bts, 8+2+6+8+32+16+104+16+8)
+
+	data := bts[8:]
+	assert.Equal(t, []byte{1, 2, 0, 0, 0, 0, 0, 0}, data[:8])
+	assert.Equal(t, uint64(3), binary.LittleEndian.Uint64(data[8:16]))
+	// oracles are padded between the signer and the payment, unused oracles are zeroed
+	assert.Equal(t, uint64(7), binary.LittleEndian.Uint64(data[64+24:64+32]))
+	assert.Equal(t, make([]byte, 32), data[64+64:64+96])
+	assert.Equal(t, uint32(2), binary.LittleEndian.Uint32(data[64+96:64+100]))
+	assert.Equal(t, []byte{10, 11, 12, 13, 0, 0, 0, 0}, data[168:176])
+	assert.Equal(t, uint64(3), binary.LittleEndian.Uint64(data[176:184]))
+	assert.Equal(t, uint16(14), binary.LittleEndian.Uint16(data[184:186]))
+
+	var decoded testutils.ZeroCopyAccount
+	require.NoError(t, entry.Decode(ctx, bts, &decoded, testutils.TestZeroCopyAccount))
+	assert.Equal(t, expected, decoded)
+
+	t.Run("length exceeds the capacity", func(t *testing.T) {
+		invalid := append([]byte{}, bts...)
+		binary.LittleEndian.PutUint32(invalid[8+64+96:], 4)
+
+		assert.ErrorIs(t, entry.Decode(ctx, invalid, &decoded, testutils.TestZeroCopyAccount), types.ErrInvalidEncoding)
+	})
+
+	t.Run("too many items", func(t *testing.T) {
+		tooMany := expected
+		tooMany.Config.Xs = make([]uint8, 6)
+
+		_, err = entry.Encode(ctx, tooMany, testutils.TestZeroCopyAccount)
+		assert.ErrorIs(t, err, types.ErrInvalidType)
+	})
+}
+
+func TestNewIDLZeroCopyAccountCodec_NotZeroCopy(t *testing.T) {
+	t.Parallel()
+
+	var idl codec.IDL
+	require.NoError(t, json.Unmarshal([]byte(testutils.JSONIDLWithAllTypes), &idl))
+
+	// strings and vectors don't have a fixed size
+	_, err := codec.NewIDLZeroCopyAccountCodec(idl, commonbinary.LittleEndian())
+	assert.ErrorIs(t, err, types.ErrInvalidConfig)
+}
+
+func TestNewIDLAccountCodec_ZeroCopyAnchorV030(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	var idl codec.IDL
+	require.NoError(t, json.Unmarshal([]byte(testutils.ZeroCopyV030IDL), &idl))
+
+	require.Len(t, idl.Types, 2)
+	assert.Equal(t, codec.IdlSerializationBytemuck, idl.Types[0].Serialization)
+	assert.Equal(t, &codec.IdlRepr{Kind: codec.IdlReprKindC, Packed: true}, idl.Types[1].Repr)
+
+	// the serialization and repr are declared, so the account codec uses the repr(C) layout
+	entry, err := codec.NewIDLAccountCodec(idl, commonbinary.LittleEndian())
+	require.NoError(t, err)
+
+	expected := testutils.PackedAccount{Kind: 1, Pair: testutils.PackedPair{A: 2, B: 3}, Value: 4}
+
+	bts, err := entry.Encode(ctx, expected, testutils.TestPackedAccount)
+	require.NoError(t, err)
+	// declared discriminator + kind + packed pair + 2 padding + value
+	require.Len(t, bts, 8+1+9+2+4)
+	assert.Equal(t, []byte{1, 2, 3, 4, 5, 6, 7, 8}, bts[:8])
+	assert.Equal(t, []byte{1, 2, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0}, bts[8:])
+
+	var decoded testutils.PackedAccount
+	require.NoError(t, entry.Decode(ctx, bts, &decoded, testutils.TestPackedAccount))
+	assert.Equal(t, expected, decoded)
+}
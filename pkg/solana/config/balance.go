@@ -0,0 +1,88 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gagliardetto/solana-go"
+	"golang.org/x/exp/slices"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/config"
+)
+
+// BalanceThreshold overrides the chain BalanceMin and BalanceFloor for a single key
+type BalanceThreshold struct {
+	Account *string
+	// Min is the balance (lamports) below which the key is reported as unhealthy
+	Min *uint64
+	// Floor is the balance (lamports) below which txm refuses new transactions from the key
+	Floor *uint64
+}
+
+func (b *BalanceThreshold) ValidateConfig() (err error) {
+	if b.Account == nil {
+		err = errors.Join(err, config.ErrMissing{Name: "Account", Msg: "required for all balance thresholds"})
+	} else if _, parseErr := solana.PublicKeyFromBase58(*b.Account); parseErr != nil {
+		err = errors.Join(err, config.ErrInvalid{Name: "Account", Value: *b.Account, Msg: parseErr.Error()})
+	}
+	if b.Min != nil && b.Floor != nil && *b.Floor > *b.Min {
+		err = errors.Join(err, config.ErrInvalid{Name: "Floor", Value: *b.Floor, Msg: "must not exceed Min"})
+	}
+	return
+}
+
+type BalanceThresholds []*BalanceThreshold
+
+func (bs *BalanceThresholds) SetFrom(fs *BalanceThresholds) {
+	for _, f := range *fs {
+		if f.Account == nil {
+			*bs = append(*bs, f)
+		} else if i := slices.IndexFunc(*bs, func(b *BalanceThreshold) bool {
+			return b.Account != nil && *b.Account == *f.Account
+		}); i == -1 {
+			*bs = append(*bs, f)
+		} else {
+			setFromBalanceThreshold((*bs)[i], f)
+		}
+	}
+}
+
+func (bs BalanceThresholds) validateKeys() (err error) {
+	accounts := config.UniqueStrings{}
+	for i, b := range bs {
+		if accounts.IsDupe(b.Account) {
+			err = errors.Join(err, config.NewErrDuplicate(fmt.Sprintf("BalanceThresholds.%d.Account", i), *b.Account))
+		}
+	}
+	return
+}
+
+func setFromBalanceThreshold(b, f *BalanceThreshold) {
+	if f.Account != nil {
+		b.Account = f.Account
+	}
+	if f.Min != nil {
+		b.Min = f.Min
+	}
+	if f.Floor != nil {
+		b.Floor = f.Floor
+	}
+}
+
+// get returns the thresholds for account, falling back to the chain defaults
+func (bs BalanceThresholds) get(account string, defaultMin, defaultFloor uint64) (minBalance, floor uint64) {
+	minBalance, floor = defaultMin, defaultFloor
+	i := slices.IndexFunc(bs, func(b *BalanceThreshold) bool {
+		return b.Account != nil && *b.Account == account
+	})
+	if i == -1 {
+		return minBalance, floor
+	}
+	if bs[i].Min != nil {
+		minBalance = *bs[i].Min
+	}
+	if bs[i].Floor != nil {
+		floor = *bs[i].Floor
+	}
+	return minBalance, floor
+}
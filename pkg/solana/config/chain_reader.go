@@ -10,7 +10,10 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/codec"
 	"github.com/smartcontractkit/chainlink-common/pkg/codec/encodings"
 	"github.com/smartcontractkit/chainlink-common/pkg/codec/encodings/binary"
+	"github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	solanacodec "github.com/smartcontractkit/chainlink-solana/pkg/solana/codec"
 )
 
 type ChainReader struct {
@@ -24,7 +27,9 @@ type ChainReaderMethods struct {
 type ChainDataReader struct {
 	AnchorIDL string `json:"anchorIDL" toml:"anchorIDL"`
 	// Encoding defines the type of encoding used for on-chain data. Currently supported
-	// are 'borsh' and 'bincode'.
+	// are 'borsh', 'bincode' and 'zerocopy'. Accounts encoded with 'bincode' belong to native programs
+	// and don't have an Anchor discriminator. 'zerocopy' accounts are Anchor zero_copy accounts with
+	// the repr(C) layout, for legacy IDLs that don't declare the serialization of their types.
 	Encoding   EncodingType           `json:"encoding" toml:"encoding"`
 	Procedures []ChainReaderProcedure `json:"procedures" toml:"procedures"`
 }
@@ -34,9 +39,11 @@ type EncodingType int
 const (
 	EncodingTypeBorsh EncodingType = iota
 	EncodingTypeBincode
+	EncodingTypeZeroCopy
 
-	encodingTypeBorshStr   = "borsh"
-	encodingTypeBincodeStr = "bincode"
+	encodingTypeBorshStr    = "borsh"
+	encodingTypeBincodeStr  = "bincode"
+	encodingTypeZeroCopyStr = "zerocopy"
 )
 
 func (t EncodingType) MarshalJSON() ([]byte, error) {
@@ -45,6 +52,8 @@ func (t EncodingType) MarshalJSON() ([]byte, error) {
 		return json.Marshal(encodingTypeBorshStr)
 	case EncodingTypeBincode:
 		return json.Marshal(encodingTypeBincodeStr)
+	case EncodingTypeZeroCopy:
+		return json.Marshal(encodingTypeZeroCopyStr)
 	default:
 		return nil, fmt.Errorf("%w: unrecognized encoding type: %d", types.ErrInvalidConfig, t)
 	}
@@ -62,6 +71,8 @@ func (t *EncodingType) UnmarshalJSON(data []byte) error {
 		*t = EncodingTypeBorsh
 	case encodingTypeBincodeStr:
 		*t = EncodingTypeBincode
+	case encodingTypeZeroCopyStr:
+		*t = EncodingTypeZeroCopy
 	default:
 		return fmt.Errorf("%w: unrecognized encoding type: %s", types.ErrInvalidConfig, str)
 	}
@@ -84,17 +95,76 @@ type chainDataProcedureFields struct {
 	// output formats.
 	OutputModifications codec.ModifiersConfig `json:"outputModifications,omitempty"`
 	// RPCOpts provides optional configurations for commitment, encoding, and data
-	// slice offsets.
+	// slice offsets. The commitment of a read follows the confidence level, the
+	// 'processed' commitment is used for unconfirmed reads if configured.
 	RPCOpts *RPCOpts `json:"rpcOpts,omitempty"`
+	// PDA derives the account address from seeds for each read instead of reading the
+	// bound address.
+	PDA *PDA `json:"pda,omitempty"`
+	// ProgramAccounts lists all accounts of the IDL account type owned by a program
+	// instead of reading a single account. The result is a slice of the IDL account type.
+	ProgramAccounts *ProgramAccounts `json:"programAccounts,omitempty"`
+	// Cache serves reads of the account from memory instead of reading it from the RPC
+	// for every call.
+	Cache *CachePolicy `json:"cache,omitempty"`
+}
+
+// CachePolicy caches the account data per address and commitment. With Subscribe, the
+// data is kept up to date with accountSubscribe notifications and is served for as long
+// as the subscription is active. Otherwise, or if the subscription fails, the data is
+// served until it is older than TTL. Subscriptions of accounts that were not read for
+// IdleTimeout (10 minutes by default) are stopped.
+type CachePolicy struct {
+	TTL         *config.Duration `json:"ttl,omitempty"`
+	Subscribe   bool             `json:"subscribe,omitempty"`
+	IdleTimeout *config.Duration `json:"idleTimeout,omitempty"`
+}
+
+// ProgramAccounts lists program accounts with getProgramAccounts. Accounts encoded with
+// 'borsh' are filtered by the discriminator of the IDL account.
+type ProgramAccounts struct {
+	// ProgramID is the base58 encoded program owning the accounts, the bound address is
+	// used if empty.
+	ProgramID string `json:"programID,omitempty"`
+	// Filters only list accounts with matching bytes at the offsets of account fields.
+	Filters []MemcmpFilter `json:"filters,omitempty"`
+	// DataSize only lists accounts with the data length in bytes.
+	DataSize uint64 `json:"dataSize,omitempty"`
+}
+
+// MemcmpFilter matches account data at an offset, including the discriminator, to either
+// base58 encoded bytes or the bytes of a field of the params argument, see PDASeed.Param.
+type MemcmpFilter struct {
+	Offset uint64        `json:"offset"`
+	Bytes  solana.Base58 `json:"bytes,omitempty"`
+	Param  string        `json:"param,omitempty"`
+}
+
+// PDA is a program derived address, derived with FindProgramAddress from the seeds in order.
+type PDA struct {
+	// ProgramID is the base58 encoded program deriving the address.
+	ProgramID string    `json:"programID"`
+	Seeds     []PDASeed `json:"seeds"`
+}
+
+// PDASeed is a single seed of a PDA, exactly one of Static, Address or Param must be set.
+type PDASeed struct {
+	// Static is a constant seed, e.g. "store".
+	Static string `json:"static,omitempty"`
+	// Address uses the bound address as seed.
+	Address bool `json:"address,omitempty"`
+	// Param uses the field of the params argument as seed. Public keys and byte arrays or
+	// slices are used as is, strings as UTF-8 bytes and integers as little endian bytes.
+	Param string `json:"param,omitempty"`
 }
 
 // BuilderForEncoding returns a builder for the encoding configuration. Defaults to little endian.
 func BuilderForEncoding(eType EncodingType) encodings.Builder {
 	switch eType {
-	case EncodingTypeBorsh:
+	case EncodingTypeBorsh, EncodingTypeZeroCopy:
 		return binary.LittleEndian()
 	case EncodingTypeBincode:
-		return binary.BigEndian()
+		return solanacodec.Bincode()
 	default:
 		return binary.LittleEndian()
 	}
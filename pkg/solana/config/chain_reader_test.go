@@ -4,6 +4,7 @@ import (
 	_ "embed"
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/gagliardetto/solana-go"
 	"github.com/gagliardetto/solana-go/rpc"
@@ -12,8 +13,10 @@ import (
 
 	codeccommon "github.com/smartcontractkit/chainlink-common/pkg/codec"
 	"github.com/smartcontractkit/chainlink-common/pkg/codec/encodings/binary"
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
 
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/codec"
 	"github.com/smartcontractkit/chainlink-solana/pkg/solana/codec/testutils"
 	"github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
 )
@@ -76,6 +79,20 @@ func TestBuilderForEncoding_Default(t *testing.T) {
 	require.Equal(t, binary.LittleEndian(), builder)
 }
 
+func TestBuilderForEncoding_ZeroCopy(t *testing.T) {
+	t.Parallel()
+
+	builder := config.BuilderForEncoding(config.EncodingTypeZeroCopy)
+	require.Equal(t, binary.LittleEndian(), builder)
+}
+
+func TestBuilderForEncoding_Bincode(t *testing.T) {
+	t.Parallel()
+
+	builder := config.BuilderForEncoding(config.EncodingTypeBincode)
+	require.Equal(t, codec.Bincode(), builder)
+}
+
 var (
 	encodingBase64 = solana.EncodingBase64
 	commitment     = rpc.CommitmentFinalized
@@ -116,6 +133,54 @@ var validChainReaderConfig = config.ChainReader{
 						},
 					},
 				},
+				"MethodWithPDA": {
+					AnchorIDL: "test idl 4",
+					Encoding:  config.EncodingTypeBorsh,
+					Procedures: []config.ChainReaderProcedure{
+						{
+							IDLAccount: testutils.TestStructWithNestedStruct,
+							PDA: &config.PDA{
+								ProgramID: "cjg3oHmg9uuPsP8D6g29NWvhySJkdYdAo9D25PRbKXJ",
+								Seeds: []config.PDASeed{
+									{Static: "oracle"},
+									{Address: true},
+									{Param: "Oracle"},
+								},
+							},
+							Cache: &config.CachePolicy{
+								TTL:         commonconfig.MustNewDuration(time.Minute),
+								Subscribe:   true,
+								IdleTimeout: commonconfig.MustNewDuration(5 * time.Minute),
+							},
+						},
+					},
+				},
+				"MethodWithProgramAccounts": {
+					AnchorIDL: "test idl 5",
+					Encoding:  config.EncodingTypeBorsh,
+					Procedures: []config.ChainReaderProcedure{
+						{
+							IDLAccount: testutils.TestStructWithNestedStruct,
+							ProgramAccounts: &config.ProgramAccounts{
+								ProgramID: "cjg3oHmg9uuPsP8D6g29NWvhySJkdYdAo9D25PRbKXJ",
+								Filters: []config.MemcmpFilter{
+									{Offset: 8, Bytes: solana.Base58{1}},
+									{Offset: 9, Param: "Owner"},
+								},
+								DataSize: 165,
+							},
+						},
+					},
+				},
+				"MethodWithZeroCopy": {
+					AnchorIDL: "test idl 6",
+					Encoding:  config.EncodingTypeZeroCopy,
+					Procedures: []config.ChainReaderProcedure{
+						{
+							IDLAccount: testutils.TestZeroCopyAccount,
+						},
+					},
+				},
 			},
 		},
 		"OtherContract": {
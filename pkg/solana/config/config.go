@@ -2,6 +2,7 @@ package config
 
 import (
 	"errors"
+	"net/url"
 	"time"
 
 	"github.com/gagliardetto/solana-go/rpc"
@@ -33,11 +34,21 @@ var defaultConfigSet = Chain{
 	BlockHistorySize:         ptr(uint64(1)),       // 1: uses latest block; >1: Uses multiple blocks, where n is number of blocks. DISCLAIMER: 1:1 ratio between n and RPC calls.
 	ComputeUnitLimitDefault:  ptr(uint32(200_000)), // set to 0 to disable adding compute unit limit
 	EstimateComputeUnitLimit: ptr(false),           // set to false to disable compute unit limit estimation
+
+	// bundle submission (disabled unless BundleEndpoint is set)
+	BundleTipDefault: ptr(uint64(10_000)),    // lamports, block engines enforce a minimum tip (1_000 lamports for jito)
+	BundleTipMax:     ptr(uint64(1_000_000)), // lamports, upper bound for tip estimation and bumping
+
+	// balance thresholds (lamports), can be overridden per key with BalanceThresholds
+	BalanceMin:   ptr(uint64(0)), // keys below are reported as unhealthy. Set to 0 to disable.
+	BalanceFloor: ptr(uint64(0)), // txm refuses new transactions from keys below. Set to 0 to disable.
 }
 
 //go:generate mockery --name Config --output ./mocks/ --case=underscore --filename config.go
 type Config interface {
 	BalancePollPeriod() time.Duration
+	// BalanceThreshold returns the minimum balance and hard floor (lamports) for the account, 0 is disabled
+	BalanceThreshold(account string) (minBalance, floor uint64)
 	ConfirmPollPeriod() time.Duration
 	OCR2CachePollPeriod() time.Duration
 	OCR2CacheTTL() time.Duration
@@ -59,6 +70,12 @@ type Config interface {
 	BlockHistorySize() uint64
 	ComputeUnitLimitDefault() uint32
 	EstimateComputeUnitLimit() bool
+
+	// bundle submission
+	BundleEndpoint() *url.URL
+	BundleTipFloorEndpoint() *url.URL
+	BundleTipDefault() uint64
+	BundleTipMax() uint64
 }
 
 type Chain struct {
@@ -82,6 +99,12 @@ type Chain struct {
 	BlockHistorySize         *uint64
 	ComputeUnitLimitDefault  *uint32
 	EstimateComputeUnitLimit *bool
+	BundleEndpoint           *config.URL
+	BundleTipFloorEndpoint   *config.URL
+	BundleTipDefault         *uint64
+	BundleTipMax             *uint64
+	BalanceMin               *uint64
+	BalanceFloor             *uint64
 }
 
 func (c *Chain) SetDefaults() {
@@ -145,12 +168,26 @@ func (c *Chain) SetDefaults() {
 	if c.EstimateComputeUnitLimit == nil {
 		c.EstimateComputeUnitLimit = defaultConfigSet.EstimateComputeUnitLimit
 	}
+	if c.BundleTipDefault == nil {
+		c.BundleTipDefault = defaultConfigSet.BundleTipDefault
+	}
+	if c.BundleTipMax == nil {
+		c.BundleTipMax = defaultConfigSet.BundleTipMax
+	}
+	if c.BalanceMin == nil {
+		c.BalanceMin = defaultConfigSet.BalanceMin
+	}
+	if c.BalanceFloor == nil {
+		c.BalanceFloor = defaultConfigSet.BalanceFloor
+	}
 }
 
 type Node struct {
 	Name     *string
 	URL      *config.URL
 	SendOnly bool
+	// Staked nodes (e.g. staked connections or SWQoS endpoints) form the priority tier for MultiNode transaction broadcasting
+	Staked bool
 }
 
 func (n *Node) ValidateConfig() (err error) {
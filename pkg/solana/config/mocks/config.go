@@ -7,6 +7,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	time "time"
+
+	url "net/url"
 )
 
 // Config is an autogenerated mock type for the Config type
@@ -32,6 +34,34 @@ func (_m *Config) BalancePollPeriod() time.Duration {
 	return r0
 }
 
+// BalanceThreshold provides a mock function with given fields: account
+func (_m *Config) BalanceThreshold(account string) (uint64, uint64) {
+	ret := _m.Called(account)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BalanceThreshold")
+	}
+
+	var r0 uint64
+	var r1 uint64
+	if rf, ok := ret.Get(0).(func(string) (uint64, uint64)); ok {
+		return rf(account)
+	}
+	if rf, ok := ret.Get(0).(func(string) uint64); ok {
+		r0 = rf(account)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) uint64); ok {
+		r1 = rf(account)
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+
+	return r0, r1
+}
+
 // BlockHistoryPollPeriod provides a mock function with given fields:
 func (_m *Config) BlockHistoryPollPeriod() time.Duration {
 	ret := _m.Called()
@@ -68,6 +98,82 @@ func (_m *Config) BlockHistorySize() uint64 {
 	return r0
 }
 
+// BundleEndpoint provides a mock function with given fields:
+func (_m *Config) BundleEndpoint() *url.URL {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BundleEndpoint")
+	}
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
+// BundleTipDefault provides a mock function with given fields:
+func (_m *Config) BundleTipDefault() uint64 {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BundleTipDefault")
+	}
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	return r0
+}
+
+// BundleTipFloorEndpoint provides a mock function with given fields:
+func (_m *Config) BundleTipFloorEndpoint() *url.URL {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BundleTipFloorEndpoint")
+	}
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
+// BundleTipMax provides a mock function with given fields:
+func (_m *Config) BundleTipMax() uint64 {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BundleTipMax")
+	}
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	return r0
+}
+
 // Commitment provides a mock function with given fields:
 func (_m *Config) Commitment() rpc.CommitmentType {
 	ret := _m.Called()
@@ -27,6 +27,7 @@ type MultiNode struct {
 	FinalizedBlockPollInterval *config.Duration
 	EnforceRepeatableRead      *bool
 	DeathDeclarationDelay      *config.Duration
+	SendTxFanoutDelay          *config.Duration
 
 	// Chain Configs
 	NodeNoNewHeadsThreshold      *config.Duration
@@ -72,6 +73,10 @@ func (c *MultiNodeConfig) DeathDeclarationDelay() time.Duration {
 	return c.MultiNode.DeathDeclarationDelay.Duration()
 }
 
+func (c *MultiNodeConfig) SendTxFanoutDelay() time.Duration {
+	return c.MultiNode.SendTxFanoutDelay.Duration()
+}
+
 func (c *MultiNodeConfig) NodeNoNewHeadsThreshold() time.Duration {
 	return c.MultiNode.NodeNoNewHeadsThreshold.Duration()
 }
@@ -129,6 +134,11 @@ func (c *MultiNodeConfig) SetDefaults() {
 	if c.MultiNode.DeathDeclarationDelay == nil {
 		c.MultiNode.DeathDeclarationDelay = config.MustNewDuration(10 * time.Second)
 	}
+	// Transactions are broadcast to staked nodes first and fan out to all nodes after 1 second, roughly 2.5 slots,
+	// which gives the staked nodes time to forward the transaction to the leader. Tiering only applies if a node is staked.
+	if c.MultiNode.SendTxFanoutDelay == nil {
+		c.MultiNode.SendTxFanoutDelay = config.MustNewDuration(time.Second)
+	}
 
 	/* Chain Configs */
 	// Threshold for no new heads is set to 10 seconds, assuming that heads should update at a reasonable pace.
@@ -186,6 +196,9 @@ func (c *MultiNodeConfig) SetFrom(f *MultiNodeConfig) {
 	if f.MultiNode.DeathDeclarationDelay != nil {
 		c.MultiNode.DeathDeclarationDelay = f.MultiNode.DeathDeclarationDelay
 	}
+	if f.MultiNode.SendTxFanoutDelay != nil {
+		c.MultiNode.SendTxFanoutDelay = f.MultiNode.SendTxFanoutDelay
+	}
 
 	// Chain Configs
 	if f.MultiNode.NodeNoNewHeadsThreshold != nil {
@@ -106,6 +106,7 @@ func setFromNode(n, f *Node) {
 		n.URL = f.URL
 	}
 	n.SendOnly = f.SendOnly
+	n.Staked = f.Staked
 }
 
 type TOMLConfig struct {
@@ -113,8 +114,9 @@ type TOMLConfig struct {
 	// Do not access directly, use [IsEnabled]
 	Enabled *bool
 	Chain
-	MultiNode MultiNodeConfig
-	Nodes     Nodes
+	MultiNode         MultiNodeConfig
+	Nodes             Nodes
+	BalanceThresholds BalanceThresholds
 }
 
 func (c *TOMLConfig) IsEnabled() bool {
@@ -131,6 +133,7 @@ func (c *TOMLConfig) SetFrom(f *TOMLConfig) {
 	setFromChain(&c.Chain, &f.Chain)
 	c.Nodes.SetFrom(&f.Nodes)
 	c.MultiNode.SetFrom(&f.MultiNode)
+	c.BalanceThresholds.SetFrom(&f.BalanceThresholds)
 }
 
 func setFromChain(c, f *Chain) {
@@ -188,6 +191,24 @@ func setFromChain(c, f *Chain) {
 	if f.BlockHistorySize != nil {
 		c.BlockHistorySize = f.BlockHistorySize
 	}
+	if f.BundleEndpoint != nil {
+		c.BundleEndpoint = f.BundleEndpoint
+	}
+	if f.BundleTipFloorEndpoint != nil {
+		c.BundleTipFloorEndpoint = f.BundleTipFloorEndpoint
+	}
+	if f.BundleTipDefault != nil {
+		c.BundleTipDefault = f.BundleTipDefault
+	}
+	if f.BundleTipMax != nil {
+		c.BundleTipMax = f.BundleTipMax
+	}
+	if f.BalanceMin != nil {
+		c.BalanceMin = f.BalanceMin
+	}
+	if f.BalanceFloor != nil {
+		c.BalanceFloor = f.BalanceFloor
+	}
 }
 
 func (c *TOMLConfig) ValidateConfig() (err error) {
@@ -200,6 +221,15 @@ func (c *TOMLConfig) ValidateConfig() (err error) {
 	if len(c.Nodes) == 0 {
 		err = errors.Join(err, config.ErrMissing{Name: "Nodes", Msg: "must have at least one node"})
 	}
+
+	if c.Chain.BundleTipDefault != nil && c.Chain.BundleTipMax != nil && *c.Chain.BundleTipDefault > *c.Chain.BundleTipMax {
+		err = errors.Join(err, config.ErrInvalid{Name: "BundleTipDefault", Value: *c.Chain.BundleTipDefault, Msg: "must not exceed BundleTipMax"})
+	}
+
+	if c.Chain.BalanceMin != nil && c.Chain.BalanceFloor != nil && *c.Chain.BalanceFloor > *c.Chain.BalanceMin {
+		err = errors.Join(err, config.ErrInvalid{Name: "BalanceFloor", Value: *c.Chain.BalanceFloor, Msg: "must not exceed BalanceMin"})
+	}
+	err = errors.Join(err, c.BalanceThresholds.validateKeys())
 	return
 }
 
@@ -217,6 +247,10 @@ func (c *TOMLConfig) BalancePollPeriod() time.Duration {
 	return c.Chain.BalancePollPeriod.Duration()
 }
 
+func (c *TOMLConfig) BalanceThreshold(account string) (minBalance, floor uint64) {
+	return c.BalanceThresholds.get(account, *c.Chain.BalanceMin, *c.Chain.BalanceFloor)
+}
+
 func (c *TOMLConfig) ConfirmPollPeriod() time.Duration {
 	return c.Chain.ConfirmPollPeriod.Duration()
 }
@@ -299,6 +333,24 @@ func (c *TOMLConfig) EstimateComputeUnitLimit() bool {
 	return *c.Chain.EstimateComputeUnitLimit
 }
 
+// BundleEndpoint returns the block engine used for bundle submission, nil if bundles are disabled
+func (c *TOMLConfig) BundleEndpoint() *url.URL {
+	return (*url.URL)(c.Chain.BundleEndpoint)
+}
+
+// BundleTipFloorEndpoint returns the tip floor API used to estimate bundle tips, nil to use fixed tips
+func (c *TOMLConfig) BundleTipFloorEndpoint() *url.URL {
+	return (*url.URL)(c.Chain.BundleTipFloorEndpoint)
+}
+
+func (c *TOMLConfig) BundleTipDefault() uint64 {
+	return *c.Chain.BundleTipDefault
+}
+
+func (c *TOMLConfig) BundleTipMax() uint64 {
+	return *c.Chain.BundleTipMax
+}
+
 func (c *TOMLConfig) ListNodes() Nodes {
 	return c.Nodes
 }
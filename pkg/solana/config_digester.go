@@ -21,8 +21,12 @@ type OffchainConfigDigester struct {
 }
 
 // ConfigDigest is meant to do the same thing as config_digest_from_data from the program.
+// Configs which could not be proposed onchain are rejected, see ValidateContractConfig.
 func (d OffchainConfigDigester) ConfigDigest(ctx context.Context, cfg types.ContractConfig) (types.ConfigDigest, error) {
 	digest := types.ConfigDigest{}
+	if err := ValidateContractConfig(ctx, cfg); err != nil {
+		return digest, err
+	}
 	buf := sha256.New()
 
 	if _, err := buf.Write(d.ProgramID.Bytes()); err != nil {
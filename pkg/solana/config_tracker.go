@@ -2,6 +2,7 @@ package solana
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
 	"github.com/smartcontractkit/libocr/offchainreporting2/types"
@@ -25,6 +26,8 @@ func (c *ConfigTracker) LatestConfigDetails(ctx context.Context) (changedInBlock
 	return state.Config.LatestConfigBlockNumber, state.Config.LatestConfigDigest, err
 }
 
+// ConfigFromState converts the onchain state to a contract config. Configured states (ConfigCount > 0) are validated
+// with ValidateContractConfig.
 func ConfigFromState(ctx context.Context, state State) (types.ContractConfig, error) {
 	pubKeys := []types.OnchainPublicKey{}
 	accounts := []types.Account{}
@@ -52,7 +55,7 @@ func ConfigFromState(ctx context.Context, state State) (types.ContractConfig, er
 		return types.ContractConfig{}, err
 	}
 
-	return types.ContractConfig{
+	cfg := types.ContractConfig{
 		ConfigDigest:          state.Config.LatestConfigDigest,
 		ConfigCount:           uint64(state.Config.ConfigCount),
 		Signers:               pubKeys,
@@ -61,7 +64,13 @@ func ConfigFromState(ctx context.Context, state State) (types.ContractConfig, er
 		OnchainConfig:         onchainConfig,
 		OffchainConfigVersion: state.OffchainConfig.Version,
 		OffchainConfig:        offchainConfig,
-	}, nil
+	}
+	if state.Config.ConfigCount > 0 {
+		if err = ValidateContractConfig(ctx, cfg); err != nil {
+			return types.ContractConfig{}, fmt.Errorf("invalid config in state: %w", err)
+		}
+	}
+	return cfg, nil
 }
 
 // LatestConfig returns the latest configuration.
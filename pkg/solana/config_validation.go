@@ -0,0 +1,94 @@
+package solana
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	"github.com/smartcontractkit/libocr/offchainreporting2/types"
+)
+
+// SignerLen is the byte length of an onchain signing key (secp256k1 address)
+const SignerLen = 20
+
+// ConfigFieldError is a contract config field which violates an OCR2 program invariant
+type ConfigFieldError struct {
+	Field string
+	Msg   string
+}
+
+func (e ConfigFieldError) Error() string {
+	return fmt.Sprintf("invalid config %s: %s", e.Field, e.Msg)
+}
+
+// ValidateContractConfig checks cfg against the invariants enforced by the OCR2 program in create_proposal,
+// write_offchain_config, propose_config and finalize_proposal, so invalid proposals can be rejected before they are
+// submitted. All violations are returned joined as ConfigFieldError.
+func ValidateContractConfig(ctx context.Context, cfg types.ContractConfig) (err error) {
+	n := len(cfg.Signers)
+	if n == 0 {
+		err = errors.Join(err, ConfigFieldError{Field: "Signers", Msg: "at least one oracle is required"})
+	}
+	if n > MaxOracles {
+		err = errors.Join(err, ConfigFieldError{Field: "Signers", Msg: fmt.Sprintf("%d oracles exceeds MaxOracles (%d)", n, MaxOracles)})
+	}
+	if len(cfg.Transmitters) != n {
+		err = errors.Join(err, ConfigFieldError{Field: "Transmitters", Msg: fmt.Sprintf("%d transmitters does not match %d signers", len(cfg.Transmitters), n)})
+	}
+	if cfg.F == 0 {
+		err = errors.Join(err, ConfigFieldError{Field: "F", Msg: "must be greater than 0"})
+	} else if 3*int(cfg.F) >= n {
+		err = errors.Join(err, ConfigFieldError{Field: "F", Msg: fmt.Sprintf("3*f (%d) must be less than the number of oracles (%d)", 3*int(cfg.F), n)})
+	}
+
+	signers := map[string]int{}
+	for i, signer := range cfg.Signers {
+		field := fmt.Sprintf("Signers[%d]", i)
+		if len(signer) != SignerLen {
+			err = errors.Join(err, ConfigFieldError{Field: field, Msg: fmt.Sprintf("length %d, expected %d", len(signer), SignerLen)})
+			continue
+		}
+		if j, ok := signers[string(signer)]; ok {
+			err = errors.Join(err, ConfigFieldError{Field: field, Msg: fmt.Sprintf("duplicate of Signers[%d]", j)})
+			continue
+		}
+		signers[string(signer)] = i
+	}
+
+	transmitters := map[solana.PublicKey]int{}
+	for i, transmitter := range cfg.Transmitters {
+		field := fmt.Sprintf("Transmitters[%d]", i)
+		pubKey, parseErr := solana.PublicKeyFromBase58(string(transmitter))
+		if parseErr != nil {
+			err = errors.Join(err, ConfigFieldError{Field: field, Msg: fmt.Sprintf("invalid public key %q: %s", transmitter, parseErr)})
+			continue
+		}
+		if j, ok := transmitters[pubKey]; ok {
+			err = errors.Join(err, ConfigFieldError{Field: field, Msg: fmt.Sprintf("duplicate of Transmitters[%d]", j)})
+			continue
+		}
+		transmitters[pubKey] = i
+	}
+
+	if _, decodeErr := (median.StandardOnchainConfigCodec{}).Decode(ctx, cfg.OnchainConfig); decodeErr != nil {
+		err = errors.Join(err, ConfigFieldError{Field: "OnchainConfig", Msg: decodeErr.Error()})
+	}
+	if cfg.OffchainConfigVersion == 0 {
+		err = errors.Join(err, ConfigFieldError{Field: "OffchainConfigVersion", Msg: "must be greater than 0"})
+	}
+	err = errors.Join(err, ValidateOffchainConfig(cfg.OffchainConfig))
+	return err
+}
+
+// ValidateOffchainConfig checks the offchain config fits in the proposal written by write_offchain_config
+func ValidateOffchainConfig(offchainConfig []byte) error {
+	if len(offchainConfig) == 0 {
+		return ConfigFieldError{Field: "OffchainConfig", Msg: "must not be empty"}
+	}
+	if len(offchainConfig) > MaxOffchainConfigLen {
+		return ConfigFieldError{Field: "OffchainConfig", Msg: fmt.Sprintf("length %d exceeds MaxOffchainConfigLen (%d)", len(offchainConfig), MaxOffchainConfigLen)}
+	}
+	return nil
+}
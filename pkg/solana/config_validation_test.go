@@ -0,0 +1,117 @@
+package solana
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/smartcontractkit/libocr/offchainreporting2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+)
+
+func TestValidateContractConfig(t *testing.T) {
+	var state State
+	require.NoError(t, bin.NewBorshDecoder(mockState.Raw).Decode(&state))
+	valid, err := ConfigFromState(tests.Context(t), state)
+	require.NoError(t, err)
+	require.NoError(t, ValidateContractConfig(tests.Context(t), valid))
+
+	// copy returns a config which can be modified without changing valid
+	copyConfig := func() types.ContractConfig {
+		cfg := valid
+		cfg.Signers = append([]types.OnchainPublicKey{}, valid.Signers...)
+		cfg.Transmitters = append([]types.Account{}, valid.Transmitters...)
+		return cfg
+	}
+
+	for _, tc := range []struct {
+		name   string
+		modify func(cfg *types.ContractConfig)
+		fields []string
+	}{
+		{"no oracles", func(cfg *types.ContractConfig) {
+			cfg.Signers, cfg.Transmitters = nil, nil
+		}, []string{"Signers", "F"}},
+		{"too many oracles", func(cfg *types.ContractConfig) {
+			for i := len(cfg.Signers); i <= MaxOracles; i++ {
+				cfg.Signers = append(cfg.Signers, bytes.Repeat([]byte{byte(i)}, SignerLen))
+				cfg.Transmitters = append(cfg.Transmitters, types.Account(solana.PublicKey{byte(i), 1}.String()))
+			}
+		}, []string{"Signers"}},
+		{"mismatched transmitters", func(cfg *types.ContractConfig) {
+			cfg.Transmitters = cfg.Transmitters[1:]
+		}, []string{"Transmitters"}},
+		{"f zero", func(cfg *types.ContractConfig) {
+			cfg.F = 0
+		}, []string{"F"}},
+		{"f too large", func(cfg *types.ContractConfig) {
+			cfg.F = uint8((len(cfg.Signers) + 2) / 3) //nolint:gosec // test config has at most MaxOracles
+		}, []string{"F"}},
+		{"short signer", func(cfg *types.ContractConfig) {
+			cfg.Signers[1] = cfg.Signers[1][:SignerLen-1]
+		}, []string{"Signers[1]"}},
+		{"duplicate signer", func(cfg *types.ContractConfig) {
+			cfg.Signers[2] = cfg.Signers[0]
+		}, []string{"Signers[2]"}},
+		{"invalid transmitter", func(cfg *types.ContractConfig) {
+			cfg.Transmitters[0] = "not-base58"
+		}, []string{"Transmitters[0]"}},
+		{"duplicate transmitter", func(cfg *types.ContractConfig) {
+			cfg.Transmitters[3] = cfg.Transmitters[1]
+		}, []string{"Transmitters[3]"}},
+		{"invalid onchain config", func(cfg *types.ContractConfig) {
+			cfg.OnchainConfig = []byte{1}
+		}, []string{"OnchainConfig"}},
+		{"offchain config version zero", func(cfg *types.ContractConfig) {
+			cfg.OffchainConfigVersion = 0
+		}, []string{"OffchainConfigVersion"}},
+		{"empty offchain config", func(cfg *types.ContractConfig) {
+			cfg.OffchainConfig = nil
+		}, []string{"OffchainConfig"}},
+		{"offchain config too long", func(cfg *types.ContractConfig) {
+			cfg.OffchainConfig = make([]byte, MaxOffchainConfigLen+1)
+		}, []string{"OffchainConfig"}},
+		{"multiple violations", func(cfg *types.ContractConfig) {
+			cfg.F = 0
+			cfg.Signers[2] = cfg.Signers[0]
+			cfg.OffchainConfigVersion = 0
+		}, []string{"F", "Signers[2]", "OffchainConfigVersion"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := copyConfig()
+			tc.modify(&cfg)
+			err := ValidateContractConfig(tests.Context(t), cfg)
+			require.Error(t, err)
+			assert.ElementsMatch(t, tc.fields, configErrorFields(err))
+
+			// invalid configs are not digested
+			_, err = OffchainConfigDigester{}.ConfigDigest(tests.Context(t), cfg)
+			assert.Error(t, err)
+		})
+	}
+
+	t.Run("unconfigured state", func(t *testing.T) {
+		_, err := ConfigFromState(tests.Context(t), State{})
+		assert.NoError(t, err)
+	})
+}
+
+func configErrorFields(err error) []string {
+	var fields []string
+	var fieldErr ConfigFieldError
+	if errors.As(err, &fieldErr) {
+		if joined, ok := err.(interface{ Unwrap() []error }); ok {
+			for _, e := range joined.Unwrap() {
+				fields = append(fields, configErrorFields(e)...)
+			}
+			return fields
+		}
+		return []string{fieldErr.Field}
+	}
+	return nil
+}
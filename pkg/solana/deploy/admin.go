@@ -0,0 +1,244 @@
+package deploy
+
+import (
+	"context"
+	"fmt"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+
+	"github.com/smartcontractkit/chainlink-solana/contracts/generated/access_controller"
+	"github.com/smartcontractkit/chainlink-solana/contracts/generated/ocr_2"
+	"github.com/smartcontractkit/chainlink-solana/contracts/generated/store"
+	relaySol "github.com/smartcontractkit/chainlink-solana/pkg/solana"
+)
+
+// AccountKind is the type of an owned program account
+type AccountKind string
+
+const (
+	KindOCR2State        AccountKind = "ocr2-state"
+	KindStore            AccountKind = "store"
+	KindFeed             AccountKind = "feed"
+	KindAccessController AccountKind = "access-controller"
+)
+
+// owned is an owned program account
+type owned struct {
+	kind          AccountKind
+	owner         solana.PublicKey
+	proposedOwner solana.PublicKey
+}
+
+// getOwned reads account and identifies its kind by program and account discriminator
+func (d *Deployer) getOwned(ctx context.Context, address solana.PublicKey) (owned, error) {
+	account, err := d.getAccount(ctx, address)
+	if err != nil {
+		return owned{}, err
+	}
+	if account == nil {
+		return owned{}, fmt.Errorf("account %s does not exist", address)
+	}
+	data := account.Data.GetBinary()
+	if len(data) < discriminatorLen {
+		return owned{}, fmt.Errorf("account %s is not a program account", address)
+	}
+	var discriminator [discriminatorLen]byte
+	copy(discriminator[:], data)
+	decoder := bin.NewBinDecoder(data)
+
+	switch {
+	case account.Owner.Equals(d.programs.OCR2) && discriminator == ocr_2.StateDiscriminator:
+		var state relaySol.State
+		if err = decoder.Decode(&state); err != nil {
+			return owned{}, fmt.Errorf("failed to decode state %s: %w", address, err)
+		}
+		return owned{kind: KindOCR2State, owner: state.Config.Owner, proposedOwner: state.Config.ProposedOwner}, nil
+	case account.Owner.Equals(d.programs.Store) && discriminator == store.StoreDiscriminator:
+		var s store.Store
+		if err = decoder.Decode(&s); err != nil {
+			return owned{}, fmt.Errorf("failed to decode store %s: %w", address, err)
+		}
+		return owned{kind: KindStore, owner: s.Owner, proposedOwner: s.ProposedOwner}, nil
+	case account.Owner.Equals(d.programs.Store) && discriminator == store.TransmissionsDiscriminator:
+		var feed store.Transmissions
+		if err = decoder.Decode(&feed); err != nil {
+			return owned{}, fmt.Errorf("failed to decode feed %s: %w", address, err)
+		}
+		return owned{kind: KindFeed, owner: feed.Owner, proposedOwner: feed.ProposedOwner}, nil
+	case account.Owner.Equals(d.programs.AccessController) && discriminator == access_controller.AccessControllerDiscriminator:
+		var ac access_controller.AccessController
+		if err = decoder.Decode(&ac); err != nil {
+			return owned{}, fmt.Errorf("failed to decode access controller %s: %w", address, err)
+		}
+		return owned{kind: KindAccessController, owner: ac.Owner, proposedOwner: ac.ProposedOwner}, nil
+	}
+	return owned{}, fmt.Errorf("account %s (program %s) is not an OCR2 state, store, feed, or access controller", address, account.Owner)
+}
+
+// PlanTransferOwnership returns the step to propose a new owner of an OCR2 state, store, feed, or access controller
+func (d *Deployer) PlanTransferOwnership(ctx context.Context, address, proposedOwner solana.PublicKey) (Plan, error) {
+	account, err := d.getOwned(ctx, address)
+	if err != nil {
+		return Plan{}, err
+	}
+	if account.owner.Equals(proposedOwner) {
+		return Plan{Skipped: []string{fmt.Sprintf("%s %s is owned by %s", account.kind, address, proposedOwner)}}, nil
+	}
+	if account.proposedOwner.Equals(proposedOwner) {
+		return Plan{Skipped: []string{fmt.Sprintf("%s %s owner %s is proposed", account.kind, address, proposedOwner)}}, nil
+	}
+
+	var program solana.PublicKey
+	var inst solana.Instruction
+	switch account.kind {
+	case KindOCR2State:
+		program, inst = d.programs.OCR2, ocr_2.NewTransferOwnershipInstruction(proposedOwner, address, d.owner).Build()
+	case KindStore:
+		program, inst = d.programs.Store, store.NewTransferStoreOwnershipInstruction(proposedOwner, address, d.owner).Build()
+	case KindFeed:
+		program, inst = d.programs.Store, store.NewTransferFeedOwnershipInstruction(proposedOwner, address, account.owner, d.owner).Build()
+	case KindAccessController:
+		program, inst = d.programs.AccessController, access_controller.NewTransferOwnershipInstruction(proposedOwner, address, d.owner).Build()
+	}
+	if inst, err = programInstruction(program, inst); err != nil {
+		return Plan{}, err
+	}
+	return Plan{Steps: []Step{{Name: fmt.Sprintf("transfer %s ownership", account.kind), Instructions: []solana.Instruction{inst}}}}, nil
+}
+
+// PlanAcceptOwnership returns the step for the deployer owner to accept the ownership of an OCR2 state, store, feed,
+// or access controller
+func (d *Deployer) PlanAcceptOwnership(ctx context.Context, address solana.PublicKey) (Plan, error) {
+	account, err := d.getOwned(ctx, address)
+	if err != nil {
+		return Plan{}, err
+	}
+	if account.owner.Equals(d.owner) {
+		return Plan{Skipped: []string{fmt.Sprintf("%s %s is owned by %s", account.kind, address, d.owner)}}, nil
+	}
+	if !account.proposedOwner.Equals(d.owner) {
+		return Plan{}, fmt.Errorf("%s %s proposed owner is %s, expected %s", account.kind, address, account.proposedOwner, d.owner)
+	}
+
+	var program solana.PublicKey
+	var inst solana.Instruction
+	switch account.kind {
+	case KindOCR2State:
+		program, inst = d.programs.OCR2, ocr_2.NewAcceptOwnershipInstruction(address, d.owner).Build()
+	case KindStore:
+		program, inst = d.programs.Store, store.NewAcceptStoreOwnershipInstruction(address, d.owner).Build()
+	case KindFeed:
+		program, inst = d.programs.Store, store.NewAcceptFeedOwnershipInstruction(address, account.proposedOwner, d.owner).Build()
+	case KindAccessController:
+		program, inst = d.programs.AccessController, access_controller.NewAcceptOwnershipInstruction(address, d.owner).Build()
+	}
+	if inst, err = programInstruction(program, inst); err != nil {
+		return Plan{}, err
+	}
+	return Plan{Steps: []Step{{Name: fmt.Sprintf("accept %s ownership", account.kind), Instructions: []solana.Instruction{inst}}}}, nil
+}
+
+// PlanWithdrawPayment returns the step to pay out the oracle owed payment to payee.
+// authority must own the payee token account.
+func (d *Deployer) PlanWithdrawPayment(ctx context.Context, stateID, payee, authority solana.PublicKey) (Plan, error) {
+	state, _, err := relaySol.GetState(ctx, d.client, stateID, d.commitment)
+	if err != nil {
+		return Plan{}, err
+	}
+	vaultAuthority, err := VaultAuthority(d.programs.OCR2, stateID)
+	if err != nil {
+		return Plan{}, fmt.Errorf("failed to derive vault authority: %w", err)
+	}
+	inst, err := programInstruction(d.programs.OCR2, ocr_2.NewWithdrawPaymentInstruction(stateID, authority, state.Config.TokenVault, vaultAuthority, payee, solana.TokenProgramID).Build())
+	if err != nil {
+		return Plan{}, err
+	}
+	return Plan{Steps: []Step{{Name: "withdraw payment", Instructions: []solana.Instruction{inst}}}}, nil
+}
+
+// AccessControllerRole is an access controller set on an OCR2 state or store
+type AccessControllerRole string
+
+const (
+	RoleRequester AccessControllerRole = "requester"
+	RoleBilling   AccessControllerRole = "billing"
+	RoleLowering  AccessControllerRole = "lowering"
+)
+
+// PlanSetAccessController returns the step to set the requester or billing access controller of an OCR2 state,
+// or the lowering access controller of a store
+func (d *Deployer) PlanSetAccessController(ctx context.Context, address solana.PublicKey, role AccessControllerRole, controller solana.PublicKey) (Plan, error) {
+	var current solana.PublicKey
+	var program solana.PublicKey
+	var inst solana.Instruction
+	switch role {
+	case RoleRequester, RoleBilling:
+		state, _, err := relaySol.GetState(ctx, d.client, address, d.commitment)
+		if err != nil {
+			return Plan{}, err
+		}
+		program = d.programs.OCR2
+		if role == RoleRequester {
+			current, inst = state.Config.RequesterAccessController, ocr_2.NewSetRequesterAccessControllerInstruction(address, d.owner, controller).Build()
+		} else {
+			current, inst = state.Config.BillingAccessController, ocr_2.NewSetBillingAccessControllerInstruction(address, d.owner, controller).Build()
+		}
+	case RoleLowering:
+		account, err := d.getAccount(ctx, address)
+		if err != nil {
+			return Plan{}, err
+		}
+		if account == nil || !account.Owner.Equals(d.programs.Store) {
+			return Plan{}, fmt.Errorf("store %s does not exist", address)
+		}
+		var s store.Store
+		if err = bin.NewBinDecoder(account.Data.GetBinary()).Decode(&s); err != nil {
+			return Plan{}, fmt.Errorf("failed to decode store %s: %w", address, err)
+		}
+		program, current = d.programs.Store, s.LoweringAccessController
+		inst = store.NewSetLoweringAccessControllerInstruction(address, d.owner, controller).Build()
+	default:
+		return Plan{}, fmt.Errorf("unknown access controller role %q", role)
+	}
+	if current.Equals(controller) {
+		return Plan{Skipped: []string{fmt.Sprintf("%s access controller of %s is %s", role, address, controller)}}, nil
+	}
+	inst, err := programInstruction(program, inst)
+	if err != nil {
+		return Plan{}, err
+	}
+	return Plan{Steps: []Step{{Name: fmt.Sprintf("set %s access controller", role), Instructions: []solana.Instruction{inst}}}}, nil
+}
+
+// PlanAccess returns the step to grant (or revoke) access to address on the access controller
+func (d *Deployer) PlanAccess(ctx context.Context, controller, address solana.PublicKey, grant bool) (Plan, error) {
+	account, err := d.getAccount(ctx, controller)
+	if err != nil {
+		return Plan{}, err
+	}
+	if account == nil || !account.Owner.Equals(d.programs.AccessController) {
+		return Plan{}, fmt.Errorf("access controller %s does not exist", controller)
+	}
+	var ac access_controller.AccessController
+	if err = bin.NewBinDecoder(account.Data.GetBinary()).Decode(&ac); err != nil {
+		return Plan{}, fmt.Errorf("failed to decode access controller %s: %w", controller, err)
+	}
+	hasAccess := false
+	for _, a := range ac.AccessList.Xs[:min(ac.AccessList.Len, uint64(len(ac.AccessList.Xs)))] {
+		hasAccess = hasAccess || a.Equals(address)
+	}
+	if hasAccess == grant {
+		return Plan{Skipped: []string{fmt.Sprintf("access of %s on %s is %t", address, controller, grant)}}, nil
+	}
+
+	var inst solana.Instruction = access_controller.NewAddAccessInstruction(controller, d.owner, address).Build()
+	name := "add access"
+	if !grant {
+		name, inst = "remove access", access_controller.NewRemoveAccessInstruction(controller, d.owner, address).Build()
+	}
+	if inst, err = programInstruction(d.programs.AccessController, inst); err != nil {
+		return Plan{}, err
+	}
+	return Plan{Steps: []Step{{Name: name, Instructions: []solana.Instruction{inst}}}}, nil
+}
@@ -0,0 +1,111 @@
+package deploy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gagliardetto/solana-go"
+
+	"github.com/smartcontractkit/chainlink-solana/contracts/generated/ocr_2"
+	relaySol "github.com/smartcontractkit/chainlink-solana/pkg/solana"
+)
+
+// Billing is the payment per observation and transmission, in gjuels
+type Billing struct {
+	ObservationPaymentGjuels  uint32
+	TransmissionPaymentGjuels uint32
+}
+
+// PlanBilling returns the step to set the state billing. Oracles are paid out with the previous billing first,
+// tokenReceiver receives the payments owed to oracles with closed payee token accounts.
+// The deployer owner must have access on the state billing access controller.
+func (d *Deployer) PlanBilling(ctx context.Context, stateID solana.PublicKey, billing Billing, tokenReceiver solana.PublicKey) (Plan, error) {
+	state, _, err := relaySol.GetState(ctx, d.client, stateID, d.commitment)
+	if err != nil {
+		return Plan{}, err
+	}
+	if state.Config.Billing.ObservationPayment == billing.ObservationPaymentGjuels && state.Config.Billing.TransmissionPayment == billing.TransmissionPaymentGjuels {
+		return Plan{Skipped: []string{fmt.Sprintf("state %s billing is up to date", stateID)}}, nil
+	}
+	if tokenReceiver.IsZero() {
+		return Plan{}, errors.New("token receiver is required to pay out oracles")
+	}
+	vaultAuthority, err := VaultAuthority(d.programs.OCR2, stateID)
+	if err != nil {
+		return Plan{}, fmt.Errorf("failed to derive vault authority: %w", err)
+	}
+	setBilling := ocr_2.NewSetBillingInstruction(
+		billing.ObservationPaymentGjuels,
+		billing.TransmissionPaymentGjuels,
+		stateID,
+		d.owner,
+		state.Config.BillingAccessController,
+		tokenReceiver,
+		state.Config.TokenVault,
+		vaultAuthority,
+		solana.TokenProgramID,
+	)
+	if err = appendPayees(setBilling, state); err != nil {
+		return Plan{}, err
+	}
+	inst, err := programInstruction(d.programs.OCR2, setBilling.Build())
+	if err != nil {
+		return Plan{}, err
+	}
+	return Plan{Steps: []Step{{Name: "set billing", Instructions: []solana.Instruction{inst}}}}, nil
+}
+
+// PlanTransferPayeeship returns the step to propose a new payee for the transmitter.
+// authority must own the current payee token account.
+func (d *Deployer) PlanTransferPayeeship(ctx context.Context, stateID, transmitter, authority, proposedPayee solana.PublicKey) (Plan, error) {
+	oracle, err := d.getOracle(ctx, stateID, transmitter)
+	if err != nil {
+		return Plan{}, err
+	}
+	if oracle.Payee.Equals(proposedPayee) {
+		return Plan{Skipped: []string{fmt.Sprintf("transmitter %s payee is %s", transmitter, proposedPayee)}}, nil
+	}
+	if oracle.ProposedPayee.Equals(proposedPayee) {
+		return Plan{Skipped: []string{fmt.Sprintf("transmitter %s payee %s is proposed", transmitter, proposedPayee)}}, nil
+	}
+	inst, err := programInstruction(d.programs.OCR2, ocr_2.NewTransferPayeeshipInstruction(stateID, authority, transmitter, oracle.Payee, proposedPayee).Build())
+	if err != nil {
+		return Plan{}, err
+	}
+	return Plan{Steps: []Step{{Name: "transfer payeeship", Instructions: []solana.Instruction{inst}}}}, nil
+}
+
+// PlanAcceptPayeeship returns the step to accept the proposed payee of the transmitter.
+// authority must own the proposed payee token account.
+func (d *Deployer) PlanAcceptPayeeship(ctx context.Context, stateID, transmitter, authority solana.PublicKey) (Plan, error) {
+	oracle, err := d.getOracle(ctx, stateID, transmitter)
+	if err != nil {
+		return Plan{}, err
+	}
+	if oracle.ProposedPayee.IsZero() {
+		return Plan{Skipped: []string{fmt.Sprintf("transmitter %s has no proposed payee", transmitter)}}, nil
+	}
+	inst, err := programInstruction(d.programs.OCR2, ocr_2.NewAcceptPayeeshipInstruction(stateID, authority, transmitter, oracle.ProposedPayee).Build())
+	if err != nil {
+		return Plan{}, err
+	}
+	return Plan{Steps: []Step{{Name: "accept payeeship", Instructions: []solana.Instruction{inst}}}}, nil
+}
+
+func (d *Deployer) getOracle(ctx context.Context, stateID, transmitter solana.PublicKey) (relaySol.Oracle, error) {
+	state, _, err := relaySol.GetState(ctx, d.client, stateID, d.commitment)
+	if err != nil {
+		return relaySol.Oracle{}, err
+	}
+	oracles, err := state.Oracles.Data()
+	if err != nil {
+		return relaySol.Oracle{}, err
+	}
+	for _, o := range oracles {
+		if o.Transmitter.Equals(transmitter) {
+			return o, nil
+		}
+	}
+	return relaySol.Oracle{}, fmt.Errorf("transmitter %s is not an oracle of state %s", transmitter, stateID)
+}
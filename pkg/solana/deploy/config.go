@@ -0,0 +1,335 @@
+package deploy
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/smartcontractkit/libocr/offchainreporting2/types"
+
+	"github.com/smartcontractkit/chainlink-solana/contracts/generated/ocr_2"
+	relaySol "github.com/smartcontractkit/chainlink-solana/pkg/solana"
+)
+
+// Oracle is an oracle in a proposed config
+type Oracle struct {
+	Signer      types.OnchainPublicKey
+	Transmitter solana.PublicKey
+	// Payee is the token account (of the state token mint) paid for the oracle observations and transmissions
+	Payee solana.PublicKey
+}
+
+// ProposalConfig is an OCR2 config applied through the proposal lifecycle:
+// create_proposal, propose_config, write_offchain_config, propose_payees, finalize_proposal, and accept_proposal.
+type ProposalConfig struct {
+	State solana.PublicKey
+	// Proposal is created if it does not exist, an existing proposal is resumed
+	Proposal solana.PublicKey
+	// TokenReceiver receives the payments owed to oracles with closed payee token accounts when the proposal is accepted
+	TokenReceiver solana.PublicKey
+
+	Oracles               []Oracle
+	F                     uint8
+	OffchainConfigVersion uint64
+	OffchainConfig        []byte
+}
+
+// PlanConfig returns the steps to apply cfg to the state. If the state config already matches no steps are returned.
+// cfg is validated against the OCR2 program invariants before any step is planned.
+func (d *Deployer) PlanConfig(ctx context.Context, cfg ProposalConfig) (Plan, error) {
+	state, _, err := relaySol.GetState(ctx, d.client, cfg.State, d.commitment)
+	if err != nil {
+		return Plan{}, err
+	}
+	if err = d.validateProposal(ctx, cfg, state); err != nil {
+		return Plan{}, fmt.Errorf("invalid proposal config: %w", err)
+	}
+	oracles := sortOracles(cfg.Oracles)
+
+	if stateConfigMatches(state, oracles, cfg) {
+		return Plan{Skipped: []string{fmt.Sprintf("state %s config is up to date (digest %x)", cfg.State, state.Config.LatestConfigDigest)}}, nil
+	}
+
+	var plan Plan
+	account, err := d.getAccount(ctx, cfg.Proposal)
+	if err != nil {
+		return Plan{}, err
+	}
+	digest := ProposalDigest(oracles, cfg.F, state.Config.TokenMint, cfg.OffchainConfigVersion, cfg.OffchainConfig)
+
+	written := []byte{}
+	proposeConfig, proposePayees := true, true
+	if account == nil {
+		create, err := d.createAccount(ctx, cfg.Proposal, ProposalAccountSize, d.programs.OCR2)
+		if err != nil {
+			return Plan{}, err
+		}
+		createProposal, err := programInstruction(d.programs.OCR2, ocr_2.NewCreateProposalInstruction(cfg.OffchainConfigVersion, cfg.Proposal, d.owner).Build())
+		if err != nil {
+			return Plan{}, err
+		}
+		plan.Steps = append(plan.Steps, Step{Name: "create proposal", Instructions: []solana.Instruction{create, createProposal}})
+	} else {
+		proposal, err := d.decodeProposal(cfg.Proposal, account)
+		if err != nil {
+			return Plan{}, err
+		}
+		if proposal.State == proposalFinalized {
+			onchain := proposalDigest(proposal)
+			if !bytes.Equal(onchain, digest) {
+				return Plan{}, fmt.Errorf("proposal %s is finalized with a different config (digest %x, expected %x), close it or use a new proposal", cfg.Proposal, onchain, digest)
+			}
+			plan.Skipped = append(plan.Skipped, fmt.Sprintf("proposal %s is finalized", cfg.Proposal))
+			accept, err := d.acceptStep(cfg, state, digest)
+			if err != nil {
+				return Plan{}, err
+			}
+			plan.Steps = append(plan.Steps, accept)
+			return plan, nil
+		}
+		if proposal.OffchainConfig.Version != cfg.OffchainConfigVersion {
+			return Plan{}, fmt.Errorf("proposal %s has offchain config version %d, expected %d, close it or use a new proposal", cfg.Proposal, proposal.OffchainConfig.Version, cfg.OffchainConfigVersion)
+		}
+		written = proposal.OffchainConfig.Xs[:min(proposal.OffchainConfig.Len, uint64(len(proposal.OffchainConfig.Xs)))]
+		if !bytes.HasPrefix(cfg.OffchainConfig, written) {
+			return Plan{}, fmt.Errorf("proposal %s has a different offchain config written, close it or use a new proposal", cfg.Proposal)
+		}
+		plan.Skipped = append(plan.Skipped, fmt.Sprintf("proposal %s exists with %d/%d offchain config bytes written", cfg.Proposal, len(written), len(cfg.OffchainConfig)))
+		proposeConfig, proposePayees = proposalOraclesDiffer(proposal, oracles, cfg.F)
+	}
+
+	if proposeConfig {
+		newOracles := make([]ocr_2.NewOracle, len(oracles))
+		for i, o := range oracles {
+			copy(newOracles[i].Signer[:], o.Signer)
+			newOracles[i].Transmitter = o.Transmitter
+		}
+		inst, err := programInstruction(d.programs.OCR2, ocr_2.NewProposeConfigInstruction(newOracles, cfg.F, cfg.Proposal, d.owner).Build())
+		if err != nil {
+			return Plan{}, err
+		}
+		plan.Steps = append(plan.Steps, Step{Name: "propose config", Instructions: []solana.Instruction{inst}})
+	}
+
+	remaining := cfg.OffchainConfig[len(written):]
+	chunks := (len(remaining) + OffchainConfigChunkSize - 1) / OffchainConfigChunkSize
+	for i := 0; i < chunks; i++ {
+		chunk := remaining[i*OffchainConfigChunkSize : min((i+1)*OffchainConfigChunkSize, len(remaining))]
+		inst, err := programInstruction(d.programs.OCR2, ocr_2.NewWriteOffchainConfigInstruction(chunk, cfg.Proposal, d.owner).Build())
+		if err != nil {
+			return Plan{}, err
+		}
+		plan.Steps = append(plan.Steps, Step{Name: fmt.Sprintf("write offchain config (%d/%d)", i+1, chunks), Instructions: []solana.Instruction{inst}})
+	}
+
+	if proposePayees {
+		// payees are matched to the proposal oracles which are sorted by signer
+		payees := ocr_2.NewProposePayeesInstruction(state.Config.TokenMint, cfg.Proposal, d.owner)
+		for _, o := range oracles {
+			payees.Append(solana.Meta(o.Payee))
+		}
+		inst, err := programInstruction(d.programs.OCR2, payees.Build())
+		if err != nil {
+			return Plan{}, err
+		}
+		plan.Steps = append(plan.Steps, Step{Name: "propose payees", Instructions: []solana.Instruction{inst}})
+	}
+
+	finalize, err := programInstruction(d.programs.OCR2, ocr_2.NewFinalizeProposalInstruction(cfg.Proposal, d.owner).Build())
+	if err != nil {
+		return Plan{}, err
+	}
+	plan.Steps = append(plan.Steps, Step{Name: "finalize proposal", Instructions: []solana.Instruction{finalize}})
+
+	accept, err := d.acceptStep(cfg, state, digest)
+	if err != nil {
+		return Plan{}, err
+	}
+	plan.Steps = append(plan.Steps, accept)
+	return plan, nil
+}
+
+// PlanCloseProposal returns the step to close an unaccepted proposal and reclaim its rent
+func (d *Deployer) PlanCloseProposal(ctx context.Context, proposal solana.PublicKey) (Plan, error) {
+	account, err := d.getAccount(ctx, proposal)
+	if err != nil {
+		return Plan{}, err
+	}
+	if account == nil {
+		return Plan{Skipped: []string{fmt.Sprintf("proposal %s does not exist", proposal)}}, nil
+	}
+	if _, err = d.decodeProposal(proposal, account); err != nil {
+		return Plan{}, err
+	}
+	inst, err := programInstruction(d.programs.OCR2, ocr_2.NewCloseProposalInstruction(proposal, d.payer, d.owner).Build())
+	if err != nil {
+		return Plan{}, err
+	}
+	return Plan{Steps: []Step{{Name: "close proposal", Instructions: []solana.Instruction{inst}}}}, nil
+}
+
+// ProposalDigest mirrors the OCR2 program Proposal::digest, oracles must be sorted by signer
+func ProposalDigest(oracles []Oracle, f uint8, tokenMint solana.PublicKey, offchainConfigVersion uint64, offchainConfig []byte) []byte {
+	hasher := sha256.New()
+	hasher.Write([]byte{uint8(len(oracles))}) //nolint:gosec // number of oracles cannot exceed MaxOracles
+	for _, o := range oracles {
+		hasher.Write(o.Signer)
+		hasher.Write(o.Transmitter.Bytes())
+		hasher.Write(o.Payee.Bytes())
+	}
+	hasher.Write([]byte{f})
+	hasher.Write(tokenMint.Bytes())
+	header := make([]byte, 8+4)
+	binary.BigEndian.PutUint64(header, offchainConfigVersion)
+	binary.BigEndian.PutUint32(header[8:], uint32(len(offchainConfig))) //nolint:gosec // offchain config cannot exceed MaxOffchainConfigLen
+	hasher.Write(header)
+	hasher.Write(offchainConfig)
+	return hasher.Sum(nil)
+}
+
+const proposalFinalized = 1
+
+func (d *Deployer) decodeProposal(address solana.PublicKey, account *rpc.Account) (ocr_2.Proposal, error) {
+	var proposal ocr_2.Proposal
+	if !account.Owner.Equals(d.programs.OCR2) {
+		return proposal, fmt.Errorf("proposal %s is owned by %s, expected the OCR2 program %s", address, account.Owner, d.programs.OCR2)
+	}
+	if err := bin.NewBinDecoder(account.Data.GetBinary()).Decode(&proposal); err != nil {
+		return proposal, fmt.Errorf("failed to decode proposal %s: %w", address, err)
+	}
+	if !proposal.Owner.Equals(d.owner) {
+		return proposal, fmt.Errorf("proposal %s is owned by %s, expected %s", address, proposal.Owner, d.owner)
+	}
+	return proposal, nil
+}
+
+func (d *Deployer) acceptStep(cfg ProposalConfig, state relaySol.State, digest []byte) (Step, error) {
+	vaultAuthority, err := VaultAuthority(d.programs.OCR2, cfg.State)
+	if err != nil {
+		return Step{}, fmt.Errorf("failed to derive vault authority: %w", err)
+	}
+	accept := ocr_2.NewAcceptProposalInstruction(
+		digest,
+		cfg.State,
+		cfg.Proposal,
+		d.payer,
+		cfg.TokenReceiver,
+		d.owner,
+		state.Config.TokenVault,
+		vaultAuthority,
+		solana.TokenProgramID,
+	)
+	// oracles of the current config are paid out before the config is replaced
+	if err = appendPayees(accept, state); err != nil {
+		return Step{}, err
+	}
+	inst, err := programInstruction(d.programs.OCR2, accept.Build())
+	if err != nil {
+		return Step{}, err
+	}
+	return Step{Name: "accept proposal", Instructions: []solana.Instruction{inst}}, nil
+}
+
+// appendPayees appends the payees of the state oracles as writable remaining accounts
+func appendPayees(inst interface{ Append(*solana.AccountMeta) }, state relaySol.State) error {
+	oracles, err := state.Oracles.Data()
+	if err != nil {
+		return err
+	}
+	for _, o := range oracles {
+		inst.Append(solana.Meta(o.Payee).WRITE())
+	}
+	return nil
+}
+
+func (d *Deployer) validateProposal(ctx context.Context, cfg ProposalConfig, state relaySol.State) error {
+	var err error
+	if cfg.Proposal.IsZero() {
+		err = errors.Join(err, errors.New("Proposal is required"))
+	}
+	if cfg.TokenReceiver.IsZero() {
+		err = errors.Join(err, errors.New("TokenReceiver is required"))
+	}
+	current, stateErr := relaySol.ConfigFromState(ctx, state)
+	if stateErr != nil {
+		return errors.Join(err, stateErr)
+	}
+	contractConfig := types.ContractConfig{
+		F:                     cfg.F,
+		OnchainConfig:         current.OnchainConfig,
+		OffchainConfigVersion: cfg.OffchainConfigVersion,
+		OffchainConfig:        cfg.OffchainConfig,
+	}
+	for i, o := range cfg.Oracles {
+		contractConfig.Signers = append(contractConfig.Signers, o.Signer)
+		contractConfig.Transmitters = append(contractConfig.Transmitters, types.Account(o.Transmitter.String()))
+		if o.Payee.IsZero() {
+			err = errors.Join(err, fmt.Errorf("Oracles[%d].Payee is required", i))
+		}
+	}
+	return errors.Join(err, relaySol.ValidateContractConfig(ctx, contractConfig))
+}
+
+func sortOracles(oracles []Oracle) []Oracle {
+	sorted := append([]Oracle{}, oracles...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].Signer, sorted[j].Signer) < 0
+	})
+	return sorted
+}
+
+func stateConfigMatches(state relaySol.State, oracles []Oracle, cfg ProposalConfig) bool {
+	if state.Config.ConfigCount == 0 || state.Config.F != cfg.F || state.OffchainConfig.Version != cfg.OffchainConfigVersion {
+		return false
+	}
+	offchainConfig, err := state.OffchainConfig.Data()
+	if err != nil || !bytes.Equal(offchainConfig, cfg.OffchainConfig) {
+		return false
+	}
+	current, err := state.Oracles.Data()
+	if err != nil || len(current) != len(oracles) {
+		return false
+	}
+	for i, o := range oracles {
+		if !bytes.Equal(current[i].Signer.Key[:], o.Signer) || !current[i].Transmitter.Equals(o.Transmitter) || !current[i].Payee.Equals(o.Payee) {
+			return false
+		}
+	}
+	return true
+}
+
+// proposalOraclesDiffer returns whether propose_config and propose_payees need to be (re)submitted.
+// propose_config clears the proposal payees.
+func proposalOraclesDiffer(proposal ocr_2.Proposal, oracles []Oracle, f uint8) (config bool, payees bool) {
+	if proposal.F != f || proposal.Oracles.Len != uint64(len(oracles)) {
+		return true, true
+	}
+	for i, o := range oracles {
+		proposed := proposal.Oracles.Xs[i]
+		if !bytes.Equal(proposed.Signer.Key[:], o.Signer) || !proposed.Transmitter.Equals(o.Transmitter) {
+			return true, true
+		}
+		if !proposed.Payee.Equals(o.Payee) {
+			payees = true
+		}
+	}
+	return false, payees
+}
+
+// proposalDigest computes the digest of an onchain proposal
+func proposalDigest(proposal ocr_2.Proposal) []byte {
+	n := min(proposal.Oracles.Len, uint64(len(proposal.Oracles.Xs)))
+	oracles := make([]Oracle, n)
+	for i, o := range proposal.Oracles.Xs[:n] {
+		oracles[i] = Oracle{Signer: o.Signer.Key[:], Transmitter: o.Transmitter, Payee: o.Payee}
+	}
+	config := proposal.OffchainConfig.Xs[:min(proposal.OffchainConfig.Len, uint64(len(proposal.OffchainConfig.Xs)))]
+	return ProposalDigest(oracles, proposal.F, proposal.TokenMint, proposal.OffchainConfig.Version, config)
+}
@@ -0,0 +1,262 @@
+package deploy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+// All account sizes are calculated from the Rust structures, anchor zero-copy accounts are prefixed by an 8 byte discriminator
+const (
+	discriminatorLen = 8
+
+	// StoreAccountSize is the store program state: owner, proposed owner, lowering access controller
+	StoreAccountSize = discriminatorLen + 3*solana.PublicKeyLength
+	// FeedHeaderSize is the store program transmissions header (including discriminator)
+	FeedHeaderSize = discriminatorLen + 192
+	// TransmissionSize is the size of a round in the feed ring buffers
+	TransmissionSize = 48
+
+	offchainConfigSize = 8 + 4096 + 8
+	proposedOracleSize = solana.PublicKeyLength + 20 + 4 + solana.PublicKeyLength
+	oracleSize         = solana.PublicKeyLength + 20 + solana.PublicKeyLength + solana.PublicKeyLength + 4 + 8
+	configSize         = 6*solana.PublicKeyLength + 2*16 + (1 + 1 + 2 + 4 + 4 + 32) + (4 + 32 + 8) + (4 + 4)
+
+	// StateAccountSize is the OCR2 program state
+	StateAccountSize = discriminatorLen + 1 + 1 + 2 + 4 + solana.PublicKeyLength + configSize + offchainConfigSize + (oracleSize*19 + 8)
+	// ProposalAccountSize is the OCR2 program config proposal
+	ProposalAccountSize = discriminatorLen + 1 + solana.PublicKeyLength + 1 + 1 + (1 + 4) + solana.PublicKeyLength + (proposedOracleSize*19 + 8) + offchainConfigSize
+
+	// OffchainConfigChunkSize is the offchain config written per write_offchain_config transaction
+	OffchainConfigChunkSize = 1000
+
+	// confirmPollPeriod is the period between signature status checks when executing a plan
+	confirmPollPeriod = 500 * time.Millisecond
+	// confirmTimeout is the maximum time to wait for a step transaction to reach the plan commitment
+	confirmTimeout = 2 * time.Minute
+)
+
+// Client is the subset of the solana rpc client used to plan and execute deployments
+type Client interface {
+	GetAccountInfoWithOpts(ctx context.Context, account solana.PublicKey, opts *rpc.GetAccountInfoOpts) (*rpc.GetAccountInfoResult, error)
+	GetMinimumBalanceForRentExemption(ctx context.Context, dataSize uint64, commitment rpc.CommitmentType) (uint64, error)
+	GetLatestBlockhash(ctx context.Context, commitment rpc.CommitmentType) (*rpc.GetLatestBlockhashResult, error)
+	SendTransactionWithOpts(ctx context.Context, tx *solana.Transaction, opts rpc.TransactionOpts) (solana.Signature, error)
+	GetSignatureStatuses(ctx context.Context, searchTransactionHistory bool, transactionSignatures ...solana.Signature) (*rpc.GetSignatureStatusesResult, error)
+}
+
+var _ Client = (*rpc.Client)(nil)
+
+// Programs are the deployed program IDs
+type Programs struct {
+	OCR2             solana.PublicKey
+	Store            solana.PublicKey
+	AccessController solana.PublicKey
+}
+
+// Step is a set of instructions submitted in a single transaction
+type Step struct {
+	Name         string
+	Instructions []solana.Instruction
+}
+
+// Signers returns the accounts which sign the step instructions, the fee payer also signs the step transaction
+func (s Step) Signers() []solana.PublicKey {
+	var signers []solana.PublicKey
+	seen := map[solana.PublicKey]bool{}
+	for _, inst := range s.Instructions {
+		for _, meta := range inst.Accounts() {
+			if meta.IsSigner && !seen[meta.PublicKey] {
+				seen[meta.PublicKey] = true
+				signers = append(signers, meta.PublicKey)
+			}
+		}
+	}
+	return signers
+}
+
+// Plan is the ordered list of steps required to reach the desired onchain state.
+// Plans are built from the current onchain state, steps which are already applied are listed in Skipped.
+type Plan struct {
+	Steps   []Step
+	Skipped []string
+}
+
+// Empty returns true if the onchain state already matches
+func (p Plan) Empty() bool {
+	return len(p.Steps) == 0
+}
+
+// Append adds the steps of next after the steps of p
+func (p Plan) Append(next Plan) Plan {
+	return Plan{
+		Steps:   append(append([]Step{}, p.Steps...), next.Steps...),
+		Skipped: append(append([]string{}, p.Skipped...), next.Skipped...),
+	}
+}
+
+func (p Plan) String() string {
+	var sb strings.Builder
+	for i, step := range p.Steps {
+		fmt.Fprintf(&sb, "%d. %s (%d instruction(s), signers: %v)\n", i+1, step.Name, len(step.Instructions), step.Signers())
+	}
+	for _, skipped := range p.Skipped {
+		fmt.Fprintf(&sb, "-  skipped: %s\n", skipped)
+	}
+	return sb.String()
+}
+
+// Deployer plans and executes OCR2 feed deployments and configuration changes.
+// owner is the owner of the deployed accounts and payer pays for account rent and transaction fees.
+type Deployer struct {
+	client     Client
+	programs   Programs
+	owner      solana.PublicKey
+	payer      solana.PublicKey
+	commitment rpc.CommitmentType
+}
+
+func NewDeployer(client Client, programs Programs, owner, payer solana.PublicKey, commitment rpc.CommitmentType) *Deployer {
+	return &Deployer{
+		client:     client,
+		programs:   programs,
+		owner:      owner,
+		payer:      payer,
+		commitment: commitment,
+	}
+}
+
+// Execute submits each plan step in order, waiting for the step to reach the deployer commitment before
+// submitting the next. keys returns the private key of each signer, including the payer.
+func (d *Deployer) Execute(ctx context.Context, plan Plan, keys func(solana.PublicKey) *solana.PrivateKey) ([]solana.Signature, error) {
+	sigs := make([]solana.Signature, 0, len(plan.Steps))
+	for i, step := range plan.Steps {
+		sig, err := d.executeStep(ctx, step, keys)
+		if err != nil {
+			return sigs, fmt.Errorf("step %d (%s): %w", i+1, step.Name, err)
+		}
+		sigs = append(sigs, sig)
+	}
+	return sigs, nil
+}
+
+// Unsigned returns the unsigned transaction of each plan step, paid by the deployer payer, for signing offline.
+// The transactions use the latest block hash and expire if not submitted within its validity window.
+func (d *Deployer) Unsigned(ctx context.Context, plan Plan) ([]*solana.Transaction, error) {
+	blockhash, err := d.client.GetLatestBlockhash(ctx, d.commitment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest block hash: %w", err)
+	}
+	if blockhash == nil || blockhash.Value == nil {
+		return nil, errors.New("nil pointer returned from GetLatestBlockhash")
+	}
+	txs := make([]*solana.Transaction, 0, len(plan.Steps))
+	for i, step := range plan.Steps {
+		tx, err := solana.NewTransaction(step.Instructions, blockhash.Value.Blockhash, solana.TransactionPayer(d.payer))
+		if err != nil {
+			return nil, fmt.Errorf("step %d (%s): failed to create tx: %w", i+1, step.Name, err)
+		}
+		txs = append(txs, tx)
+	}
+	return txs, nil
+}
+
+func (d *Deployer) executeStep(ctx context.Context, step Step, keys func(solana.PublicKey) *solana.PrivateKey) (solana.Signature, error) {
+	blockhash, err := d.client.GetLatestBlockhash(ctx, d.commitment)
+	if err != nil {
+		return solana.Signature{}, fmt.Errorf("failed to get latest block hash: %w", err)
+	}
+	if blockhash == nil || blockhash.Value == nil {
+		return solana.Signature{}, errors.New("nil pointer returned from GetLatestBlockhash")
+	}
+	tx, err := solana.NewTransaction(step.Instructions, blockhash.Value.Blockhash, solana.TransactionPayer(d.payer))
+	if err != nil {
+		return solana.Signature{}, fmt.Errorf("failed to create tx: %w", err)
+	}
+	if _, err = tx.Sign(keys); err != nil {
+		return solana.Signature{}, fmt.Errorf("failed to sign tx: %w", err)
+	}
+	sig, err := d.client.SendTransactionWithOpts(ctx, tx, rpc.TransactionOpts{PreflightCommitment: d.commitment})
+	if err != nil {
+		return sig, fmt.Errorf("failed to send tx: %w", err)
+	}
+	return sig, d.confirm(ctx, sig)
+}
+
+// confirm waits for sig to reach the deployer commitment
+func (d *Deployer) confirm(ctx context.Context, sig solana.Signature) error {
+	ctx, cancel := context.WithTimeout(ctx, confirmTimeout)
+	defer cancel()
+	tick := time.NewTicker(confirmPollPeriod)
+	defer tick.Stop()
+	for {
+		res, err := d.client.GetSignatureStatuses(ctx, true, sig)
+		if err == nil && res != nil && len(res.Value) == 1 && res.Value[0] != nil {
+			status := res.Value[0]
+			if status.Err != nil {
+				return fmt.Errorf("tx %s failed: %v", sig, status.Err)
+			}
+			if reachedCommitment(status.ConfirmationStatus, d.commitment) {
+				return nil
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("tx %s not confirmed: %w (last error: %v)", sig, ctx.Err(), err)
+		case <-tick.C:
+		}
+	}
+}
+
+func reachedCommitment(status rpc.ConfirmationStatusType, commitment rpc.CommitmentType) bool {
+	switch commitment {
+	case rpc.CommitmentFinalized:
+		return status == rpc.ConfirmationStatusFinalized
+	case rpc.CommitmentConfirmed:
+		return status == rpc.ConfirmationStatusConfirmed || status == rpc.ConfirmationStatusFinalized
+	default:
+		return status != ""
+	}
+}
+
+// getAccount returns the account, or nil if it does not exist
+func (d *Deployer) getAccount(ctx context.Context, account solana.PublicKey) (*rpc.Account, error) {
+	res, err := d.client.GetAccountInfoWithOpts(ctx, account, &rpc.GetAccountInfoOpts{
+		Encoding:   solana.EncodingBase64,
+		Commitment: d.commitment,
+	})
+	if errors.Is(err, rpc.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account %s: %w", account, err)
+	}
+	if res == nil || res.Value == nil {
+		return nil, nil
+	}
+	return res.Value, nil
+}
+
+// createAccount returns the instruction creating a rent exempt account of size owned by program
+func (d *Deployer) createAccount(ctx context.Context, account solana.PublicKey, size uint64, program solana.PublicKey) (solana.Instruction, error) {
+	lamports, err := d.client.GetMinimumBalanceForRentExemption(ctx, size, d.commitment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rent exemption for %d bytes: %w", size, err)
+	}
+	return system.NewCreateAccountInstruction(lamports, size, program, d.payer, account).Build(), nil
+}
+
+// programInstruction rebinds inst to programID, the generated instruction builders use a package level program ID
+func programInstruction(programID solana.PublicKey, inst solana.Instruction) (solana.Instruction, error) {
+	data, err := inst.Data()
+	if err != nil {
+		return nil, err
+	}
+	return solana.NewInstruction(programID, inst.Accounts(), data), nil
+}
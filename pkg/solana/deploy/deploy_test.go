@@ -0,0 +1,476 @@
+package deploy
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"sync"
+	"testing"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-solana/contracts/generated/access_controller"
+	"github.com/smartcontractkit/chainlink-solana/contracts/generated/ocr_2"
+	"github.com/smartcontractkit/chainlink-solana/contracts/generated/store"
+	relaySol "github.com/smartcontractkit/chainlink-solana/pkg/solana"
+)
+
+// fakeClient serves accounts from memory and confirms every sent transaction
+type fakeClient struct {
+	lock     sync.Mutex
+	accounts map[solana.PublicKey]*rpc.Account
+	sent     []*solana.Transaction
+}
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{accounts: map[solana.PublicKey]*rpc.Account{}}
+}
+
+func (c *fakeClient) set(addr, owner solana.PublicKey, data []byte) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.accounts[addr] = &rpc.Account{Lamports: 1, Owner: owner, Data: rpc.DataBytesOrJSONFromBytes(data)}
+}
+
+func (c *fakeClient) GetAccountInfoWithOpts(_ context.Context, account solana.PublicKey, _ *rpc.GetAccountInfoOpts) (*rpc.GetAccountInfoResult, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	a, ok := c.accounts[account]
+	if !ok {
+		return nil, rpc.ErrNotFound
+	}
+	return &rpc.GetAccountInfoResult{Value: a}, nil
+}
+
+func (c *fakeClient) GetMinimumBalanceForRentExemption(_ context.Context, dataSize uint64, _ rpc.CommitmentType) (uint64, error) {
+	return (dataSize + 128) * 3480 * 2, nil
+}
+
+func (c *fakeClient) GetLatestBlockhash(context.Context, rpc.CommitmentType) (*rpc.GetLatestBlockhashResult, error) {
+	return &rpc.GetLatestBlockhashResult{Value: &rpc.LatestBlockhashResult{Blockhash: solana.Hash{1}}}, nil
+}
+
+func (c *fakeClient) SendTransactionWithOpts(_ context.Context, tx *solana.Transaction, _ rpc.TransactionOpts) (solana.Signature, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.sent = append(c.sent, tx)
+	return tx.Signatures[0], nil
+}
+
+func (c *fakeClient) GetSignatureStatuses(_ context.Context, _ bool, sigs ...solana.Signature) (*rpc.GetSignatureStatusesResult, error) {
+	res := &rpc.GetSignatureStatusesResult{}
+	for range sigs {
+		res.Value = append(res.Value, &rpc.SignatureStatusesResult{ConfirmationStatus: rpc.ConfirmationStatusConfirmed})
+	}
+	return res, nil
+}
+
+type testEnv struct {
+	client   *fakeClient
+	deployer *Deployer
+	programs Programs
+	owner    solana.PublicKey
+	mint     solana.PublicKey
+}
+
+func newTestEnv(t *testing.T) testEnv {
+	env := testEnv{
+		client: newFakeClient(),
+		programs: Programs{
+			OCR2:             solana.NewWallet().PublicKey(),
+			Store:            solana.NewWallet().PublicKey(),
+			AccessController: solana.NewWallet().PublicKey(),
+		},
+		owner: solana.NewWallet().PublicKey(),
+		mint:  solana.NewWallet().PublicKey(),
+	}
+	env.deployer = NewDeployer(env.client, env.programs, env.owner, env.owner, rpc.CommitmentConfirmed)
+	return env
+}
+
+func stepNames(plan Plan) []string {
+	var names []string
+	for _, step := range plan.Steps {
+		names = append(names, step.Name)
+	}
+	return names
+}
+
+func testFeedConfig() FeedConfig {
+	return FeedConfig{
+		Feed:                      solana.NewWallet().PublicKey(),
+		Description:               "ETH/USD",
+		Decimals:                  8,
+		Granularity:               30,
+		LiveLength:                1024,
+		HistoricalLength:          1024,
+		FlaggingThreshold:         1000,
+		State:                     solana.NewWallet().PublicKey(),
+		MinAnswer:                 big.NewInt(-1),
+		MaxAnswer:                 big.NewInt(1_000_000),
+		RequesterAccessController: solana.NewWallet().PublicKey(),
+		BillingAccessController:   solana.NewWallet().PublicKey(),
+	}
+}
+
+func TestDeployer_PlanFeed(t *testing.T) {
+	env := newTestEnv(t)
+	cfg := testFeedConfig()
+	cfg.TokenMint = env.mint
+
+	plan, err := env.deployer.PlanFeed(tests.Context(t), cfg)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"create feed", "create token vault", "initialize state", "set feed writer", "set feed flagging threshold"}, stepNames(plan))
+	assert.Empty(t, plan.Skipped)
+	assert.ElementsMatch(t, []solana.PublicKey{env.owner, cfg.Feed}, plan.Steps[0].Signers())
+	for _, inst := range plan.Steps[2].Instructions[1:] {
+		assert.Equal(t, env.programs.OCR2, inst.ProgramID())
+	}
+
+	// the feed exists with the writer set, only the state is missing
+	storeAuthority, err := StoreAuthority(env.programs.OCR2, cfg.State)
+	require.NoError(t, err)
+	var description [32]byte
+	copy(description[:], cfg.Description)
+	feed := store.Transmissions{
+		Version:           2,
+		Owner:             env.owner,
+		Writer:            storeAuthority,
+		Description:       description,
+		Decimals:          cfg.Decimals,
+		FlaggingThreshold: cfg.FlaggingThreshold,
+		Granularity:       cfg.Granularity,
+		LiveLength:        cfg.LiveLength,
+	}
+	buf := new(bytes.Buffer)
+	require.NoError(t, bin.NewBinEncoder(buf).Encode(feed))
+	env.client.set(cfg.Feed, env.programs.Store, buf.Bytes())
+
+	plan, err = env.deployer.PlanFeed(tests.Context(t), cfg)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"create token vault", "initialize state"}, stepNames(plan))
+	assert.Len(t, plan.Skipped, 3)
+
+	// a feed with different parameters is not reused
+	cfg.LiveLength++
+	_, err = env.deployer.PlanFeed(tests.Context(t), cfg)
+	require.ErrorContains(t, err, "exists with a different")
+
+	cfg.LiveLength = 0
+	_, err = env.deployer.PlanFeed(tests.Context(t), cfg)
+	require.ErrorContains(t, err, "invalid feed config")
+}
+
+// setState writes an OCR2 state, configured if oracles are set
+func (env testEnv) setState(t *testing.T, stateID solana.PublicKey, oracles []Oracle, f uint8, version uint64, offchainConfig []byte) {
+	state := relaySol.State{
+		AccountDiscriminator: ocr_2.StateDiscriminator,
+		Version:              1,
+	}
+	state.Config.Owner = env.owner
+	state.Config.TokenMint = env.mint
+	state.Config.TokenVault = solana.NewWallet().PublicKey()
+	state.Config.MinAnswer = bin.Int128{Lo: 1}
+	state.Config.MaxAnswer = bin.Int128{Lo: 1_000_000}
+	state.Config.F = f
+	if len(oracles) > 0 {
+		state.Config.ConfigCount = 1
+	}
+	state.OffchainConfig.Version = version
+	state.OffchainConfig.Len = uint64(copy(state.OffchainConfig.Raw[:], offchainConfig))
+	for i, o := range sortOracles(oracles) {
+		copy(state.Oracles.Raw[i].Signer.Key[:], o.Signer)
+		state.Oracles.Raw[i].Transmitter = o.Transmitter
+		state.Oracles.Raw[i].Payee = o.Payee
+	}
+	state.Oracles.Len = uint64(len(oracles))
+	buf := new(bytes.Buffer)
+	require.NoError(t, bin.NewBinEncoder(buf).Encode(state))
+	env.client.set(stateID, env.programs.OCR2, buf.Bytes())
+}
+
+func testOracles(n int) []Oracle {
+	oracles := make([]Oracle, n)
+	for i := range oracles {
+		oracles[i] = Oracle{
+			// reverse order so the proposal sorts the oracles
+			Signer:      bytes.Repeat([]byte{byte(n - i)}, 20),
+			Transmitter: solana.NewWallet().PublicKey(),
+			Payee:       solana.NewWallet().PublicKey(),
+		}
+	}
+	return oracles
+}
+
+func TestDeployer_PlanConfig(t *testing.T) {
+	env := newTestEnv(t)
+	stateID := solana.NewWallet().PublicKey()
+	current := testOracles(4)
+	env.setState(t, stateID, current, 1, 1, []byte{1})
+
+	cfg := ProposalConfig{
+		State:                 stateID,
+		Proposal:              solana.NewWallet().PublicKey(),
+		TokenReceiver:         solana.NewWallet().PublicKey(),
+		Oracles:               testOracles(7),
+		F:                     2,
+		OffchainConfigVersion: 2,
+		OffchainConfig:        bytes.Repeat([]byte{2}, OffchainConfigChunkSize+1),
+	}
+
+	t.Run("new proposal", func(t *testing.T) {
+		plan, err := env.deployer.PlanConfig(tests.Context(t), cfg)
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"create proposal",
+			"propose config",
+			"write offchain config (1/2)",
+			"write offchain config (2/2)",
+			"propose payees",
+			"finalize proposal",
+			"accept proposal",
+		}, stepNames(plan))
+
+		// payees follow the proposal oracle order (sorted by signer)
+		sorted := sortOracles(cfg.Oracles)
+		payees := plan.Steps[4].Instructions[0].Accounts()[2:]
+		require.Len(t, payees, len(sorted))
+		for i, o := range sorted {
+			assert.Equal(t, o.Payee, payees[i].PublicKey)
+		}
+
+		// accept pays out the current oracles and uses the proposal digest
+		accept := plan.Steps[6].Instructions[0]
+		remaining := accept.Accounts()[8:]
+		require.Len(t, remaining, len(current))
+		for _, meta := range remaining {
+			assert.True(t, meta.IsWritable)
+		}
+		data, err := accept.Data()
+		require.NoError(t, err)
+		digest := ProposalDigest(sorted, cfg.F, env.mint, cfg.OffchainConfigVersion, cfg.OffchainConfig)
+		assert.True(t, bytes.HasSuffix(data, digest))
+	})
+
+	t.Run("resume proposal", func(t *testing.T) {
+		sorted := sortOracles(cfg.Oracles)
+		proposal := ocr_2.Proposal{Version: 1, Owner: env.owner, F: cfg.F}
+		for i, o := range sorted {
+			copy(proposal.Oracles.Xs[i].Signer.Key[:], o.Signer)
+			proposal.Oracles.Xs[i].Transmitter = o.Transmitter
+		}
+		proposal.Oracles.Len = uint64(len(sorted))
+		proposal.OffchainConfig.Version = cfg.OffchainConfigVersion
+		proposal.OffchainConfig.Len = uint64(copy(proposal.OffchainConfig.Xs[:], cfg.OffchainConfig[:OffchainConfigChunkSize]))
+		buf := new(bytes.Buffer)
+		require.NoError(t, bin.NewBinEncoder(buf).Encode(proposal))
+		env.client.set(cfg.Proposal, env.programs.OCR2, buf.Bytes())
+		defer delete(env.client.accounts, cfg.Proposal)
+
+		plan, err := env.deployer.PlanConfig(tests.Context(t), cfg)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"write offchain config (1/1)", "propose payees", "finalize proposal", "accept proposal"}, stepNames(plan))
+
+		// a proposal with a different offchain config can't be resumed
+		other := cfg
+		other.OffchainConfig = bytes.Repeat([]byte{3}, 10)
+		_, err = env.deployer.PlanConfig(tests.Context(t), other)
+		require.ErrorContains(t, err, "different offchain config")
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		invalid := cfg
+		invalid.F = 3
+		_, err := env.deployer.PlanConfig(tests.Context(t), invalid)
+		require.ErrorContains(t, err, "invalid proposal config")
+	})
+
+	t.Run("config up to date", func(t *testing.T) {
+		env.setState(t, stateID, cfg.Oracles, cfg.F, cfg.OffchainConfigVersion, cfg.OffchainConfig)
+		plan, err := env.deployer.PlanConfig(tests.Context(t), cfg)
+		require.NoError(t, err)
+		assert.True(t, plan.Empty())
+		assert.Len(t, plan.Skipped, 1)
+	})
+}
+
+func TestDeployer_PlanBilling(t *testing.T) {
+	env := newTestEnv(t)
+	stateID := solana.NewWallet().PublicKey()
+	env.setState(t, stateID, testOracles(4), 1, 1, []byte{1})
+
+	plan, err := env.deployer.PlanBilling(tests.Context(t), stateID, Billing{ObservationPaymentGjuels: 1, TransmissionPaymentGjuels: 2}, solana.NewWallet().PublicKey())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"set billing"}, stepNames(plan))
+	assert.Len(t, plan.Steps[0].Instructions[0].Accounts(), 7+4)
+
+	plan, err = env.deployer.PlanBilling(tests.Context(t), stateID, Billing{}, solana.PublicKey{})
+	require.NoError(t, err)
+	assert.True(t, plan.Empty())
+}
+
+func TestDeployer_PlanOwnership(t *testing.T) {
+	env := newTestEnv(t)
+	proposed := solana.NewWallet().PublicKey()
+
+	feedID := solana.NewWallet().PublicKey()
+	feed := store.Transmissions{Version: 2, Owner: env.owner}
+	buf := new(bytes.Buffer)
+	require.NoError(t, bin.NewBinEncoder(buf).Encode(feed))
+	env.client.set(feedID, env.programs.Store, buf.Bytes())
+
+	plan, err := env.deployer.PlanTransferOwnership(tests.Context(t), feedID, proposed)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"transfer feed ownership"}, stepNames(plan))
+	assert.Equal(t, env.programs.Store, plan.Steps[0].Instructions[0].ProgramID())
+
+	// the proposed owner accepts
+	feed.ProposedOwner = proposed
+	buf.Reset()
+	require.NoError(t, bin.NewBinEncoder(buf).Encode(feed))
+	env.client.set(feedID, env.programs.Store, buf.Bytes())
+
+	plan, err = env.deployer.PlanTransferOwnership(tests.Context(t), feedID, proposed)
+	require.NoError(t, err)
+	assert.True(t, plan.Empty())
+
+	other := NewDeployer(env.client, env.programs, solana.NewWallet().PublicKey(), env.owner, rpc.CommitmentConfirmed)
+	_, err = other.PlanAcceptOwnership(tests.Context(t), feedID)
+	require.ErrorContains(t, err, "proposed owner is")
+
+	proposer := NewDeployer(env.client, env.programs, proposed, proposed, rpc.CommitmentConfirmed)
+	plan, err = proposer.PlanAcceptOwnership(tests.Context(t), feedID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"accept feed ownership"}, stepNames(plan))
+	assert.Equal(t, []solana.PublicKey{proposed}, plan.Steps[0].Signers())
+
+	stateID := solana.NewWallet().PublicKey()
+	env.setState(t, stateID, nil, 0, 0, nil)
+	plan, err = env.deployer.PlanTransferOwnership(tests.Context(t), stateID, proposed)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"transfer ocr2-state ownership"}, stepNames(plan))
+	assert.Equal(t, env.programs.OCR2, plan.Steps[0].Instructions[0].ProgramID())
+
+	// accounts of other programs are rejected
+	unknown := solana.NewWallet().PublicKey()
+	env.client.set(unknown, solana.NewWallet().PublicKey(), buf.Bytes())
+	_, err = env.deployer.PlanTransferOwnership(tests.Context(t), unknown, proposed)
+	require.ErrorContains(t, err, "is not an OCR2 state")
+}
+
+func TestDeployer_PlanAccess(t *testing.T) {
+	env := newTestEnv(t)
+	controllerID := solana.NewWallet().PublicKey()
+	address := solana.NewWallet().PublicKey()
+
+	controller := access_controller.AccessController{Owner: env.owner}
+	controller.AccessList.Xs[0] = address
+	controller.AccessList.Len = 1
+	buf := new(bytes.Buffer)
+	require.NoError(t, bin.NewBinEncoder(buf).Encode(controller))
+	env.client.set(controllerID, env.programs.AccessController, buf.Bytes())
+
+	plan, err := env.deployer.PlanAccess(tests.Context(t), controllerID, address, true)
+	require.NoError(t, err)
+	assert.True(t, plan.Empty())
+
+	plan, err = env.deployer.PlanAccess(tests.Context(t), controllerID, address, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"remove access"}, stepNames(plan))
+
+	plan, err = env.deployer.PlanAccess(tests.Context(t), controllerID, solana.NewWallet().PublicKey(), true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"add access"}, stepNames(plan))
+	assert.Equal(t, env.programs.AccessController, plan.Steps[0].Instructions[0].ProgramID())
+
+	stateID := solana.NewWallet().PublicKey()
+	env.setState(t, stateID, nil, 0, 0, nil)
+	plan, err = env.deployer.PlanSetAccessController(tests.Context(t), stateID, RoleBilling, controllerID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"set billing access controller"}, stepNames(plan))
+
+	_, err = env.deployer.PlanSetAccessController(tests.Context(t), stateID, "unknown", controllerID)
+	require.ErrorContains(t, err, "unknown access controller role")
+}
+
+func TestDeployer_Execute(t *testing.T) {
+	env := newTestEnv(t)
+	owner := solana.NewWallet()
+	env.deployer = NewDeployer(env.client, env.programs, owner.PublicKey(), owner.PublicKey(), rpc.CommitmentConfirmed)
+	feed := solana.NewWallet()
+	cfg := testFeedConfig()
+	cfg.Feed = feed.PublicKey()
+	cfg.TokenMint = env.mint
+
+	plan, err := env.deployer.PlanFeed(tests.Context(t), cfg)
+	require.NoError(t, err)
+
+	// signing fails if a key is missing
+	_, err = env.deployer.Execute(tests.Context(t), plan, func(key solana.PublicKey) *solana.PrivateKey {
+		if key.Equals(owner.PublicKey()) {
+			return &owner.PrivateKey
+		}
+		return nil
+	})
+	require.ErrorContains(t, err, "step 1 (create feed)")
+	require.Empty(t, env.client.sent)
+
+	state := solana.NewWallet()
+	cfg.State = state.PublicKey()
+	plan, err = env.deployer.PlanFeed(tests.Context(t), cfg)
+	require.NoError(t, err)
+	keys := map[solana.PublicKey]*solana.PrivateKey{
+		owner.PublicKey(): &owner.PrivateKey,
+		feed.PublicKey():  &feed.PrivateKey,
+		state.PublicKey(): &state.PrivateKey,
+	}
+	sigs, err := env.deployer.Execute(tests.Context(t), plan, func(key solana.PublicKey) *solana.PrivateKey {
+		return keys[key]
+	})
+	require.NoError(t, err)
+	require.Len(t, sigs, len(plan.Steps))
+	require.Len(t, env.client.sent, len(plan.Steps))
+	for i, tx := range env.client.sent {
+		assert.Equal(t, owner.PublicKey(), tx.Message.AccountKeys[0])
+		assert.Equal(t, sigs[i], tx.Signatures[0])
+	}
+}
+
+func TestDeployer_Unsigned(t *testing.T) {
+	env := newTestEnv(t)
+	cfg := testFeedConfig()
+	cfg.TokenMint = env.mint
+
+	plan, err := env.deployer.PlanFeed(tests.Context(t), cfg)
+	require.NoError(t, err)
+	txs, err := env.deployer.Unsigned(tests.Context(t), plan)
+	require.NoError(t, err)
+	require.Len(t, txs, len(plan.Steps))
+	for _, tx := range txs {
+		assert.Empty(t, tx.Signatures)
+		assert.Equal(t, env.owner, tx.Message.AccountKeys[0])
+	}
+	assert.Empty(t, env.client.sent)
+}
+
+func TestToInt128(t *testing.T) {
+	for _, v := range []*big.Int{
+		big.NewInt(0),
+		big.NewInt(-1),
+		big.NewInt(1_000_000),
+		new(big.Int).Lsh(big.NewInt(1), 100),
+		new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 100)),
+	} {
+		i, err := toInt128(v)
+		require.NoError(t, err)
+		assert.Equal(t, v.String(), i.BigInt().String())
+	}
+	_, err := toInt128(new(big.Int).Lsh(big.NewInt(1), 127))
+	require.Error(t, err)
+}
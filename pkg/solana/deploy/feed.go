@@ -0,0 +1,295 @@
+package deploy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	associatedtokenaccount "github.com/gagliardetto/solana-go/programs/associated-token-account"
+	"github.com/gagliardetto/solana-go/rpc"
+
+	"github.com/smartcontractkit/chainlink-solana/contracts/generated/ocr_2"
+	"github.com/smartcontractkit/chainlink-solana/contracts/generated/store"
+	relaySol "github.com/smartcontractkit/chainlink-solana/pkg/solana"
+)
+
+// FeedConfig is an OCR2 feed: the store program feed (transmissions account) and the OCR2 state writing to it.
+// Accounts which do not exist yet are created with the account address as signer.
+type FeedConfig struct {
+	// Store is an optional store program state account owning the lowering access controller
+	Store                    solana.PublicKey
+	LoweringAccessController solana.PublicKey
+
+	Feed             solana.PublicKey
+	Description      string
+	Decimals         uint8
+	Granularity      uint8
+	LiveLength       uint32
+	HistoricalLength uint32
+	// FlaggingThreshold is set as the feed validator config if non-zero
+	FlaggingThreshold uint32
+
+	State                     solana.PublicKey
+	MinAnswer                 *big.Int
+	MaxAnswer                 *big.Int
+	TokenMint                 solana.PublicKey
+	RequesterAccessController solana.PublicKey
+	BillingAccessController   solana.PublicKey
+}
+
+func (c FeedConfig) validate() (err error) {
+	if c.Feed.IsZero() {
+		err = errors.Join(err, errors.New("Feed is required"))
+	}
+	if c.State.IsZero() {
+		err = errors.Join(err, errors.New("State is required"))
+	}
+	if c.TokenMint.IsZero() {
+		err = errors.Join(err, errors.New("TokenMint is required"))
+	}
+	if !c.Store.IsZero() && c.LoweringAccessController.IsZero() {
+		err = errors.Join(err, errors.New("LoweringAccessController is required to create a store"))
+	}
+	if len(c.Description) > 32 {
+		err = errors.Join(err, fmt.Errorf("Description exceeds 32 bytes: %q", c.Description))
+	}
+	if c.LiveLength == 0 || c.Granularity == 0 {
+		err = errors.Join(err, fmt.Errorf("LiveLength (%d) and Granularity (%d) must be greater than 0", c.LiveLength, c.Granularity))
+	}
+	if c.MinAnswer == nil || c.MaxAnswer == nil {
+		err = errors.Join(err, errors.New("MinAnswer and MaxAnswer are required"))
+	} else if c.MinAnswer.Cmp(c.MaxAnswer) > 0 {
+		err = errors.Join(err, fmt.Errorf("MinAnswer (%s) exceeds MaxAnswer (%s)", c.MinAnswer, c.MaxAnswer))
+	}
+	return err
+}
+
+// FeedSize returns the feed account size for the ring buffer lengths
+func FeedSize(liveLength, historicalLength uint32) uint64 {
+	return FeedHeaderSize + (uint64(liveLength)+uint64(historicalLength))*TransmissionSize
+}
+
+// VaultAuthority returns the OCR2 program PDA owning the state token vault
+func VaultAuthority(ocr2Program, state solana.PublicKey) (solana.PublicKey, error) {
+	addr, _, err := solana.FindProgramAddress([][]byte{[]byte("vault"), state.Bytes()}, ocr2Program)
+	return addr, err
+}
+
+// StoreAuthority returns the OCR2 program PDA writing to the state feed
+func StoreAuthority(ocr2Program, state solana.PublicKey) (solana.PublicKey, error) {
+	addr, _, err := solana.FindProgramAddress([][]byte{[]byte("store"), state.Bytes()}, ocr2Program)
+	return addr, err
+}
+
+// PlanFeed returns the steps to deploy the feed. Existing accounts are checked against cfg and reused.
+func (d *Deployer) PlanFeed(ctx context.Context, cfg FeedConfig) (Plan, error) {
+	if err := cfg.validate(); err != nil {
+		return Plan{}, fmt.Errorf("invalid feed config: %w", err)
+	}
+	var plan Plan
+
+	if !cfg.Store.IsZero() {
+		storeStep, err := d.planStore(ctx, cfg)
+		if err != nil {
+			return Plan{}, err
+		}
+		plan = plan.Append(storeStep)
+	}
+
+	feedPlan, header, err := d.planFeedAccount(ctx, cfg)
+	if err != nil {
+		return Plan{}, err
+	}
+	plan = plan.Append(feedPlan)
+
+	statePlan, err := d.planState(ctx, cfg)
+	if err != nil {
+		return Plan{}, err
+	}
+	plan = plan.Append(statePlan)
+
+	// the OCR2 program writes to the feed through the store authority
+	storeAuthority, err := StoreAuthority(d.programs.OCR2, cfg.State)
+	if err != nil {
+		return Plan{}, fmt.Errorf("failed to derive store authority: %w", err)
+	}
+	feedOwner := d.owner
+	if header != nil {
+		feedOwner = header.Owner
+	}
+	if header == nil || !header.Writer.Equals(storeAuthority) {
+		inst, err := programInstruction(d.programs.Store, store.NewSetWriterInstruction(storeAuthority, cfg.Feed, feedOwner, d.owner).Build())
+		if err != nil {
+			return Plan{}, err
+		}
+		plan.Steps = append(plan.Steps, Step{Name: "set feed writer", Instructions: []solana.Instruction{inst}})
+	} else {
+		plan.Skipped = append(plan.Skipped, fmt.Sprintf("feed writer is %s", storeAuthority))
+	}
+
+	if cfg.FlaggingThreshold != 0 {
+		if header == nil || header.FlaggingThreshold != cfg.FlaggingThreshold {
+			inst, err := programInstruction(d.programs.Store, store.NewSetValidatorConfigInstruction(cfg.FlaggingThreshold, cfg.Feed, feedOwner, d.owner).Build())
+			if err != nil {
+				return Plan{}, err
+			}
+			plan.Steps = append(plan.Steps, Step{Name: "set feed flagging threshold", Instructions: []solana.Instruction{inst}})
+		} else {
+			plan.Skipped = append(plan.Skipped, fmt.Sprintf("feed flagging threshold is %d", cfg.FlaggingThreshold))
+		}
+	}
+	return plan, nil
+}
+
+func (d *Deployer) planStore(ctx context.Context, cfg FeedConfig) (Plan, error) {
+	account, err := d.getAccount(ctx, cfg.Store)
+	if err != nil {
+		return Plan{}, err
+	}
+	if account != nil {
+		if !account.Owner.Equals(d.programs.Store) {
+			return Plan{}, fmt.Errorf("store %s is owned by %s, expected the store program %s", cfg.Store, account.Owner, d.programs.Store)
+		}
+		return Plan{Skipped: []string{fmt.Sprintf("store %s exists", cfg.Store)}}, nil
+	}
+	create, err := d.createAccount(ctx, cfg.Store, StoreAccountSize, d.programs.Store)
+	if err != nil {
+		return Plan{}, err
+	}
+	initialize, err := programInstruction(d.programs.Store, store.NewInitializeInstruction(cfg.Store, d.owner, cfg.LoweringAccessController).Build())
+	if err != nil {
+		return Plan{}, err
+	}
+	return Plan{Steps: []Step{{Name: "create store", Instructions: []solana.Instruction{create, initialize}}}}, nil
+}
+
+// planFeedAccount returns the steps to create the feed, and the feed header if it exists
+func (d *Deployer) planFeedAccount(ctx context.Context, cfg FeedConfig) (Plan, *store.Transmissions, error) {
+	account, err := d.getAccount(ctx, cfg.Feed)
+	if err != nil {
+		return Plan{}, nil, err
+	}
+	if account != nil {
+		if !account.Owner.Equals(d.programs.Store) {
+			return Plan{}, nil, fmt.Errorf("feed %s is owned by %s, expected the store program %s", cfg.Feed, account.Owner, d.programs.Store)
+		}
+		var header store.Transmissions
+		if err = bin.NewBinDecoder(account.Data.GetBinary()).Decode(&header); err != nil {
+			return Plan{}, nil, fmt.Errorf("failed to decode feed %s: %w", cfg.Feed, err)
+		}
+		var description [32]byte
+		copy(description[:], cfg.Description)
+		if header.Description != description || header.Decimals != cfg.Decimals || header.Granularity != cfg.Granularity || header.LiveLength != cfg.LiveLength {
+			return Plan{}, nil, fmt.Errorf("feed %s exists with a different description, decimals, granularity, or live length", cfg.Feed)
+		}
+		return Plan{Skipped: []string{fmt.Sprintf("feed %s exists", cfg.Feed)}}, &header, nil
+	}
+
+	create, err := d.createAccount(ctx, cfg.Feed, FeedSize(cfg.LiveLength, cfg.HistoricalLength), d.programs.Store)
+	if err != nil {
+		return Plan{}, nil, err
+	}
+	createFeed, err := programInstruction(d.programs.Store, store.NewCreateFeedInstruction(cfg.Description, cfg.Decimals, cfg.Granularity, cfg.LiveLength, cfg.Feed, d.owner).Build())
+	if err != nil {
+		return Plan{}, nil, err
+	}
+	return Plan{Steps: []Step{{Name: "create feed", Instructions: []solana.Instruction{create, createFeed}}}}, nil, nil
+}
+
+func (d *Deployer) planState(ctx context.Context, cfg FeedConfig) (Plan, error) {
+	var plan Plan
+	vaultAuthority, err := VaultAuthority(d.programs.OCR2, cfg.State)
+	if err != nil {
+		return Plan{}, fmt.Errorf("failed to derive vault authority: %w", err)
+	}
+	tokenVault, _, err := solana.FindAssociatedTokenAddress(vaultAuthority, cfg.TokenMint)
+	if err != nil {
+		return Plan{}, fmt.Errorf("failed to derive token vault: %w", err)
+	}
+
+	state, _, err := relaySol.GetState(ctx, d.client, cfg.State, d.commitment)
+	switch {
+	case errors.Is(err, rpc.ErrNotFound):
+	case err != nil:
+		return Plan{}, err
+	default:
+		if !state.Transmissions.Equals(cfg.Feed) || !state.Config.TokenMint.Equals(cfg.TokenMint) {
+			return Plan{}, fmt.Errorf("state %s exists with feed %s and token mint %s", cfg.State, state.Transmissions, state.Config.TokenMint)
+		}
+		plan.Skipped = append(plan.Skipped, fmt.Sprintf("state %s exists", cfg.State))
+		if !state.Config.RequesterAccessController.Equals(cfg.RequesterAccessController) {
+			inst, err := programInstruction(d.programs.OCR2, ocr_2.NewSetRequesterAccessControllerInstruction(cfg.State, d.owner, cfg.RequesterAccessController).Build())
+			if err != nil {
+				return Plan{}, err
+			}
+			plan.Steps = append(plan.Steps, Step{Name: "set requester access controller", Instructions: []solana.Instruction{inst}})
+		}
+		if !state.Config.BillingAccessController.Equals(cfg.BillingAccessController) {
+			inst, err := programInstruction(d.programs.OCR2, ocr_2.NewSetBillingAccessControllerInstruction(cfg.State, d.owner, cfg.BillingAccessController).Build())
+			if err != nil {
+				return Plan{}, err
+			}
+			plan.Steps = append(plan.Steps, Step{Name: "set billing access controller", Instructions: []solana.Instruction{inst}})
+		}
+		return plan, nil
+	}
+
+	vault, err := d.getAccount(ctx, tokenVault)
+	if err != nil {
+		return Plan{}, err
+	}
+	if vault == nil {
+		plan.Steps = append(plan.Steps, Step{
+			Name:         "create token vault",
+			Instructions: []solana.Instruction{associatedtokenaccount.NewCreateInstruction(d.payer, vaultAuthority, cfg.TokenMint).Build()},
+		})
+	} else {
+		plan.Skipped = append(plan.Skipped, fmt.Sprintf("token vault %s exists", tokenVault))
+	}
+
+	minAnswer, err := toInt128(cfg.MinAnswer)
+	if err != nil {
+		return Plan{}, fmt.Errorf("invalid MinAnswer: %w", err)
+	}
+	maxAnswer, err := toInt128(cfg.MaxAnswer)
+	if err != nil {
+		return Plan{}, fmt.Errorf("invalid MaxAnswer: %w", err)
+	}
+	create, err := d.createAccount(ctx, cfg.State, StateAccountSize, d.programs.OCR2)
+	if err != nil {
+		return Plan{}, err
+	}
+	initialize, err := programInstruction(d.programs.OCR2, ocr_2.NewInitializeInstruction(
+		minAnswer,
+		maxAnswer,
+		cfg.State,
+		cfg.Feed,
+		d.owner,
+		cfg.TokenMint,
+		tokenVault,
+		vaultAuthority,
+		cfg.RequesterAccessController,
+		cfg.BillingAccessController,
+	).Build())
+	if err != nil {
+		return Plan{}, err
+	}
+	plan.Steps = append(plan.Steps, Step{Name: "initialize state", Instructions: []solana.Instruction{create, initialize}})
+	return plan, nil
+}
+
+// toInt128 converts v to a two's complement i128
+func toInt128(v *big.Int) (bin.Int128, error) {
+	if v.BitLen() > 127 {
+		return bin.Int128{}, fmt.Errorf("%s overflows i128", v)
+	}
+	u := new(big.Int).Set(v)
+	if u.Sign() < 0 {
+		u.Add(u, new(big.Int).Lsh(big.NewInt(1), 128))
+	}
+	lo := new(big.Int).And(u, new(big.Int).SetUint64(^uint64(0)))
+	return bin.Int128{Lo: lo.Uint64(), Hi: new(big.Int).Rsh(u, 64).Uint64()}, nil
+}
@@ -0,0 +1,229 @@
+package solana
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils"
+
+	"github.com/smartcontractkit/chainlink-solana/contracts/generated/store"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/client"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
+)
+
+const (
+	// flaggingThresholdMultiplier is the store program THRESHOLD_MULTIPLIER, thresholds are in 1/100000 of the previous answer
+	flaggingThresholdMultiplier = 100_000
+	// lowerFlagRetryPeriod is the minimum time between lower_flag submissions for a feed
+	lowerFlagRetryPeriod = time.Minute
+)
+
+// FlagLowerer polls a store program feed and submits lower_flag once the feed has been flagged and the latest
+// healthyRounds rounds after the flag was observed are all within the feed flagging threshold.
+// authority must be the store owner or have access on the store lowering access controller.
+type FlagLowerer struct {
+	services.StateMachine
+
+	feed, storeProgramID, authority solana.PublicKey
+	healthyRounds                   uint32
+
+	reader     client.Reader
+	feedReader *StoreFeedReader
+	txManager  TxManager
+	cfg        config.Config
+	lggr       logger.Logger
+
+	lock          sync.Mutex
+	flaggedRound  uint32 // latest round when the flag was first observed, 0 if the feed is not flagged
+	lastSubmitted time.Time
+
+	done   chan struct{}
+	stopCh services.StopChan
+}
+
+func NewFlagLowerer(feed, storeProgramID, authority solana.PublicKey, healthyRounds uint32, cfg config.Config, reader client.Reader, txManager TxManager, lggr logger.Logger) *FlagLowerer {
+	return &FlagLowerer{
+		feed:           feed,
+		storeProgramID: storeProgramID,
+		authority:      authority,
+		healthyRounds:  healthyRounds,
+		reader:         reader,
+		feedReader:     NewStoreFeedReader(reader, feed, cfg.Commitment()),
+		txManager:      txManager,
+		cfg:            cfg,
+		lggr:           logger.Named(lggr, "FlagLowerer"),
+	}
+}
+
+func (f *FlagLowerer) Name() string {
+	return f.lggr.Name()
+}
+
+func (f *FlagLowerer) Start(context.Context) error {
+	return f.StartOnce("FlagLowerer", func() error {
+		f.done = make(chan struct{})
+		f.stopCh = make(chan struct{})
+		go f.poll()
+		return nil
+	})
+}
+
+func (f *FlagLowerer) Close() error {
+	return f.StopOnce("FlagLowerer", func() error {
+		close(f.stopCh)
+		<-f.done
+		return nil
+	})
+}
+
+func (f *FlagLowerer) HealthReport() map[string]error {
+	return map[string]error{f.Name(): f.Healthy()}
+}
+
+func (f *FlagLowerer) poll() {
+	defer close(f.done)
+	ctx, cancel := f.stopCh.NewCtx()
+	defer cancel()
+	tick := time.After(0)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-tick:
+			if err := f.check(ctx); err != nil {
+				f.lggr.Errorw("Failed to check feed flag", "feed", f.feed, "err", err)
+			}
+			tick = time.After(utils.WithJitter(f.cfg.OCR2CachePollPeriod()))
+		}
+	}
+}
+
+// check submits lower_flag if the feed is flagged and the healthy rounds policy is satisfied
+func (f *FlagLowerer) check(ctx context.Context) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	header, _, err := f.feedReader.GetHeader(ctx)
+	if err != nil {
+		return err
+	}
+	if header.State != FeedStateFlagged {
+		if f.flaggedRound != 0 {
+			f.lggr.Infow("Feed flag lowered", "feed", f.feed, "round", header.LatestRoundID)
+		}
+		f.flaggedRound = 0
+		return nil
+	}
+	if f.flaggedRound == 0 {
+		f.flaggedRound = max(header.LatestRoundID, 1)
+		f.lggr.Warnw("Feed flagged", "feed", f.feed, "round", header.LatestRoundID, "flaggingThreshold", header.FlaggingThreshold)
+	}
+	if time.Since(f.lastSubmitted) < lowerFlagRetryPeriod {
+		return nil
+	}
+
+	rounds, err := f.feedReader.GetLatestRounds(ctx, int(f.healthyRounds)+1)
+	if err != nil {
+		return err
+	}
+	if !roundsHealthy(rounds, f.flaggedRound, f.healthyRounds, header.FlaggingThreshold) {
+		return nil
+	}
+
+	tx, err := f.lowerFlagTx(ctx, header)
+	if err != nil {
+		return err
+	}
+	f.lggr.Infow("Submitting lower_flag", "feed", f.feed, "flaggedRound", f.flaggedRound, "round", header.LatestRoundID)
+	if err = f.txManager.Enqueue(ctx, f.feed.String(), tx, nil); err != nil {
+		return fmt.Errorf("failed to enqueue lower_flag: %w", err)
+	}
+	f.lastSubmitted = time.Now()
+	return nil
+}
+
+// roundsHealthy returns true if the last n rounds are after the flagged round and within the flagging threshold
+func roundsHealthy(rounds []Round, flaggedRound, n uint32, flaggingThreshold uint32) bool {
+	if uint32(len(rounds)) != n+1 || rounds[1].RoundID <= flaggedRound {
+		return false
+	}
+	for i := 1; i < len(rounds); i++ {
+		if !withinFlaggingThreshold(flaggingThreshold, rounds[i-1].Answer, rounds[i].Answer) {
+			return false
+		}
+	}
+	return true
+}
+
+// withinFlaggingThreshold mirrors the store program is_valid check used to raise the flag
+func withinFlaggingThreshold(flaggingThreshold uint32, previous, answer *big.Int) bool {
+	if previous.Sign() == 0 {
+		return true
+	}
+	change := new(big.Int).Sub(previous, answer)
+	change.Abs(change).Mul(change, big.NewInt(flaggingThresholdMultiplier))
+	if change.BitLen() > 128 { // u128 overflow is invalid onchain
+		return false
+	}
+	ratio := change.Quo(change, new(big.Int).Abs(previous))
+	return ratio.Cmp(big.NewInt(int64(flaggingThreshold))) <= 0
+}
+
+func (f *FlagLowerer) lowerFlagTx(ctx context.Context, header TransmissionsHeader) (*solana.Transaction, error) {
+	accessController, err := f.loweringAccessController(ctx, header.Owner)
+	if err != nil {
+		return nil, err
+	}
+	blockhash, err := f.reader.LatestBlockhash(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest block hash: %w", err)
+	}
+	if blockhash == nil || blockhash.Value == nil {
+		return nil, errors.New("nil pointer returned from LatestBlockhash")
+	}
+	accounts := store.NewLowerFlagInstruction(f.feed, header.Owner, f.authority, accessController).AccountMetaSlice
+	tx, err := solana.NewTransaction(
+		[]solana.Instruction{
+			solana.NewInstruction(f.storeProgramID, accounts, store.Instruction_LowerFlag[:]),
+		},
+		blockhash.Value.Blockhash,
+		solana.TransactionPayer(f.authority),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create lower_flag tx: %w", err)
+	}
+	return tx, nil
+}
+
+// loweringAccessController returns the lowering access controller of the store owning the feed.
+// Feeds owned by an individual key do not use an access controller, the owner is passed instead.
+func (f *FlagLowerer) loweringAccessController(ctx context.Context, owner solana.PublicKey) (solana.PublicKey, error) {
+	res, err := f.reader.GetAccountInfoWithOpts(ctx, owner, &rpc.GetAccountInfoOpts{
+		Encoding:   "base64",
+		Commitment: f.cfg.Commitment(),
+	})
+	if errors.Is(err, rpc.ErrNotFound) {
+		return owner, nil
+	}
+	if err != nil {
+		return solana.PublicKey{}, fmt.Errorf("failed to get feed owner: %w", err)
+	}
+	if res == nil || res.Value == nil || !res.Value.Owner.Equals(f.storeProgramID) {
+		return owner, nil
+	}
+	var s store.Store
+	if err = bin.NewBinDecoder(res.Value.Data.GetBinary()).Decode(&s); err != nil {
+		return solana.PublicKey{}, fmt.Errorf("failed to decode store: %w", err)
+	}
+	return s.LoweringAccessController, nil
+}
@@ -0,0 +1,100 @@
+package solana
+
+import (
+	"context"
+	"encoding/binary"
+	"math/big"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-solana/contracts/generated/store"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/client/mocks"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/txm"
+)
+
+// flagFeed sets the feed state and flagging threshold in feed account data built by testFeed
+func flagFeed(data []byte, state uint8, flaggingThreshold uint32) []byte {
+	data[AccountDiscriminatorLen+1] = state
+	binary.LittleEndian.PutUint32(data[AccountDiscriminatorLen+1+1+32*4+1:], flaggingThreshold)
+	return data
+}
+
+type enqueuedTxs []*solana.Transaction
+
+func (e *enqueuedTxs) Enqueue(_ context.Context, _ string, tx *solana.Transaction, _ *string, _ ...txm.SetTxConfig) error {
+	*e = append(*e, tx)
+	return nil
+}
+
+func (e *enqueuedTxs) EnqueueSigned(_ context.Context, _ string, tx *solana.Transaction, _ *string) error {
+	*e = append(*e, tx)
+	return nil
+}
+
+func TestFlagLowerer(t *testing.T) {
+	ctx := tests.Context(t)
+	feed, storeProgramID, authority := solana.PublicKey{1}, solana.PublicKey{2}, solana.PublicKey{3}
+
+	data := flagFeed(testFeed(t, 4, 0, 1, 3), FeedStateFlagged, 100_000)
+	rw := mocks.NewReaderWriter(t)
+	mockFeedReadsFunc(rw, feed, func() []byte { return data })
+	var txs enqueuedTxs
+	lowerer := NewFlagLowerer(feed, storeProgramID, authority, 2, config.NewDefault(), rw, &txs, logger.Test(t))
+
+	header, _, err := lowerer.feedReader.GetHeader(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, FeedStateFlagged, header.State)
+	assert.Equal(t, uint32(100_000), header.FlaggingThreshold)
+
+	// no rounds since the flag was observed
+	require.NoError(t, lowerer.check(ctx))
+	assert.Equal(t, uint32(3), lowerer.flaggedRound)
+	assert.Empty(t, txs)
+
+	// two healthy rounds after the flag
+	data = flagFeed(testFeed(t, 4, 0, 1, 5), FeedStateFlagged, 100_000)
+	rw.On("GetAccountInfoWithOpts", mock.Anything, solana.PublicKey{}, mock.Anything).Return(nil, rpc.ErrNotFound).Once()
+	rw.On("LatestBlockhash", mock.Anything).Return(&rpc.GetLatestBlockhashResult{Value: &rpc.LatestBlockhashResult{}}, nil).Once()
+	require.NoError(t, lowerer.check(ctx))
+	require.Len(t, txs, 1)
+	ix := txs[0].Message.Instructions[0]
+	assert.Equal(t, storeProgramID, txs[0].Message.AccountKeys[ix.ProgramIDIndex])
+	assert.Equal(t, store.Instruction_LowerFlag[:], []byte(ix.Data))
+	accounts, err := ix.ResolveInstructionAccounts(&txs[0].Message)
+	require.NoError(t, err)
+	assert.Equal(t, []solana.PublicKey{feed, {}, authority, {}}, []solana.PublicKey{
+		accounts[0].PublicKey, accounts[1].PublicKey, accounts[2].PublicKey, accounts[3].PublicKey,
+	})
+
+	// not resubmitted while the retry period has not passed
+	require.NoError(t, lowerer.check(ctx))
+	assert.Len(t, txs, 1)
+
+	// flag lowered
+	data = flagFeed(testFeed(t, 4, 0, 1, 5), FeedStateNormal, 100_000)
+	require.NoError(t, lowerer.check(ctx))
+	assert.Zero(t, lowerer.flaggedRound)
+}
+
+func TestRoundsHealthy(t *testing.T) {
+	round := func(id uint32, answer int64) Round {
+		return Round{RoundID: id, Answer: big.NewInt(answer)}
+	}
+	// threshold of 10%
+	assert.True(t, roundsHealthy([]Round{round(4, 100), round(5, 110), round(6, 100)}, 4, 2, 10_000))
+	assert.False(t, roundsHealthy([]Round{round(4, 100), round(5, 111), round(6, 100)}, 4, 2, 10_000))
+	assert.False(t, roundsHealthy([]Round{round(4, 100), round(5, 110), round(6, 100)}, 5, 2, 10_000))
+	assert.False(t, roundsHealthy([]Round{round(5, 110), round(6, 100)}, 4, 2, 10_000))
+	// previous answer of zero is always valid
+	assert.True(t, roundsHealthy([]Round{round(4, 0), round(5, 1000)}, 4, 1, 0))
+	assert.True(t, roundsHealthy([]Round{round(4, -100), round(5, -90)}, 4, 1, 10_000))
+}
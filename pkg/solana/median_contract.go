@@ -6,11 +6,15 @@ import (
 	"time"
 
 	"github.com/smartcontractkit/libocr/offchainreporting2/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 )
 
 type MedianContract struct {
-	stateCache         *StateCache
-	transmissionsCache *TransmissionsCache
+	stateCache          *StateCache
+	transmissionsCache  *TransmissionsCache
+	roundRequestedCache *RoundRequestedCache
+	lggr                logger.Logger
 }
 
 func (c *MedianContract) LatestTransmissionDetails(
@@ -60,6 +64,14 @@ func (c *MedianContract) LatestRoundRequested(
 	round uint8,
 	err error,
 ) {
-	state, err := c.stateCache.Read()
-	return state.Config.LatestConfigDigest, 0, 0, err
+	latest, err := c.roundRequestedCache.Read()
+	if err != nil {
+		// the round requested event is optional, OCR should not be blocked by failing RPC calls
+		c.lggr.Warnw("failed to read latest RoundRequested event, returning zero values", "err", err)
+		return configDigest, epoch, round, nil
+	}
+	if !latest.Found() || latest.BlockTime.Before(time.Now().Add(-lookback)) {
+		return configDigest, epoch, round, nil
+	}
+	return latest.ConfigDigest, latest.Epoch, latest.Round, nil
 }
@@ -2,6 +2,9 @@ package monitor
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sync"
 	"time"
 
 	"github.com/gagliardetto/solana-go"
@@ -16,6 +19,7 @@ import (
 // Config defines the monitor configuration.
 type Config interface {
 	BalancePollPeriod() time.Duration
+	BalanceThreshold(account string) (minBalance, floor uint64)
 }
 
 // Keystore provides the keys to be monitored.
@@ -27,20 +31,35 @@ type BalanceClient interface {
 	Balance(ctx context.Context, addr solana.PublicKey) (uint64, error)
 }
 
-// NewBalanceMonitor returns a balance monitoring services.Service which reports the SOL balance of all ks keys to prometheus.
-func NewBalanceMonitor(chainID string, cfg Config, lggr logger.Logger, ks Keystore, reader internal.Loader[BalanceClient]) services.Service {
-	return newBalanceMonitor(chainID, cfg, lggr, ks, reader)
+// FeeSpender provides the recent fee spend of keys, used to estimate the days of runway.
+type FeeSpender interface {
+	FeeSpend(account solana.PublicKey) (lamports uint64, period time.Duration)
 }
 
-func newBalanceMonitor(chainID string, cfg Config, lggr logger.Logger, ks Keystore, reader internal.Loader[BalanceClient]) *balanceMonitor {
+// BalanceMonitor reports the SOL balance of all ks keys to prometheus and checks them against the configured thresholds.
+type BalanceMonitor interface {
+	services.Service
+	// CheckBalanceFloor returns an error if the last observed balance of account is below its floor.
+	CheckBalanceFloor(account string) error
+}
+
+// NewBalanceMonitor returns a balance monitoring BalanceMonitor which reports the SOL balance of all ks keys to prometheus.
+// spender is optional, runway is not estimated if nil.
+func NewBalanceMonitor(chainID string, cfg Config, lggr logger.Logger, ks Keystore, reader internal.Loader[BalanceClient], spender FeeSpender) BalanceMonitor {
+	return newBalanceMonitor(chainID, cfg, lggr, ks, reader, spender)
+}
+
+func newBalanceMonitor(chainID string, cfg Config, lggr logger.Logger, ks Keystore, reader internal.Loader[BalanceClient], spender FeeSpender) *balanceMonitor {
 	b := balanceMonitor{
-		chainID: chainID,
-		cfg:     cfg,
-		lggr:    logger.Named(lggr, "BalanceMonitor"),
-		ks:      ks,
-		reader:  reader,
-		stop:    make(chan struct{}),
-		done:    make(chan struct{}),
+		chainID:  chainID,
+		cfg:      cfg,
+		lggr:     logger.Named(lggr, "BalanceMonitor"),
+		ks:       ks,
+		reader:   reader,
+		spender:  spender,
+		balances: map[string]uint64{},
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
 	}
 	b.updateFn = b.updateProm
 	return &b
@@ -54,7 +73,11 @@ type balanceMonitor struct {
 	ks       Keystore
 	updateFn func(acc solana.PublicKey, lamports uint64) // overridable for testing
 
-	reader internal.Loader[BalanceClient]
+	reader  internal.Loader[BalanceClient]
+	spender FeeSpender
+
+	balancesLock sync.RWMutex
+	balances     map[string]uint64 // last observed balance per key
 
 	stop services.StopChan
 	done chan struct{}
@@ -79,8 +102,66 @@ func (b *balanceMonitor) Close() error {
 	})
 }
 
+// HealthReport reports the monitor as unhealthy if any key is below its minimum balance
 func (b *balanceMonitor) HealthReport() map[string]error {
-	return map[string]error{b.Name(): b.Healthy()}
+	err := b.Healthy()
+	b.balancesLock.RLock()
+	defer b.balancesLock.RUnlock()
+	for account, lamports := range b.balances {
+		if minBalance, _ := b.cfg.BalanceThreshold(account); lamports < minBalance {
+			err = errors.Join(err, fmt.Errorf("account %s balance %d is below minimum %d lamports", account, lamports, minBalance))
+		}
+	}
+	return map[string]error{b.Name(): err}
+}
+
+func (b *balanceMonitor) CheckBalanceFloor(account string) error {
+	_, floor := b.cfg.BalanceThreshold(account)
+	if floor == 0 {
+		return nil
+	}
+	b.balancesLock.RLock()
+	lamports, ok := b.balances[account]
+	b.balancesLock.RUnlock()
+	if ok && lamports < floor {
+		return fmt.Errorf("account %s balance %d is below floor %d lamports", account, lamports, floor)
+	}
+	return nil
+}
+
+// updateThresholds records the balance and reports the thresholds and runway for the key
+func (b *balanceMonitor) updateThresholds(acc solana.PublicKey, lamports uint64) {
+	account := acc.String()
+	b.balancesLock.Lock()
+	b.balances[account] = lamports
+	b.balancesLock.Unlock()
+
+	minBalance, floor := b.cfg.BalanceThreshold(account)
+	belowMin := minBalance != 0 && lamports < minBalance
+	if belowMin {
+		b.lggr.Warnw("Account balance below minimum", "account", account, "lamports", lamports, "minimum", minBalance)
+	}
+	if floor != 0 && lamports < floor {
+		b.lggr.Errorw("Account balance below floor, new transactions are rejected", "account", account, "lamports", lamports, "floor", floor)
+	}
+	b.updateBelowMinProm(acc, belowMin)
+
+	if b.spender == nil {
+		return
+	}
+	spent, period := b.spender.FeeSpend(acc)
+	if runway, ok := runwayDays(lamports, spent, period); ok {
+		b.updateRunwayProm(acc, runway)
+	}
+}
+
+// runwayDays estimates the days until the balance is spent at the observed spend rate
+func runwayDays(lamports, spent uint64, period time.Duration) (float64, bool) {
+	if spent == 0 || period <= 0 {
+		return 0, false
+	}
+	perDay := float64(spent) / period.Hours() * 24
+	return float64(lamports) / perDay, true
 }
 
 func (b *balanceMonitor) monitor() {
@@ -137,6 +218,7 @@ func (b *balanceMonitor) updateBalances(ctx context.Context) {
 		}
 		gotSomeBals = true
 		b.updateFn(pubKey, lamports)
+		b.updateThresholds(pubKey, lamports)
 	}
 	if !gotSomeBals {
 		// Try a new client next time.
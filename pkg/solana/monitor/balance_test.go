@@ -47,7 +47,7 @@ func TestBalanceMonitor(t *testing.T) {
 		exp = append(exp, update{acc.String(), expBals[i]})
 	}
 	cfg := &config{balancePollPeriod: time.Second}
-	b := newBalanceMonitor(chainID, cfg, logger.Test(t), ks, nil)
+	b := newBalanceMonitor(chainID, cfg, logger.Test(t), ks, nil, nil)
 	var got []update
 	done := make(chan struct{})
 	b.updateFn = func(acc solana.PublicKey, lamports uint64) {
@@ -77,14 +77,75 @@ func TestBalanceMonitor(t *testing.T) {
 	assert.EqualValues(t, exp, got)
 }
 
+func TestBalanceMonitor_Thresholds(t *testing.T) {
+	low, high, unset := solana.PublicKey{1}, solana.PublicKey{2}, solana.PublicKey{3}
+	cfg := &config{
+		balancePollPeriod: time.Second,
+		thresholds: map[string][2]uint64{
+			low.String():  {1_000, 100},
+			high.String(): {1_000, 100},
+		},
+	}
+	spender := feeSpender{low: 10, high: 500}
+	b := newBalanceMonitor("Chainlinktest-42", cfg, logger.Test(t), keystore{}, nil, spender)
+
+	// balances not yet observed
+	require.NoError(t, b.CheckBalanceFloor(low.String()))
+	require.NoError(t, b.HealthReport()[b.Name()])
+
+	b.updateThresholds(low, 50)
+	b.updateThresholds(high, 2_000)
+	b.updateThresholds(unset, 0)
+
+	require.ErrorContains(t, b.CheckBalanceFloor(low.String()), "below floor 100 lamports")
+	require.NoError(t, b.CheckBalanceFloor(high.String()))
+	require.NoError(t, b.CheckBalanceFloor(unset.String()))
+
+	err := b.HealthReport()[b.Name()]
+	require.ErrorContains(t, err, low.String())
+	require.NotContains(t, err.Error(), high.String())
+	require.NotContains(t, err.Error(), unset.String())
+
+	b.updateThresholds(low, 1_000)
+	require.NoError(t, b.CheckBalanceFloor(low.String()))
+	require.NoError(t, b.HealthReport()[b.Name()])
+}
+
+func TestRunwayDays(t *testing.T) {
+	_, ok := runwayDays(1_000, 0, time.Hour)
+	assert.False(t, ok)
+	_, ok = runwayDays(1_000, 10, 0)
+	assert.False(t, ok)
+
+	days, ok := runwayDays(1_000, 500, 24*time.Hour)
+	require.True(t, ok)
+	assert.InDelta(t, 2, days, 1e-9)
+
+	days, ok = runwayDays(1_000, 500, 12*time.Hour)
+	require.True(t, ok)
+	assert.InDelta(t, 1, days, 1e-9)
+}
+
 type config struct {
 	balancePollPeriod time.Duration
+	thresholds        map[string][2]uint64
 }
 
 func (c *config) BalancePollPeriod() time.Duration {
 	return c.balancePollPeriod
 }
 
+func (c *config) BalanceThreshold(account string) (uint64, uint64) {
+	t := c.thresholds[account]
+	return t[0], t[1]
+}
+
+type feeSpender map[solana.PublicKey]uint64
+
+func (f feeSpender) FeeSpend(account solana.PublicKey) (uint64, time.Duration) {
+	return f[account], 24 * time.Hour
+}
+
 type keystore []solana.PublicKey
 
 func (k keystore) Accounts(ctx context.Context) (ks []string, err error) {
@@ -15,6 +15,14 @@ var (
 		prometheus.GaugeOpts{Name: "solana_balance", Help: "Solana account balances"},
 		[]string{"account", "chainID", "chainSet", "denomination"},
 	)
+	promSolanaBalanceRunway = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{Name: "solana_balance_runway_days", Help: "Estimated days until the Solana account balance is spent at the recent fee spend"},
+		[]string{"account", "chainID", "chainSet"},
+	)
+	promSolanaBalanceBelowMin = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{Name: "solana_balance_below_minimum", Help: "Set to 1 if the Solana account balance is below the configured minimum"},
+		[]string{"account", "chainID", "chainSet"},
+	)
 	promCacheTimestamp = promauto.NewGaugeVec(
 		prometheus.GaugeOpts{Name: "solana_cache_last_update_unix", Help: "Solana relayer cache last update timestamp"},
 		[]string{"type", "chainID", "account"},
@@ -30,6 +38,18 @@ func (b *balanceMonitor) updateProm(acc solana.PublicKey, lamports uint64) {
 	promSolanaBalance.WithLabelValues(acc.String(), b.chainID, "solana", "SOL").Set(v)
 }
 
+func (b *balanceMonitor) updateRunwayProm(acc solana.PublicKey, days float64) {
+	promSolanaBalanceRunway.WithLabelValues(acc.String(), b.chainID, "solana").Set(days)
+}
+
+func (b *balanceMonitor) updateBelowMinProm(acc solana.PublicKey, belowMin bool) {
+	var v float64
+	if belowMin {
+		v = 1
+	}
+	promSolanaBalanceBelowMin.WithLabelValues(acc.String(), b.chainID, "solana").Set(v)
+}
+
 func SetCacheTimestamp(t time.Time, cacheType, chainID, account string) {
 	promCacheTimestamp.With(prometheus.Labels{
 		"type":    cacheType,
@@ -0,0 +1,195 @@
+package multisig
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/gagliardetto/solana-go/rpc"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/client"
+)
+
+// nonceInitialized is the system program nonce account state once InitializeNonceAccount is executed
+const nonceInitialized uint32 = 1
+
+// DurableNonce is the current nonce of a system program durable nonce account.
+// Transactions using a durable nonce don't expire with the recent block hash, they are valid until the nonce is advanced.
+type DurableNonce struct {
+	Account   solana.PublicKey
+	Authority solana.PublicKey
+	Nonce     solana.Hash
+}
+
+// GetDurableNonce reads the current nonce of a durable nonce account
+func GetDurableNonce(ctx context.Context, reader client.AccountReader, account solana.PublicKey, commitment rpc.CommitmentType) (DurableNonce, error) {
+	res, err := reader.GetAccountInfoWithOpts(ctx, account, &rpc.GetAccountInfoOpts{
+		Commitment: commitment,
+		Encoding:   "base64",
+	})
+	if err != nil {
+		return DurableNonce{}, fmt.Errorf("failed to fetch nonce account at address '%s': %w", account, err)
+	}
+	if res == nil || res.Value == nil || res.Value.Data == nil {
+		return DurableNonce{}, errors.New("nil pointer returned in GetDurableNonce.GetAccountInfoWithOpts")
+	}
+	if !res.Value.Owner.Equals(solana.SystemProgramID) {
+		return DurableNonce{}, fmt.Errorf("account %s is not a nonce account, owner is %s", account, res.Value.Owner)
+	}
+	var nonce system.NonceAccount
+	if err = bin.NewBinDecoder(res.Value.Data.GetBinary()).Decode(&nonce); err != nil {
+		return DurableNonce{}, fmt.Errorf("failed to decode nonce account data: %w", err)
+	}
+	if nonce.State != nonceInitialized {
+		return DurableNonce{}, fmt.Errorf("nonce account %s is not initialized", account)
+	}
+	return DurableNonce{
+		Account:   account,
+		Authority: nonce.AuthorizedPubkey,
+		Nonce:     solana.Hash(nonce.Nonce),
+	}, nil
+}
+
+// UnsignedTx is a transaction message signed offline by each of its signers
+type UnsignedTx struct {
+	Message solana.Message
+}
+
+// NewUnsignedTx builds the instructions (e.g. from contracts/generated) into a message using the durable nonce as the
+// recent block hash. The nonce is advanced by the first instruction, so the nonce authority is a signer of the message.
+func NewUnsignedTx(instructions []solana.Instruction, payer solana.PublicKey, nonce DurableNonce) (*UnsignedTx, error) {
+	if len(instructions) == 0 {
+		return nil, errors.New("no instructions")
+	}
+	advance := system.NewAdvanceNonceAccountInstruction(nonce.Account, solana.SysVarRecentBlockHashesPubkey, nonce.Authority).Build()
+	tx, err := solana.NewTransaction(append([]solana.Instruction{advance}, instructions...), nonce.Nonce, solana.TransactionPayer(payer))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tx: %w", err)
+	}
+	return &UnsignedTx{Message: tx.Message}, nil
+}
+
+// DecodeUnsignedTx decodes a base64 encoded message, see UnsignedTx.Encode
+func DecodeUnsignedTx(encoded string) (*UnsignedTx, error) {
+	var msg solana.Message
+	if err := msg.UnmarshalBase64(encoded); err != nil {
+		return nil, fmt.Errorf("failed to decode message: %w", err)
+	}
+	return &UnsignedTx{Message: msg}, nil
+}
+
+// Encode returns the base64 encoded message, the bytes signed by each signer
+func (u *UnsignedTx) Encode() (string, error) {
+	raw, err := u.Message.MarshalBinary()
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(raw), nil
+}
+
+// Signers returns the accounts which must sign the message, in signature order
+func (u *UnsignedTx) Signers() []solana.PublicKey {
+	return u.Message.Signers()
+}
+
+// PartialSignature is the signature of the message by one of its signers
+type PartialSignature struct {
+	Signer    solana.PublicKey
+	Signature solana.Signature
+}
+
+// String encodes the partial signature as "<signer>:<signature>" for transport between offline signers
+func (p PartialSignature) String() string {
+	return p.Signer.String() + ":" + p.Signature.String()
+}
+
+// ParsePartialSignature decodes a partial signature encoded with PartialSignature.String
+func ParsePartialSignature(s string) (PartialSignature, error) {
+	signer, sig, ok := strings.Cut(s, ":")
+	if !ok {
+		return PartialSignature{}, fmt.Errorf("invalid partial signature %q, expected <signer>:<signature>", s)
+	}
+	var p PartialSignature
+	var err error
+	if p.Signer, err = solana.PublicKeyFromBase58(signer); err != nil {
+		return PartialSignature{}, fmt.Errorf("invalid signer: %w", err)
+	}
+	if p.Signature, err = solana.SignatureFromBase58(sig); err != nil {
+		return PartialSignature{}, fmt.Errorf("invalid signature: %w", err)
+	}
+	return p, nil
+}
+
+// Sign signs the message with key, key must be one of the message signers
+func (u *UnsignedTx) Sign(key solana.PrivateKey) (PartialSignature, error) {
+	signer := key.PublicKey()
+	if !u.Message.IsSigner(signer) {
+		return PartialSignature{}, fmt.Errorf("%s is not a signer of the message", signer)
+	}
+	raw, err := u.Message.MarshalBinary()
+	if err != nil {
+		return PartialSignature{}, err
+	}
+	sig, err := key.Sign(raw)
+	if err != nil {
+		return PartialSignature{}, fmt.Errorf("failed to sign message: %w", err)
+	}
+	return PartialSignature{Signer: signer, Signature: sig}, nil
+}
+
+// Missing returns the signers without a valid signature in sigs
+func (u *UnsignedTx) Missing(sigs ...PartialSignature) ([]solana.PublicKey, error) {
+	_, missing, err := u.merge(sigs)
+	return missing, err
+}
+
+// Assemble merges the partial signatures into a signed transaction, ready to be submitted with txm.EnqueueSigned.
+// Each signature is verified, all signers must have signed.
+func (u *UnsignedTx) Assemble(sigs ...PartialSignature) (*solana.Transaction, error) {
+	signatures, missing, err := u.merge(sigs)
+	if err != nil {
+		return nil, err
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("missing signatures from %v", missing)
+	}
+	return &solana.Transaction{Signatures: signatures, Message: u.Message}, nil
+}
+
+// merge places the verified signatures in signer order, the same signature may be provided more than once
+func (u *UnsignedTx) merge(sigs []PartialSignature) ([]solana.Signature, []solana.PublicKey, error) {
+	raw, err := u.Message.MarshalBinary()
+	if err != nil {
+		return nil, nil, err
+	}
+	signers := u.Message.Signers()
+	index := make(map[solana.PublicKey]int, len(signers))
+	for i, s := range signers {
+		index[s] = i
+	}
+
+	signatures := make([]solana.Signature, len(signers))
+	for _, p := range sigs {
+		i, ok := index[p.Signer]
+		if !ok {
+			return nil, nil, fmt.Errorf("%s is not a signer of the message", p.Signer)
+		}
+		if !p.Signature.Verify(p.Signer, raw) {
+			return nil, nil, fmt.Errorf("invalid signature by %s", p.Signer)
+		}
+		signatures[i] = p.Signature
+	}
+
+	var missing []solana.PublicKey
+	for i, s := range signers {
+		if signatures[i].IsZero() {
+			missing = append(missing, s)
+		}
+	}
+	return signatures, missing, nil
+}
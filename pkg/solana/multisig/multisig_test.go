@@ -0,0 +1,105 @@
+package multisig
+
+import (
+	"bytes"
+	"testing"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-solana/contracts/generated/ocr_2"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/client/mocks"
+)
+
+func TestGetDurableNonce(t *testing.T) {
+	rw := mocks.NewReaderWriter(t)
+	account := solana.NewWallet().PublicKey()
+	authority := solana.NewWallet().PublicKey()
+
+	nonce := system.NonceAccount{State: nonceInitialized, AuthorizedPubkey: authority, Nonce: solana.PublicKey{7}}
+	buf := new(bytes.Buffer)
+	require.NoError(t, bin.NewBinEncoder(buf).Encode(nonce))
+	rw.On("GetAccountInfoWithOpts", mock.Anything, account, mock.Anything).Return(&rpc.GetAccountInfoResult{
+		Value: &rpc.Account{Owner: solana.SystemProgramID, Data: rpc.DataBytesOrJSONFromBytes(buf.Bytes())},
+	}, nil).Once()
+
+	got, err := GetDurableNonce(tests.Context(t), rw, account, rpc.CommitmentConfirmed)
+	require.NoError(t, err)
+	assert.Equal(t, DurableNonce{Account: account, Authority: authority, Nonce: solana.Hash{7}}, got)
+
+	// uninitialized nonce accounts can't be used
+	nonce.State = 0
+	buf.Reset()
+	require.NoError(t, bin.NewBinEncoder(buf).Encode(nonce))
+	rw.On("GetAccountInfoWithOpts", mock.Anything, account, mock.Anything).Return(&rpc.GetAccountInfoResult{
+		Value: &rpc.Account{Owner: solana.SystemProgramID, Data: rpc.DataBytesOrJSONFromBytes(buf.Bytes())},
+	}, nil).Once()
+	_, err = GetDurableNonce(tests.Context(t), rw, account, rpc.CommitmentConfirmed)
+	require.ErrorContains(t, err, "not initialized")
+
+	rw.On("GetAccountInfoWithOpts", mock.Anything, account, mock.Anything).Return(&rpc.GetAccountInfoResult{
+		Value: &rpc.Account{Owner: solana.NewWallet().PublicKey(), Data: rpc.DataBytesOrJSONFromBytes(buf.Bytes())},
+	}, nil).Once()
+	_, err = GetDurableNonce(tests.Context(t), rw, account, rpc.CommitmentConfirmed)
+	require.ErrorContains(t, err, "is not a nonce account")
+}
+
+func TestUnsignedTx(t *testing.T) {
+	payer := solana.NewWallet()
+	owner := solana.NewWallet()
+	nonce := DurableNonce{Account: solana.NewWallet().PublicKey(), Authority: owner.PublicKey(), Nonce: solana.Hash{7}}
+
+	// ownership of an OCR2 state held by owner
+	inst := ocr_2.NewTransferOwnershipInstruction(solana.NewWallet().PublicKey(), solana.NewWallet().PublicKey(), owner.PublicKey()).Build()
+	unsigned, err := NewUnsignedTx([]solana.Instruction{inst}, payer.PublicKey(), nonce)
+	require.NoError(t, err)
+	assert.Equal(t, nonce.Nonce, unsigned.Message.RecentBlockhash)
+	assert.Equal(t, []solana.PublicKey{payer.PublicKey(), owner.PublicKey()}, unsigned.Signers())
+	program, err := unsigned.Message.Program(unsigned.Message.Instructions[0].ProgramIDIndex)
+	require.NoError(t, err)
+	assert.Equal(t, solana.SystemProgramID, program, "first instruction advances the nonce")
+
+	// each signer signs a decoded copy offline
+	encoded, err := unsigned.Encode()
+	require.NoError(t, err)
+	var sigs []PartialSignature
+	for _, key := range []solana.PrivateKey{owner.PrivateKey, payer.PrivateKey} {
+		offline, err := DecodeUnsignedTx(encoded)
+		require.NoError(t, err)
+		sig, err := offline.Sign(key)
+		require.NoError(t, err)
+		parsed, err := ParsePartialSignature(sig.String())
+		require.NoError(t, err)
+		sigs = append(sigs, parsed)
+	}
+
+	_, err = unsigned.Sign(solana.NewWallet().PrivateKey)
+	require.ErrorContains(t, err, "is not a signer")
+
+	missing, err := unsigned.Missing(sigs[0])
+	require.NoError(t, err)
+	assert.Equal(t, []solana.PublicKey{payer.PublicKey()}, missing)
+	_, err = unsigned.Assemble(sigs[0])
+	require.ErrorContains(t, err, "missing signatures")
+
+	// signatures are placed in signer order
+	tx, err := unsigned.Assemble(sigs...)
+	require.NoError(t, err)
+	require.NoError(t, tx.VerifySignatures())
+	assert.Equal(t, sigs[1].Signature, tx.Signatures[0])
+
+	invalid := sigs[1]
+	invalid.Signature = sigs[0].Signature
+	_, err = unsigned.Assemble(sigs[0], invalid)
+	require.ErrorContains(t, err, "invalid signature")
+
+	_, err = ParsePartialSignature(payer.PublicKey().String())
+	require.Error(t, err)
+}
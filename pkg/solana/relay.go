@@ -25,6 +25,8 @@ var _ TxManager = (*txm.Txm)(nil)
 
 type TxManager interface {
 	Enqueue(ctx context.Context, accountID string, tx *solana.Transaction, txID *string, txCfgs ...txm.SetTxConfig) error
+	// EnqueueSigned broadcasts a transaction signed offline (e.g. multisig) without re-signing or changing it
+	EnqueueSigned(ctx context.Context, accountID string, tx *solana.Transaction, txID *string) error
 }
 
 var _ relaytypes.Relayer = &Relayer{} //nolint:staticcheck
@@ -153,15 +155,60 @@ func (r *Relayer) NewMedianProvider(ctx context.Context, rargs relaytypes.RelayA
 		return nil, fmt.Errorf("error on 'solana.PublicKeyFromBase58' for 'spec.RelayConfig.TransmissionsID: %w", err)
 	}
 
+	// parse additional transmitter keys
+	if len(relayConfig.RetiredTransmitterIDs) > 0 && len(relayConfig.TransmitterIDs) == 0 {
+		return nil, errors.New("spec.RelayConfig.RetiredTransmitterIDs must be set with TransmitterIDs")
+	}
+	var keys *transmitterKeys
+	if len(relayConfig.TransmitterIDs) > 0 {
+		transmitterKeyIDs := []solana.PublicKey{transmitterAccount}
+		for _, id := range relayConfig.TransmitterIDs {
+			key, err := solana.PublicKeyFromBase58(id)
+			if err != nil {
+				return nil, fmt.Errorf("error on 'solana.PublicKeyFromBase58' for 'spec.RelayConfig.TransmitterIDs: %w", err)
+			}
+			transmitterKeyIDs = append(transmitterKeyIDs, key)
+		}
+		var retired []solana.PublicKey
+		for _, id := range relayConfig.RetiredTransmitterIDs {
+			key, err := solana.PublicKeyFromBase58(id)
+			if err != nil {
+				return nil, fmt.Errorf("error on 'solana.PublicKeyFromBase58' for 'spec.RelayConfig.RetiredTransmitterIDs: %w", err)
+			}
+			retired = append(retired, key)
+		}
+		keys = newTransmitterKeys(transmitterKeyIDs, retired, logger.Named(lggr, "TransmitterKeys"))
+	}
+
 	cfg := configWatcher.chain.Config()
+
+	// optional flag lowering
+	var flagLowerer *FlagLowerer
+	if relayConfig.LowerFlagAuthority != "" {
+		if relayConfig.LowerFlagHealthyRounds == 0 {
+			return nil, errors.New("spec.RelayConfig.LowerFlagHealthyRounds must be set with LowerFlagAuthority")
+		}
+		authority, err := solana.PublicKeyFromBase58(relayConfig.LowerFlagAuthority)
+		if err != nil {
+			return nil, fmt.Errorf("error on 'solana.PublicKeyFromBase58' for 'spec.RelayConfig.LowerFlagAuthority: %w", err)
+		}
+		flagLowerer = NewFlagLowerer(transmissionsID, configWatcher.storeProgramID, authority, relayConfig.LowerFlagHealthyRounds,
+			cfg, configWatcher.reader, configWatcher.chain.TxManager(), lggr)
+	}
+
 	transmissionsCache := NewTransmissionsCache(transmissionsID, relayConfig.ChainID, cfg, configWatcher.reader, r.lggr)
+	roundRequestedCache := NewRoundRequestedCache(configWatcher.programID, configWatcher.stateID, relayConfig.ChainID, cfg, configWatcher.reader, r.lggr)
 	return &medianProvider{
-		configProvider:     configWatcher,
-		transmissionsCache: transmissionsCache,
-		reportCodec:        ReportCodec{},
+		configProvider:      configWatcher,
+		transmissionsCache:  transmissionsCache,
+		roundRequestedCache: roundRequestedCache,
+		flagLowerer:         flagLowerer,
+		reportCodec:         ReportCodec{},
 		contract: &MedianContract{
-			stateCache:         configWatcher.stateCache,
-			transmissionsCache: transmissionsCache,
+			stateCache:          configWatcher.stateCache,
+			transmissionsCache:  transmissionsCache,
+			roundRequestedCache: roundRequestedCache,
+			lggr:                r.lggr,
 		},
 		transmitter: &Transmitter{
 			stateID:            configWatcher.stateID,
@@ -173,6 +220,7 @@ func (r *Relayer) NewMedianProvider(ctx context.Context, rargs relaytypes.RelayA
 			stateCache:         configWatcher.stateCache,
 			lggr:               r.lggr,
 			txManager:          configWatcher.chain.TxManager(),
+			keys:               keys,
 		},
 	}, nil
 }
@@ -272,33 +320,51 @@ var _ relaytypes.MedianProvider = &medianProvider{}
 
 type medianProvider struct {
 	*configProvider
-	transmissionsCache *TransmissionsCache
-	reportCodec        median.ReportCodec
-	contract           median.MedianContract
-	transmitter        types.ContractTransmitter
+	transmissionsCache  *TransmissionsCache
+	roundRequestedCache *RoundRequestedCache
+	flagLowerer         *FlagLowerer // nil if flag lowering is disabled
+	reportCodec         median.ReportCodec
+	contract            median.MedianContract
+	transmitter         types.ContractTransmitter
 }
 
 func (p *medianProvider) Name() string {
 	return p.stateCache.Name()
 }
 
-// start both cache services
+// start all cache services
 func (p *medianProvider) Start(ctx context.Context) error {
 	return p.StartOnce("SolanaMedianProvider", func() error {
 		if err := p.configProvider.stateCache.Start(ctx); err != nil {
 			return err
 		}
-		return p.transmissionsCache.Start(ctx)
+		if err := p.transmissionsCache.Start(ctx); err != nil {
+			return err
+		}
+		if p.flagLowerer != nil {
+			if err := p.flagLowerer.Start(ctx); err != nil {
+				return err
+			}
+		}
+		return p.roundRequestedCache.Start(ctx)
 	})
 }
 
-// close both cache services
+// close all cache services
 func (p *medianProvider) Close() error {
 	return p.StopOnce("SolanaMedianProvider", func() error {
 		if err := p.configProvider.stateCache.Close(); err != nil {
 			return err
 		}
-		return p.transmissionsCache.Close()
+		if err := p.transmissionsCache.Close(); err != nil {
+			return err
+		}
+		if p.flagLowerer != nil {
+			if err := p.flagLowerer.Close(); err != nil {
+				return err
+			}
+		}
+		return p.roundRequestedCache.Close()
 	})
 }
 
@@ -107,3 +107,42 @@ func (c ReportCodec) ObserversCountFromReport(report types.Report) (uint8, error
 	end := start + int(ObsCountLen)
 	return report[start:end][0], nil
 }
+
+func (c ReportCodec) TimestampFromReport(report types.Report) (uint32, error) {
+	// report should contain timestamp + observers + median + juels per eth
+	if len(report) != int(ReportLen) {
+		return 0, fmt.Errorf("report length mismatch: %d (received), %d (expected)", len(report), ReportLen)
+	}
+
+	// unpack observations timestamp
+	return binary.BigEndian.Uint32(report[:TimestampLen]), nil
+}
+
+// ObserversFromReport returns the oracle indices of the observers included in the report.
+// Observers are ordered by their observed value (ascending), so the median observer is at index len/2.
+func (c ReportCodec) ObserversFromReport(report types.Report) ([]uint8, error) {
+	count, err := c.ObserversCountFromReport(report)
+	if err != nil {
+		return nil, err
+	}
+	if uint64(count) > ObsArrLen {
+		return nil, fmt.Errorf("observers count exceeds observers array length: %d (received), %d (max)", count, ObsArrLen)
+	}
+
+	// unpack observers array
+	start := int(TimestampLen + ObsCountLen)
+	end := start + int(count)
+	return append([]uint8{}, report[start:end]...), nil
+}
+
+func (c ReportCodec) JuelsPerFeeCoinFromReport(report types.Report) (*big.Int, error) {
+	// report should contain timestamp + observers + median + juels per eth
+	if len(report) != int(ReportLen) {
+		return nil, fmt.Errorf("report length mismatch: %d (received), %d (expected)", len(report), ReportLen)
+	}
+
+	// unpack juels per fee coin
+	start := int(ReportHeaderLen + MedianLen)
+	end := start + int(JuelsLen)
+	return bigbigendian.DeserializeSigned(int(JuelsLen), report[start:end])
+}
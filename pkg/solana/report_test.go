@@ -80,6 +80,42 @@ func TestMedianFromOnChainReport(t *testing.T) {
 	res, err := c.MedianFromReport(tests.Context(t), report)
 	assert.NoError(t, err)
 	assert.Equal(t, "1234567890", res.String())
+
+	timestamp, err := c.TimestampFromReport(report)
+	assert.NoError(t, err)
+	assert.Equal(t, binary.BigEndian.Uint32([]byte{97, 91, 43, 83}), timestamp)
+
+	observers, err := c.ObserversFromReport(report)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint8{0, 1}, observers)
+
+	juels, err := c.JuelsPerFeeCoinFromReport(report)
+	assert.NoError(t, err)
+	assert.Equal(t, "1000000000000000000", juels.String())
+
+	// invalid report length
+	_, err = c.ObserversFromReport(report[1:])
+	assert.Error(t, err)
+	_, err = c.JuelsPerFeeCoinFromReport(report[1:])
+	assert.Error(t, err)
+	_, err = c.TimestampFromReport(report[1:])
+	assert.Error(t, err)
+}
+
+func TestObserversFromReport_OrderedByValue(t *testing.T) {
+	ctx := tests.Context(t)
+	c := ReportCodec{}
+	oo := []median.ParsedAttributedObservation{
+		{Value: big.NewInt(30), JuelsPerFeeCoin: big.NewInt(1), Observer: commontypes.OracleID(0)},
+		{Value: big.NewInt(10), JuelsPerFeeCoin: big.NewInt(1), Observer: commontypes.OracleID(1)},
+		{Value: big.NewInt(20), JuelsPerFeeCoin: big.NewInt(1), Observer: commontypes.OracleID(2)},
+	}
+	report, err := c.BuildReport(ctx, oo)
+	require.NoError(t, err)
+
+	observers, err := c.ObserversFromReport(report)
+	require.NoError(t, err)
+	assert.Equal(t, []uint8{1, 2, 0}, observers)
 }
 
 type medianTest struct {
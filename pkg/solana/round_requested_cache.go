@@ -0,0 +1,174 @@
+package solana
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/monitoring/event"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/client"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
+)
+
+const (
+	// roundRequestedPageSize is the number of signatures fetched per GetSignaturesForAddress call
+	roundRequestedPageSize = 1000
+	// roundRequestedMaxPages bounds a single scan, older signatures are skipped if more transactions were sent between polls
+	roundRequestedMaxPages = 5
+	// roundRequestedMaxTxs bounds the number of GetTransaction calls in a single scan, older transactions are skipped
+	roundRequestedMaxTxs = 100
+)
+
+// RoundRequestedEvent is the latest RoundRequested event emitted by the OCR2 program for a feed
+type RoundRequestedEvent struct {
+	event.RoundRequested
+	Signature solana.Signature
+	Slot      uint64
+	BlockTime time.Time
+}
+
+// Found returns false if no RoundRequested event has been observed
+func (e RoundRequestedEvent) Found() bool {
+	return e.Slot != 0
+}
+
+type RoundRequestedCache struct {
+	*client.Cache[RoundRequestedEvent]
+}
+
+// NewRoundRequestedCache creates a cache of the latest RoundRequested event, updated by incrementally scanning the
+// transactions of the state account since the last poll
+func NewRoundRequestedCache(programID, stateID solana.PublicKey, chainID string, cfg config.Config, reader client.Reader, lggr logger.Logger) *RoundRequestedCache {
+	name := "ocr2_median_round_requested"
+	lggr = logger.With(lggr, "cache", name)
+	scanner := &roundRequestedScanner{
+		programID: programID,
+		stateID:   stateID,
+		reader:    reader,
+		lggr:      lggr,
+	}
+	return &RoundRequestedCache{client.NewCache(name, stateID, chainID, cfg, scanner.Scan, lggr)}
+}
+
+// roundRequestedScanner tracks the latest RoundRequested event across scans
+type roundRequestedScanner struct {
+	programID, stateID solana.PublicKey
+	reader             client.Reader
+	lggr               logger.Logger
+
+	lock   sync.Mutex
+	until  solana.Signature // newest signature processed by the previous scan
+	latest RoundRequestedEvent
+}
+
+// Scan fetches transactions since the previous scan (newest first) and returns the latest RoundRequested event
+func (s *roundRequestedScanner) Scan(ctx context.Context) (RoundRequestedEvent, uint64, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var newest solana.Signature
+	var before solana.Signature
+	fetched := 0
+	for page := 0; page < roundRequestedMaxPages; page++ {
+		limit := roundRequestedPageSize
+		sigs, err := s.reader.GetSignaturesForAddressWithOpts(ctx, s.stateID, &rpc.GetSignaturesForAddressOpts{
+			Limit:  &limit,
+			Before: before,
+			Until:  s.until,
+		})
+		if err != nil {
+			return s.latest, s.latest.Slot, fmt.Errorf("failed to fetch signatures for state account '%s': %w", s.stateID, err)
+		}
+		if len(sigs) == 0 {
+			break
+		}
+		if newest.IsZero() {
+			newest = sigs[0].Signature
+		}
+
+		found, n, err := s.findLatest(ctx, sigs, roundRequestedMaxTxs-fetched)
+		fetched += n
+		if err != nil {
+			return s.latest, s.latest.Slot, err
+		}
+		if found {
+			break // older transactions can't contain a newer event
+		}
+		if fetched >= roundRequestedMaxTxs {
+			s.lggr.Warnw("transaction limit reached, skipping older transactions", "limit", roundRequestedMaxTxs)
+			break
+		}
+		// only the latest page is scanned on the first poll
+		if len(sigs) < roundRequestedPageSize || s.until.IsZero() {
+			break
+		}
+		before = sigs[len(sigs)-1].Signature
+	}
+
+	if !newest.IsZero() {
+		s.until = newest
+	}
+	return s.latest, s.latest.Slot, nil
+}
+
+// findLatest updates the latest event with the newest RoundRequested event in sigs (ordered newest first)
+// at most limit transactions are fetched, the number of fetched transactions is returned
+func (s *roundRequestedScanner) findLatest(ctx context.Context, sigs []*rpc.TransactionSignature, limit int) (bool, int, error) {
+	fetched := 0
+	for _, sig := range sigs {
+		if sig.Err != nil {
+			// failed transactions do not emit events
+			continue
+		}
+		if fetched >= limit {
+			break
+		}
+		fetched++
+		res, err := s.reader.GetTransaction(ctx, sig.Signature, &rpc.GetTransactionOpts{Encoding: solana.EncodingBase64})
+		if err != nil {
+			return false, fetched, fmt.Errorf("failed to fetch transaction '%s': %w", sig.Signature, err)
+		}
+		if res == nil || res.Meta == nil {
+			s.lggr.Debugw("no transaction found for signature", "signature", sig.Signature)
+			continue
+		}
+
+		// events are emitted in order, the last RoundRequested event in a transaction is the latest
+		var latest *event.RoundRequested
+		for _, raw := range event.ExtractEvents(res.Meta.LogMessages, s.programID.String()) {
+			decoded, err := event.Decode(raw)
+			if err != nil {
+				s.lggr.Debugw("failed to decode event", "signature", sig.Signature, "err", err)
+				continue
+			}
+			if roundRequested, ok := decoded.(event.RoundRequested); ok {
+				latest = &roundRequested
+			}
+		}
+		if latest == nil {
+			continue
+		}
+
+		s.latest = RoundRequestedEvent{
+			RoundRequested: *latest,
+			Signature:      sig.Signature,
+			Slot:           res.Slot,
+		}
+		switch {
+		case res.BlockTime != nil:
+			s.latest.BlockTime = res.BlockTime.Time()
+		case sig.BlockTime != nil:
+			s.latest.BlockTime = sig.BlockTime.Time()
+		default:
+			s.latest.BlockTime = time.Now() // block time is not yet available for recent blocks
+		}
+		return true, fetched, nil
+	}
+	return false, fetched, nil
+}
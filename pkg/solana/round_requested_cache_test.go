@@ -0,0 +1,150 @@
+package solana
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/monitoring/event"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/client/mocks"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
+)
+
+func roundRequestedLogs(t *testing.T, programID solana.PublicKey, ev event.RoundRequested) []string {
+	buf := new(bytes.Buffer)
+	require.NoError(t, bin.NewBinEncoder(buf).Encode(ev))
+	data := append(append([]byte{}, event.RoundRequestedDiscriminator...), buf.Bytes()...)
+	return []string{
+		"Program " + programID.String() + " invoke [1]",
+		"Program log: Instruction: RequestNewRound",
+		"Program data: " + base64.StdEncoding.EncodeToString(data),
+		"Program " + programID.String() + " success",
+	}
+}
+
+func TestRoundRequestedCache(t *testing.T) {
+	ctx := tests.Context(t)
+	rw := mocks.NewReaderWriter(t)
+	programID, stateID := solana.PublicKey{1}, solana.PublicKey{2}
+	cache := NewRoundRequestedCache(programID, stateID, "test", config.NewDefault(), rw, logger.Test(t))
+	contract := &MedianContract{roundRequestedCache: cache, lggr: logger.Test(t)}
+
+	// first poll: no RoundRequested events
+	transmitSig, failedSig := solana.Signature{1}, solana.Signature{2}
+	rw.On("GetSignaturesForAddressWithOpts", mock.Anything, stateID, mock.MatchedBy(func(opts *rpc.GetSignaturesForAddressOpts) bool {
+		return opts.Until.IsZero()
+	})).Return([]*rpc.TransactionSignature{
+		{Signature: failedSig, Err: "failed"},
+		{Signature: transmitSig},
+	}, nil).Once()
+	rw.On("GetTransaction", mock.Anything, transmitSig, mock.Anything).Return(&rpc.GetTransactionResult{
+		Slot: 10,
+		Meta: &rpc.TransactionMeta{LogMessages: []string{
+			"Program " + programID.String() + " invoke [1]",
+			"Program log: Instruction: Transmit",
+			"Program " + programID.String() + " success",
+		}},
+	}, nil).Once()
+	require.NoError(t, cache.Fetch(ctx))
+
+	digest, epoch, round, err := contract.LatestRoundRequested(ctx, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, [32]byte{}, [32]byte(digest))
+	assert.Zero(t, epoch)
+	assert.Zero(t, round)
+
+	// second poll: only signatures after the first poll are scanned
+	requestSig, olderRequestSig := solana.Signature{3}, solana.Signature{4}
+	blockTime := solana.UnixTimeSeconds(time.Now().Add(-time.Minute).Unix())
+	expected := event.RoundRequested{ConfigDigest: [32]byte{9}, Requester: solana.PublicKey{5}, Epoch: 7, Round: 2}
+	rw.On("GetSignaturesForAddressWithOpts", mock.Anything, stateID, mock.MatchedBy(func(opts *rpc.GetSignaturesForAddressOpts) bool {
+		return opts.Until == failedSig
+	})).Return([]*rpc.TransactionSignature{
+		{Signature: requestSig},
+		{Signature: olderRequestSig},
+	}, nil).Once()
+	rw.On("GetTransaction", mock.Anything, requestSig, mock.Anything).Return(&rpc.GetTransactionResult{
+		Slot:      20,
+		BlockTime: &blockTime,
+		Meta:      &rpc.TransactionMeta{LogMessages: roundRequestedLogs(t, programID, expected)},
+	}, nil).Once()
+	require.NoError(t, cache.Fetch(ctx))
+
+	digest, epoch, round, err = contract.LatestRoundRequested(ctx, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, expected.ConfigDigest, [32]byte(digest))
+	assert.Equal(t, expected.Epoch, epoch)
+	assert.Equal(t, expected.Round, round)
+
+	// event outside of lookback window is ignored
+	digest, epoch, round, err = contract.LatestRoundRequested(ctx, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, [32]byte{}, [32]byte(digest))
+	assert.Zero(t, epoch)
+	assert.Zero(t, round)
+
+	// third poll: no new transactions keeps the latest event
+	rw.On("GetSignaturesForAddressWithOpts", mock.Anything, stateID, mock.MatchedBy(func(opts *rpc.GetSignaturesForAddressOpts) bool {
+		return opts.Until == requestSig
+	})).Return([]*rpc.TransactionSignature{}, nil).Once()
+	require.NoError(t, cache.Fetch(ctx))
+	latest, err := cache.Read()
+	require.NoError(t, err)
+	assert.Equal(t, requestSig, latest.Signature)
+	assert.Equal(t, uint64(20), latest.Slot)
+	assert.Equal(t, blockTime.Time(), latest.BlockTime)
+}
+
+func TestRoundRequestedCache_MaxTxs(t *testing.T) {
+	ctx := tests.Context(t)
+	rw := mocks.NewReaderWriter(t)
+	programID, stateID := solana.PublicKey{1}, solana.PublicKey{2}
+	cache := NewRoundRequestedCache(programID, stateID, "test", config.NewDefault(), rw, logger.Test(t))
+
+	sigs := make([]*rpc.TransactionSignature, 2*roundRequestedMaxTxs)
+	for i := range sigs {
+		sigs[i] = &rpc.TransactionSignature{Signature: solana.Signature{byte(i), byte(i >> 8), 1}}
+	}
+	rw.On("GetSignaturesForAddressWithOpts", mock.Anything, stateID, mock.Anything).Return(sigs, nil).Once()
+	rw.On("GetTransaction", mock.Anything, mock.Anything, mock.Anything).Return(&rpc.GetTransactionResult{
+		Slot: 10,
+		Meta: &rpc.TransactionMeta{},
+	}, nil).Times(roundRequestedMaxTxs)
+	require.NoError(t, cache.Fetch(ctx))
+
+	// older transactions are skipped, the next scan starts from the newest signature
+	rw.On("GetSignaturesForAddressWithOpts", mock.Anything, stateID, mock.MatchedBy(func(opts *rpc.GetSignaturesForAddressOpts) bool {
+		return opts.Until == sigs[0].Signature
+	})).Return([]*rpc.TransactionSignature{}, nil).Once()
+	require.NoError(t, cache.Fetch(ctx))
+}
+
+func TestMedianContract_LatestRoundRequested_Error(t *testing.T) {
+	ctx := tests.Context(t)
+	rw := mocks.NewReaderWriter(t)
+	programID, stateID := solana.PublicKey{1}, solana.PublicKey{2}
+	cache := NewRoundRequestedCache(programID, stateID, "test", config.NewDefault(), rw, logger.Test(t))
+	contract := &MedianContract{roundRequestedCache: cache, lggr: logger.Test(t)}
+
+	rw.On("GetSignaturesForAddressWithOpts", mock.Anything, stateID, mock.Anything).Return(nil, errors.New("rpc error")).Once()
+	require.Error(t, cache.Fetch(ctx))
+
+	// the cache is stale, zero values are returned
+	digest, epoch, round, err := contract.LatestRoundRequested(ctx, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, [32]byte{}, [32]byte(digest))
+	assert.Zero(t, epoch)
+	assert.Zero(t, round)
+}
@@ -0,0 +1,307 @@
+package solana
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	solanago "github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/client"
+)
+
+// SimulateOpts configures a transaction dry-run
+type SimulateOpts struct {
+	// Accounts are returned as pre/post account diffs
+	Accounts []solanago.PublicKey
+	// SigVerify verifies the transaction signatures (conflicts with ReplaceRecentBlockhash)
+	SigVerify bool
+	// ReplaceRecentBlockhash replaces the transaction blockhash with the latest blockhash (conflicts with SigVerify)
+	ReplaceRecentBlockhash bool
+	// Commitment used for simulation, defaults to the chain config commitment
+	Commitment rpc.CommitmentType
+}
+
+// SimulateResult contains the decoded output of a transaction dry-run
+type SimulateResult struct {
+	Logs          []string
+	Events        []AnchorEvent
+	UnitsConsumed uint64
+	AccountDiffs  []AccountDiff
+	// PreSlot is the context slot of the pre-simulation account state, 0 if no accounts were requested
+	PreSlot uint64
+	// Err is the classified simulation error, nil if the transaction would succeed
+	Err *SimulationError
+}
+
+// AccountDiff contains the account state before and after the simulated transaction
+type AccountDiff struct {
+	Address                   solanago.PublicKey
+	PreExists, PostExists     bool
+	PreLamports, PostLamports uint64
+	PreOwner, PostOwner       solanago.PublicKey
+	PreData, PostData         []byte
+}
+
+// LamportsDelta returns the signed change in lamports
+func (d AccountDiff) LamportsDelta() int64 {
+	return int64(d.PostLamports) - int64(d.PreLamports) //nolint:gosec // total lamport supply fits in int64
+}
+
+// AnchorEvent is an event emitted with `emit!` by an Anchor program (logged as `Program data: <base64>`)
+type AnchorEvent struct {
+	ProgramID     solanago.PublicKey
+	Discriminator [8]byte
+	Data          []byte // event data without the discriminator
+}
+
+// Is checks if the event discriminator matches the Anchor event name
+func (e AnchorEvent) Is(name string) bool {
+	sum := sha256.Sum256([]byte("event:" + name))
+	return [8]byte(sum[:8]) == e.Discriminator
+}
+
+// SimulationErrorKind classifies simulation errors
+// https://github.com/anza-xyz/agave/blob/master/sdk/src/transaction/error.rs
+type SimulationErrorKind string
+
+const (
+	SimulationErrorInstruction       SimulationErrorKind = "InstructionError"
+	SimulationErrorBlockhashNotFound SimulationErrorKind = "BlockhashNotFound"
+	SimulationErrorAlreadyProcessed  SimulationErrorKind = "AlreadyProcessed"
+	SimulationErrorInsufficientFunds SimulationErrorKind = "InsufficientFundsForFee"
+	SimulationErrorAccountNotFound   SimulationErrorKind = "AccountNotFound"
+	SimulationErrorOther             SimulationErrorKind = "Other"
+)
+
+// SimulationError is a decoded transaction error returned by simulation
+type SimulationError struct {
+	Kind SimulationErrorKind
+	// Name is the raw transaction or instruction error name (e.g. InvalidAccountData, Custom)
+	Name string
+	// InstructionIndex is set for instruction errors
+	InstructionIndex *int
+	// CustomCode is set for program errors (e.g. Anchor error codes)
+	CustomCode *uint32
+	// Raw is the error as returned by the RPC
+	Raw interface{}
+}
+
+func (e *SimulationError) Error() string {
+	switch {
+	case e.InstructionIndex != nil && e.CustomCode != nil:
+		return fmt.Sprintf("simulation failed: %s at instruction %d: custom program error %d (0x%x)", e.Kind, *e.InstructionIndex, *e.CustomCode, *e.CustomCode)
+	case e.InstructionIndex != nil:
+		return fmt.Sprintf("simulation failed: %s at instruction %d: %s", e.Kind, *e.InstructionIndex, e.Name)
+	default:
+		return fmt.Sprintf("simulation failed: %s: %v", e.Kind, e.Raw)
+	}
+}
+
+// Simulate dry-runs a transaction without submitting it through txm
+func (c *chain) Simulate(ctx context.Context, tx *solanago.Transaction, opts SimulateOpts) (*SimulateResult, error) {
+	reader, err := c.getClient()
+	if err != nil {
+		return nil, fmt.Errorf("chain unreachable: %w", err)
+	}
+	if opts.Commitment == "" {
+		opts.Commitment = c.cfg.Commitment()
+	}
+	return simulate(ctx, reader, tx, opts)
+}
+
+func simulate(ctx context.Context, rw client.ReaderWriter, tx *solanago.Transaction, opts SimulateOpts) (*SimulateResult, error) {
+	if tx == nil {
+		return nil, errors.New("tx is nil pointer")
+	}
+	if opts.SigVerify && opts.ReplaceRecentBlockhash {
+		return nil, errors.New("SigVerify and ReplaceRecentBlockhash cannot both be enabled")
+	}
+
+	// read pre-simulation state for requested accounts at the simulation commitment
+	// later reads are not served from a slot older than the first read
+	diffs := make([]AccountDiff, len(opts.Accounts))
+	var preSlot uint64
+	for i, addr := range opts.Accounts {
+		diffs[i].Address = addr
+		accountOpts := &rpc.GetAccountInfoOpts{Encoding: solanago.EncodingBase64, Commitment: opts.Commitment}
+		if preSlot != 0 {
+			minSlot := preSlot
+			accountOpts.MinContextSlot = &minSlot
+		}
+		info, err := rw.GetAccountInfoWithCommitment(ctx, addr, accountOpts)
+		if errors.Is(err, rpc.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read account %s: %w", addr, err)
+		}
+		if info == nil {
+			continue
+		}
+		preSlot = max(preSlot, info.Context.Slot)
+		if info.Value == nil {
+			continue
+		}
+		diffs[i].PreExists = true
+		diffs[i].PreLamports = info.Value.Lamports
+		diffs[i].PreOwner = info.Value.Owner
+		if info.Value.Data != nil {
+			diffs[i].PreData = info.Value.Data.GetBinary()
+		}
+	}
+
+	simOpts := &rpc.SimulateTransactionOpts{
+		SigVerify:              opts.SigVerify,
+		Commitment:             opts.Commitment,
+		ReplaceRecentBlockhash: opts.ReplaceRecentBlockhash,
+	}
+	if len(opts.Accounts) > 0 {
+		simOpts.Accounts = &rpc.SimulateTransactionAccountsOpts{
+			Encoding:  solanago.EncodingBase64,
+			Addresses: opts.Accounts,
+		}
+	}
+	res, err := rw.SimulateTx(ctx, tx, simOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to simulate tx: %w", err)
+	}
+
+	out := &SimulateResult{
+		Logs:         res.Logs,
+		Events:       parseAnchorEvents(res.Logs),
+		AccountDiffs: diffs,
+		PreSlot:      preSlot,
+		Err:          classifySimulationError(res.Err),
+	}
+	if res.UnitsConsumed != nil {
+		out.UnitsConsumed = *res.UnitsConsumed
+	}
+
+	// post-simulation state is returned in the same order as the requested accounts
+	for i := range res.Accounts {
+		if i >= len(diffs) || res.Accounts[i] == nil {
+			continue
+		}
+		diffs[i].PostExists = true
+		diffs[i].PostLamports = res.Accounts[i].Lamports
+		diffs[i].PostOwner = res.Accounts[i].Owner
+		if res.Accounts[i].Data != nil {
+			diffs[i].PostData = res.Accounts[i].Data.GetBinary()
+		}
+	}
+	return out, nil
+}
+
+var (
+	logProgramInvoke = regexp.MustCompile(`^Program\s([1-9A-HJ-NP-Za-km-z]+)\sinvoke\s\[\d+\]$`)
+	logProgramExit   = regexp.MustCompile(`^Program\s([1-9A-HJ-NP-Za-km-z]+)\s(?:success|failed.*)$`)
+	logProgramData   = regexp.MustCompile(`^Program\sdata:\s([+/0-9A-Za-z]+={0,2})$`)
+)
+
+// parseAnchorEvents extracts events from program logs and attributes them to the invoking program
+func parseAnchorEvents(logs []string) []AnchorEvent {
+	var stack []solanago.PublicKey
+	var events []AnchorEvent
+	for _, log := range logs {
+		if matches := logProgramInvoke.FindStringSubmatch(log); matches != nil {
+			programID, err := solanago.PublicKeyFromBase58(matches[1])
+			if err != nil {
+				return events // malformed execution trace
+			}
+			stack = append(stack, programID)
+			continue
+		}
+		if matches := logProgramData.FindStringSubmatch(log); matches != nil {
+			if len(stack) == 0 {
+				continue
+			}
+			raw, err := base64.StdEncoding.DecodeString(matches[1])
+			if err != nil || len(raw) < 8 {
+				continue // not an anchor event
+			}
+			events = append(events, AnchorEvent{
+				ProgramID:     stack[len(stack)-1],
+				Discriminator: [8]byte(raw[:8]),
+				Data:          raw[8:],
+			})
+			continue
+		}
+		if logProgramExit.MatchString(log) && len(stack) > 0 {
+			stack = stack[:len(stack)-1]
+		}
+	}
+	return events
+}
+
+// classifySimulationError decodes the RPC transaction error
+// errors are either a string (e.g. "BlockhashNotFound") or an object (e.g. {"InstructionError":[0,{"Custom":1}]})
+func classifySimulationError(raw interface{}) *SimulationError {
+	if raw == nil {
+		return nil
+	}
+	out := &SimulationError{Kind: SimulationErrorOther, Raw: raw}
+
+	switch v := raw.(type) {
+	case string:
+		out.Name = v
+	case map[string]interface{}:
+		for name, detail := range v {
+			out.Name = name
+			if name != string(SimulationErrorInstruction) {
+				break
+			}
+			out.Kind = SimulationErrorInstruction
+			parts, ok := detail.([]interface{})
+			if !ok || len(parts) != 2 {
+				return out
+			}
+			if index, ok := toUint64(parts[0]); ok {
+				i := int(index) //nolint:gosec // instruction index is bounded by tx size
+				out.InstructionIndex = &i
+			}
+			switch instructionErr := parts[1].(type) {
+			case string:
+				out.Name = instructionErr
+			case map[string]interface{}:
+				for errName, errDetail := range instructionErr {
+					out.Name = errName
+					if code, ok := toUint64(errDetail); ok && errName == "Custom" {
+						c := uint32(code) //nolint:gosec // custom program errors are u32
+						out.CustomCode = &c
+					}
+				}
+			}
+			return out
+		}
+	}
+
+	switch SimulationErrorKind(out.Name) {
+	case SimulationErrorBlockhashNotFound, SimulationErrorAlreadyProcessed, SimulationErrorInsufficientFunds, SimulationErrorAccountNotFound:
+		out.Kind = SimulationErrorKind(out.Name)
+	}
+	return out
+}
+
+func toUint64(v interface{}) (uint64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return uint64(n), n >= 0
+	case json.Number:
+		u, err := strconv.ParseUint(n.String(), 10, 64)
+		return u, err == nil
+	case int:
+		return uint64(n), n >= 0
+	case int64:
+		return uint64(n), n >= 0
+	case uint64:
+		return n, true
+	}
+	return 0, false
+}
@@ -0,0 +1,152 @@
+package solana
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/client/mocks"
+)
+
+func TestSimulate(t *testing.T) {
+	ctx := tests.Context(t)
+	rw := mocks.NewReaderWriter(t)
+
+	from, to, missing := solana.PublicKey{1}, solana.PublicKey{2}, solana.PublicKey{3}
+	tx, err := solana.NewTransaction(
+		[]solana.Instruction{system.NewTransferInstruction(10, from, to).Build()},
+		solana.Hash{},
+		solana.TransactionPayer(from),
+	)
+	require.NoError(t, err)
+
+	// invalid inputs
+	_, err = simulate(ctx, rw, nil, SimulateOpts{})
+	require.ErrorContains(t, err, "tx is nil")
+	_, err = simulate(ctx, rw, tx, SimulateOpts{SigVerify: true, ReplaceRecentBlockhash: true})
+	require.ErrorContains(t, err, "cannot both be enabled")
+
+	programID := solana.PublicKey{4}
+	eventName := sha256.Sum256([]byte("event:NewTransmission"))
+	eventData := base64.StdEncoding.EncodeToString(append(eventName[:8], 1, 2, 3))
+	units := uint64(450)
+	postData, err := rpc.DataBytesOrJSONFromBase64(base64.StdEncoding.EncodeToString([]byte{9}))
+	require.NoError(t, err)
+
+	// pre-simulation state is read at the simulation commitment, later reads are not older than the first read
+	rw.On("GetAccountInfoWithCommitment", mock.Anything, from, mock.MatchedBy(func(opts *rpc.GetAccountInfoOpts) bool {
+		return opts.Commitment == rpc.CommitmentConfirmed && opts.MinContextSlot == nil
+	})).Return(&rpc.GetAccountInfoResult{
+		RPCContext: rpc.RPCContext{Context: rpc.Context{Slot: 42}},
+		Value:      &rpc.Account{Lamports: 100, Owner: solana.SystemProgramID},
+	}, nil).Once()
+	rw.On("GetAccountInfoWithCommitment", mock.Anything, missing, mock.MatchedBy(func(opts *rpc.GetAccountInfoOpts) bool {
+		return opts.Commitment == rpc.CommitmentConfirmed && opts.MinContextSlot != nil && *opts.MinContextSlot == 42
+	})).Return(nil, rpc.ErrNotFound).Once()
+	rw.On("SimulateTx", mock.Anything, tx, mock.MatchedBy(func(opts *rpc.SimulateTransactionOpts) bool {
+		return opts.ReplaceRecentBlockhash && opts.Commitment == rpc.CommitmentConfirmed &&
+			opts.Accounts != nil && len(opts.Accounts.Addresses) == 2
+	})).Return(&rpc.SimulateTransactionResult{
+		Logs: []string{
+			"Program " + programID.String() + " invoke [1]",
+			"Program log: Instruction: Transmit",
+			"Program " + solana.SystemProgramID.String() + " invoke [2]",
+			"Program data: " + base64.StdEncoding.EncodeToString([]byte{1}), // too short, ignored
+			"Program " + solana.SystemProgramID.String() + " success",
+			"Program data: " + eventData,
+			"Program " + programID.String() + " success",
+		},
+		UnitsConsumed: &units,
+		Accounts: []*rpc.Account{
+			{Lamports: 90, Owner: solana.SystemProgramID},
+			{Lamports: 1, Owner: programID, Data: postData},
+		},
+	}, nil).Once()
+
+	res, err := simulate(ctx, rw, tx, SimulateOpts{
+		Accounts:               []solana.PublicKey{from, missing},
+		ReplaceRecentBlockhash: true,
+		Commitment:             rpc.CommitmentConfirmed,
+	})
+	require.NoError(t, err)
+	assert.Nil(t, res.Err)
+	assert.Equal(t, units, res.UnitsConsumed)
+	assert.Equal(t, uint64(42), res.PreSlot)
+	assert.Len(t, res.Logs, 7)
+
+	require.Len(t, res.Events, 1)
+	assert.Equal(t, programID, res.Events[0].ProgramID)
+	assert.True(t, res.Events[0].Is("NewTransmission"))
+	assert.False(t, res.Events[0].Is("SetConfig"))
+	assert.Equal(t, []byte{1, 2, 3}, res.Events[0].Data)
+
+	require.Len(t, res.AccountDiffs, 2)
+	assert.Equal(t, AccountDiff{
+		Address:      from,
+		PreExists:    true,
+		PostExists:   true,
+		PreLamports:  100,
+		PostLamports: 90,
+		PreOwner:     solana.SystemProgramID,
+		PostOwner:    solana.SystemProgramID,
+	}, res.AccountDiffs[0])
+	assert.Equal(t, int64(-10), res.AccountDiffs[0].LamportsDelta())
+	assert.False(t, res.AccountDiffs[1].PreExists)
+	assert.True(t, res.AccountDiffs[1].PostExists)
+	assert.Equal(t, []byte{9}, res.AccountDiffs[1].PostData)
+
+	// simulation error is classified
+	rw.On("SimulateTx", mock.Anything, tx, mock.Anything).Return(&rpc.SimulateTransactionResult{
+		Err: "BlockhashNotFound",
+	}, nil).Once()
+	res, err = simulate(ctx, rw, tx, SimulateOpts{})
+	require.NoError(t, err)
+	require.NotNil(t, res.Err)
+	assert.Equal(t, SimulationErrorBlockhashNotFound, res.Err.Kind)
+	assert.Empty(t, res.AccountDiffs)
+}
+
+func TestClassifySimulationError(t *testing.T) {
+	decode := func(s string) interface{} {
+		var out interface{}
+		require.NoError(t, json.Unmarshal([]byte(s), &out))
+		return out
+	}
+
+	assert.Nil(t, classifySimulationError(nil))
+
+	simErr := classifySimulationError(decode(`"AlreadyProcessed"`))
+	assert.Equal(t, SimulationErrorAlreadyProcessed, simErr.Kind)
+
+	simErr = classifySimulationError(decode(`"SanitizeFailure"`))
+	assert.Equal(t, SimulationErrorOther, simErr.Kind)
+	assert.Equal(t, "SanitizeFailure", simErr.Name)
+
+	simErr = classifySimulationError(decode(`{"InstructionError":[1,{"Custom":6001}]}`))
+	assert.Equal(t, SimulationErrorInstruction, simErr.Kind)
+	require.NotNil(t, simErr.InstructionIndex)
+	assert.Equal(t, 1, *simErr.InstructionIndex)
+	require.NotNil(t, simErr.CustomCode)
+	assert.Equal(t, uint32(6001), *simErr.CustomCode)
+	assert.Contains(t, simErr.Error(), "custom program error 6001 (0x1771)")
+
+	simErr = classifySimulationError(decode(`{"InstructionError":[0,"InvalidAccountData"]}`))
+	assert.Equal(t, SimulationErrorInstruction, simErr.Kind)
+	assert.Equal(t, "InvalidAccountData", simErr.Name)
+	assert.Nil(t, simErr.CustomCode)
+	assert.Contains(t, simErr.Error(), "at instruction 0: InvalidAccountData")
+
+	simErr = classifySimulationError(decode(`{"InsufficientFundsForRent":{"account_index":2}}`))
+	assert.Equal(t, SimulationErrorOther, simErr.Kind)
+	assert.Equal(t, "InsufficientFundsForRent", simErr.Name)
+}
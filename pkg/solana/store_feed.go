@@ -0,0 +1,300 @@
+package solana
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/client"
+)
+
+// ErrRoundNotFound is returned when a round is not stored in the feed (not yet reported, or overwritten)
+var ErrRoundNotFound = errors.New("round not found in feed")
+
+// Round is a transmission stored in a store program feed
+type Round struct {
+	RoundID   uint32
+	Slot      uint64
+	Timestamp uint32
+	Answer    *big.Int
+}
+
+// Time returns the round timestamp
+func (r Round) Time() time.Time {
+	return time.Unix(int64(r.Timestamp), 0)
+}
+
+// StoreFeedReader reads rounds from a store program feed (transmissions account).
+// A feed stores the latest LiveLength rounds in a live ring buffer, and every Granularity-th round in a historical
+// ring buffer which fills the rest of the account. Each read fetches the header with the ring buffers it needs in a
+// single request so results are consistent with a single slot.
+type StoreFeedReader struct {
+	reader     client.AccountReader
+	feed       solana.PublicKey
+	commitment rpc.CommitmentType
+}
+
+func NewStoreFeedReader(reader client.AccountReader, feed solana.PublicKey, commitment rpc.CommitmentType) *StoreFeedReader {
+	return &StoreFeedReader{reader: reader, feed: feed, commitment: commitment}
+}
+
+// GetHeader returns the feed header
+func (r *StoreFeedReader) GetHeader(ctx context.Context) (TransmissionsHeader, uint64, error) {
+	length := TransmissionsHeaderLen
+	f, slot, err := r.read(ctx, &length)
+	return f.header, slot, err
+}
+
+// GetRound returns the round with roundID. Rounds outside of the live buffer are read from the historical buffer,
+// which rounds down to the nearest stored round (the returned RoundID is the stored round).
+func (r *StoreFeedReader) GetRound(ctx context.Context, roundID uint32) (Round, error) {
+	header, _, err := r.GetHeader(ctx)
+	if err != nil {
+		return Round{}, err
+	}
+	// historical rounds require reading the full account
+	var length *uint64
+	if roundID >= liveStart(header) {
+		length = liveBufferLen(header)
+	}
+	f, _, err := r.read(ctx, length)
+	if err != nil {
+		return Round{}, err
+	}
+	round, ok := f.fetch(roundID)
+	if !ok && length != nil && roundID < liveStart(f.header) {
+		// round left the live buffer since reading the header
+		if f, _, err = r.read(ctx, nil); err != nil {
+			return Round{}, err
+		}
+		round, ok = f.fetch(roundID)
+	}
+	if !ok {
+		return Round{}, fmt.Errorf("%w: round %d (latest %d)", ErrRoundNotFound, roundID, f.header.LatestRoundID)
+	}
+	return round, nil
+}
+
+// GetLatestRounds returns up to the last n rounds from the live buffer, ordered by round ID
+func (r *StoreFeedReader) GetLatestRounds(ctx context.Context, n int) ([]Round, error) {
+	header, _, err := r.GetHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	f, _, err := r.read(ctx, liveBufferLen(header))
+	if err != nil {
+		return nil, err
+	}
+	rounds := f.liveRounds()
+	if n >= 0 && len(rounds) > n {
+		rounds = rounds[len(rounds)-n:]
+	}
+	return rounds, nil
+}
+
+// GetRoundsByTime returns the stored rounds with timestamps in [start, end], ordered by round ID.
+// Rounds older than the live buffer are only available at the feed granularity.
+func (r *StoreFeedReader) GetRoundsByTime(ctx context.Context, start, end time.Time) ([]Round, error) {
+	if end.Before(start) {
+		return nil, fmt.Errorf("invalid time range: end %s before start %s", end, start)
+	}
+	header, _, err := r.GetHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	f, _, err := r.read(ctx, liveBufferLen(header))
+	if err != nil {
+		return nil, err
+	}
+	rounds := f.liveRounds()
+	// the live buffer does not cover the start of the range, read the historical buffer
+	if len(rounds) > 0 && rounds[0].RoundID > 1 && rounds[0].Time().After(start) {
+		if f, _, err = r.read(ctx, nil); err != nil {
+			return nil, err
+		}
+		rounds = f.rounds()
+	}
+
+	var out []Round
+	for _, round := range rounds {
+		if t := round.Time(); !t.Before(start) && !t.After(end) {
+			out = append(out, round)
+		}
+	}
+	return out, nil
+}
+
+// read fetches length bytes of the feed after the account discriminator, or the full account if length is nil
+func (r *StoreFeedReader) read(ctx context.Context, length *uint64) (storeFeed, uint64, error) {
+	opts := &rpc.GetAccountInfoOpts{
+		Encoding:   "base64",
+		Commitment: r.commitment,
+	}
+	if length != nil {
+		offset := AccountDiscriminatorLen
+		opts.DataSlice = &rpc.DataSlice{Offset: &offset, Length: length}
+	}
+	res, err := r.reader.GetAccountInfoWithOpts(ctx, r.feed, opts)
+	if err != nil {
+		return storeFeed{}, 0, fmt.Errorf("error on rpc.GetAccountInfo [feed]: %w", err)
+	}
+	if res == nil || res.Value == nil || res.Value.Data == nil {
+		return storeFeed{}, 0, errors.New("nil pointer returned in StoreFeedReader.GetAccountInfoWithOpts")
+	}
+
+	data := res.Value.Data.GetBinary()
+	if length == nil {
+		if uint64(len(data)) < AccountDiscriminatorLen {
+			return storeFeed{}, 0, fmt.Errorf("feed account too short: %d bytes", len(data))
+		}
+		data = data[AccountDiscriminatorLen:]
+	}
+	f, err := decodeStoreFeed(data)
+	return f, res.RPCContext.Context.Slot, err
+}
+
+func liveStart(header TransmissionsHeader) uint32 {
+	if header.LatestRoundID < header.LiveLength {
+		return 1
+	}
+	return header.LatestRoundID - header.LiveLength + 1
+}
+
+// liveBufferLen is the length of the header and the live buffer
+func liveBufferLen(header TransmissionsHeader) *uint64 {
+	length := TransmissionsHeaderMaxSize + uint64(header.LiveLength)*TransmissionLen
+	return &length
+}
+
+// storeFeed is a snapshot of the feed header and the (partially) read ring buffers
+type storeFeed struct {
+	header     TransmissionsHeader
+	live       []Transmission
+	historical []Transmission
+}
+
+// decodeStoreFeed decodes the feed from data after the account discriminator
+func decodeStoreFeed(data []byte) (storeFeed, error) {
+	var f storeFeed
+	if err := bin.NewBinDecoder(data).Decode(&f.header); err != nil {
+		return f, fmt.Errorf("failed to decode transmission account header: %w", err)
+	}
+	if f.header.Version != 2 {
+		return f, fmt.Errorf("can't parse feed version %v", f.header.Version)
+	}
+	if f.header.LiveLength == 0 || f.header.Granularity == 0 {
+		return f, fmt.Errorf("invalid feed header: live length %d, granularity %d", f.header.LiveLength, f.header.Granularity)
+	}
+	if uint64(len(data)) <= TransmissionsHeaderMaxSize {
+		return f, nil
+	}
+
+	buffers := data[TransmissionsHeaderMaxSize:]
+	liveLen := min(uint64(len(buffers)), uint64(f.header.LiveLength)*TransmissionLen)
+	var err error
+	if f.live, err = decodeTransmissions(buffers[:liveLen]); err != nil {
+		return f, err
+	}
+	if f.historical, err = decodeTransmissions(buffers[liveLen:]); err != nil {
+		return f, err
+	}
+	return f, nil
+}
+
+func decodeTransmissions(data []byte) ([]Transmission, error) {
+	ts := make([]Transmission, uint64(len(data))/TransmissionLen)
+	for i := range ts {
+		if err := bin.NewBinDecoder(data[uint64(i)*TransmissionLen:]).Decode(&ts[i]); err != nil {
+			return nil, fmt.Errorf("failed to decode transmission: %w", err)
+		}
+	}
+	return ts, nil
+}
+
+// fetch mirrors the store program Feed::fetch, returning the round or the closest preceding historical round
+func (f storeFeed) fetch(roundID uint32) (Round, bool) {
+	latest := f.header.LatestRoundID
+	if roundID == 0 || roundID > latest {
+		return Round{}, false
+	}
+	if roundID >= liveStart(f.header) {
+		return f.liveRound(latest - roundID)
+	}
+	granularity := uint32(f.header.Granularity)
+	historicalEnd := latest - latest%granularity
+	rounded := roundID - roundID%granularity
+	if rounded == 0 {
+		return Round{}, false
+	}
+	return f.historicalRound((historicalEnd - rounded) / granularity)
+}
+
+// liveRound returns the round offset rounds before the latest round
+func (f storeFeed) liveRound(offset uint32) (Round, bool) {
+	length := f.header.LiveLength
+	if offset >= length || uint32(len(f.live)) != length {
+		return Round{}, false
+	}
+	index := (f.header.LiveCursor + length - 1 - offset) % length
+	return newRound(f.header.LatestRoundID-offset, f.live[index]), true
+}
+
+// historicalRound returns the historical round offset entries before the latest historical round
+func (f storeFeed) historicalRound(offset uint32) (Round, bool) {
+	length := uint32(len(f.historical))
+	granularity := uint32(f.header.Granularity)
+	latest := f.header.LatestRoundID - f.header.LatestRoundID%granularity
+	// historical entries are only written once the round is reached, and older entries are overwritten
+	if offset >= length || offset >= latest/granularity {
+		return Round{}, false
+	}
+	index := (f.header.HistoricalCursor + length - 1 - offset) % length
+	return newRound(latest-offset*granularity, f.historical[index]), true
+}
+
+// liveRounds returns the rounds in the live buffer ordered by round ID
+func (f storeFeed) liveRounds() []Round {
+	start := liveStart(f.header)
+	var rounds []Round
+	for id := start; id != 0 && id <= f.header.LatestRoundID; id++ {
+		if round, ok := f.liveRound(f.header.LatestRoundID - id); ok {
+			rounds = append(rounds, round)
+		}
+	}
+	return rounds
+}
+
+// rounds returns the historical rounds preceding the live buffer followed by the live rounds, ordered by round ID
+func (f storeFeed) rounds() []Round {
+	start := liveStart(f.header)
+	var historical []Round
+	for offset := uint32(0); ; offset++ {
+		round, ok := f.historicalRound(offset)
+		if !ok {
+			break
+		}
+		if round.RoundID < start {
+			historical = append(historical, round)
+		}
+	}
+	rounds := make([]Round, 0, len(historical))
+	for i := len(historical) - 1; i >= 0; i-- {
+		rounds = append(rounds, historical[i])
+	}
+	return append(rounds, f.liveRounds()...)
+}
+
+func newRound(roundID uint32, t Transmission) Round {
+	return Round{
+		RoundID:   roundID,
+		Slot:      t.Slot,
+		Timestamp: t.Timestamp,
+		Answer:    t.Answer.BigInt(),
+	}
+}
@@ -0,0 +1,139 @@
+package solana
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/client/mocks"
+)
+
+// testFeed builds a feed account mirroring the store program insert logic
+func testFeed(t *testing.T, liveLength, historicalLength, granularity, rounds uint32) []byte {
+	header := TransmissionsHeader{Version: 2, Granularity: uint8(granularity), LiveLength: liveLength}
+	live := make([]Transmission, liveLength)
+	historical := make([]Transmission, historicalLength)
+	for i := uint32(1); i <= rounds; i++ {
+		tr := Transmission{Slot: uint64(i), Timestamp: 1000 + i, Answer: bin.Int128{Lo: uint64(i)}}
+		header.LatestRoundID++
+		live[header.LiveCursor] = tr
+		header.LiveCursor = (header.LiveCursor + 1) % liveLength
+		if historicalLength > 0 && header.LatestRoundID%granularity == 0 {
+			historical[header.HistoricalCursor] = tr
+			header.HistoricalCursor = (header.HistoricalCursor + 1) % historicalLength
+		}
+	}
+
+	buf := new(bytes.Buffer)
+	buf.Write(make([]byte, AccountDiscriminatorLen))
+	require.NoError(t, bin.NewBinEncoder(buf).Encode(header))
+	buf.Write(make([]byte, AccountDiscriminatorLen+TransmissionsHeaderMaxSize-uint64(buf.Len())))
+	for _, tr := range append(live, historical...) {
+		require.NoError(t, bin.NewBinEncoder(buf).Encode(tr))
+	}
+	return buf.Bytes()
+}
+
+// mockFeedReads serves account reads with data slices from data
+func mockFeedReads(rw *mocks.ReaderWriter, feed solana.PublicKey, data []byte) {
+	mockFeedReadsFunc(rw, feed, func() []byte { return data })
+}
+
+// mockFeedReadsFunc serves account reads with data slices from the current data
+func mockFeedReadsFunc(rw *mocks.ReaderWriter, feed solana.PublicKey, current func() []byte) {
+	rw.On("GetAccountInfoWithOpts", mock.Anything, feed, mock.Anything).Return(func(_ context.Context, _ solana.PublicKey, opts *rpc.GetAccountInfoOpts) *rpc.GetAccountInfoResult {
+		data := current()
+		out := data
+		if opts.DataSlice != nil {
+			out = data[*opts.DataSlice.Offset:min(uint64(len(data)), *opts.DataSlice.Offset+*opts.DataSlice.Length)]
+		}
+		return &rpc.GetAccountInfoResult{
+			RPCContext: rpc.RPCContext{Context: rpc.Context{Slot: 1}},
+			Value:      &rpc.Account{Data: rpc.DataBytesOrJSONFromBytes(out)},
+		}
+	}, nil)
+}
+
+func roundIDs(t *testing.T, rounds []Round) []uint32 {
+	ids := make([]uint32, len(rounds))
+	for i, r := range rounds {
+		ids[i] = r.RoundID
+		assert.Equal(t, uint64(r.RoundID), r.Answer.Uint64())
+	}
+	return ids
+}
+
+func TestStoreFeedReader(t *testing.T) {
+	ctx := tests.Context(t)
+	feed := solana.PublicKey{1}
+	rw := mocks.NewReaderWriter(t)
+	mockFeedReads(rw, feed, testFeed(t, 2, 3, 5, 20))
+	reader := NewStoreFeedReader(rw, feed, rpc.CommitmentConfirmed)
+
+	header, slot, err := reader.GetHeader(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(20), header.LatestRoundID)
+	assert.Equal(t, uint64(1), slot)
+
+	// same cases as the store program tests
+	for roundID, expected := range map[uint32]uint32{20: 20, 19: 19, 18: 15, 15: 15, 14: 10, 10: 10} {
+		round, err := reader.GetRound(ctx, roundID)
+		require.NoError(t, err)
+		assert.Equal(t, expected, round.RoundID)
+		assert.Equal(t, uint64(expected), round.Slot)
+		assert.Equal(t, 1000+expected, round.Timestamp)
+		assert.Equal(t, uint64(expected), round.Answer.Uint64())
+	}
+	for _, roundID := range []uint32{0, 9, 21} {
+		_, err = reader.GetRound(ctx, roundID)
+		require.ErrorIs(t, err, ErrRoundNotFound)
+	}
+
+	rounds, err := reader.GetLatestRounds(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{20}, roundIDs(t, rounds))
+	rounds, err = reader.GetLatestRounds(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{19, 20}, roundIDs(t, rounds))
+
+	// live range only
+	rounds, err = reader.GetRoundsByTime(ctx, time.Unix(1019, 0), time.Unix(1030, 0))
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{19, 20}, roundIDs(t, rounds))
+	// includes historical rounds at the feed granularity
+	rounds, err = reader.GetRoundsByTime(ctx, time.Unix(0, 0), time.Unix(1019, 0))
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{10, 15, 19}, roundIDs(t, rounds))
+
+	_, err = reader.GetRoundsByTime(ctx, time.Unix(1, 0), time.Unix(0, 0))
+	require.Error(t, err)
+}
+
+func TestStoreFeedReader_PartiallyFilled(t *testing.T) {
+	ctx := tests.Context(t)
+	feed := solana.PublicKey{1}
+	rw := mocks.NewReaderWriter(t)
+	mockFeedReads(rw, feed, testFeed(t, 4, 2, 2, 3))
+	reader := NewStoreFeedReader(rw, feed, rpc.CommitmentConfirmed)
+
+	rounds, err := reader.GetLatestRounds(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{1, 2, 3}, roundIDs(t, rounds))
+
+	rounds, err = reader.GetRoundsByTime(ctx, time.Unix(0, 0), time.Unix(2000, 0))
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{1, 2, 3}, roundIDs(t, rounds))
+
+	_, err = reader.GetRound(ctx, 4)
+	require.ErrorIs(t, err, ErrRoundNotFound)
+}
@@ -0,0 +1,316 @@
+package solana
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	solanago "github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/token"
+	"github.com/gagliardetto/solana-go/rpc"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/client"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/txm"
+)
+
+// Token2022ProgramID is the SPL Token-2022 (token extensions) program
+var Token2022ProgramID = solanago.MustPublicKeyFromBase58("TokenzQdBNbLqP5VEhdkAS6EPFLC1PHnBqCXEpPxuEb")
+
+const (
+	// mint and token account layouts are shared by SPL Token and Token-2022 (extensions are appended)
+	mintDecimalsOffset       = 44 // mint_authority (36) + supply (8)
+	tokenAccountMintOffset   = 0
+	tokenAccountOwnerOffset  = 32
+	tokenAccountAmountOffset = 64
+	tokenAccountLen          = 165
+	mintLen                  = 82
+	multisigLen              = 355
+
+	// token-2022 extensions are appended after the base account (mints are padded to tokenAccountLen)
+	// as an account type byte followed by TLV entries (type u16 + length u16 + value)
+	token2022AccountTypeMint    = 1
+	token2022ExtensionHeaderLen = 4
+	// mint extension types up to TokenGroupMember are known
+	token2022MaxKnownExtension = 23
+
+	// compute units used by TransferChecked (~6.2K) and CreateIdempotent (~25K for token-2022)
+	tokenTransferComputeUnits      = 10_000
+	createTokenAccountComputeUnits = 40_000
+)
+
+// token2022AccountExtensionLen is the length of the account extension required by a mint extension,
+// token accounts of the mint are created with these extensions
+var token2022AccountExtensionLen = map[uint16]uint64{
+	1:  8,  // TransferFeeConfig -> TransferFeeAmount
+	9:  0,  // NonTransferable -> NonTransferableAccount
+	14: 1,  // TransferHook -> TransferHookAccount
+	16: 64, // ConfidentialTransferFeeConfig -> ConfidentialTransferFeeAmount
+}
+
+// TransactToken transfers SPL Token or Token-2022 tokens of mint from the associated token account of from.
+// to can either be a wallet, in which case its associated token account is used (and created if it does not exist),
+// or an existing token account for the mint.
+// Token-2022 mints with transfer hooks are not supported.
+func (c *chain) TransactToken(ctx context.Context, from, to, mint string, amount *big.Int, balanceCheck bool) error {
+	reader, err := c.Reader()
+	if err != nil {
+		return fmt.Errorf("chain unreachable: %w", err)
+	}
+
+	fromKey, err := solanago.PublicKeyFromBase58(from)
+	if err != nil {
+		return fmt.Errorf("failed to parse from key: %w", err)
+	}
+	toKey, err := solanago.PublicKeyFromBase58(to)
+	if err != nil {
+		return fmt.Errorf("failed to parse to key: %w", err)
+	}
+	mintKey, err := solanago.PublicKeyFromBase58(mint)
+	if err != nil {
+		return fmt.Errorf("failed to parse mint key: %w", err)
+	}
+	if !amount.IsUint64() {
+		return fmt.Errorf("amount %s overflows uint64", amount)
+	}
+
+	tx, computeUnits, err := buildTokenTransfer(ctx, reader, fromKey, toKey, mintKey, amount.Uint64(), balanceCheck)
+	if err != nil {
+		return err
+	}
+
+	chainTxm := c.TxManager()
+	err = chainTxm.Enqueue(ctx, "", tx, nil,
+		txm.SetComputeUnitLimit(computeUnits),
+		// no fee bumping and no additional fee - makes validating balance accurate
+		txm.SetComputeUnitPriceMax(0),
+		txm.SetComputeUnitPriceMin(0),
+		txm.SetBaseComputeUnitPrice(0),
+		txm.SetFeeBumpPeriod(0),
+	)
+	if err != nil {
+		return fmt.Errorf("transaction failed: %w", err)
+	}
+	return nil
+}
+
+// buildTokenTransfer builds a TransferChecked transaction, creating the destination associated token account if needed
+func buildTokenTransfer(ctx context.Context, reader client.Reader, from, to, mint solanago.PublicKey, amount uint64, balanceCheck bool) (*solanago.Transaction, uint32, error) {
+	mintInfo, err := getAccount(ctx, reader, mint)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get mint: %w", err)
+	}
+	if mintInfo == nil {
+		return nil, 0, fmt.Errorf("mint %s not found", mint)
+	}
+	tokenProgram := mintInfo.Owner
+	if !tokenProgram.Equals(token.ProgramID) && !tokenProgram.Equals(Token2022ProgramID) {
+		return nil, 0, fmt.Errorf("mint %s is owned by %s, not a token program", mint, tokenProgram)
+	}
+	mintData := mintInfo.Data.GetBinary()
+	if len(mintData) <= mintDecimalsOffset {
+		return nil, 0, fmt.Errorf("invalid mint %s: data too short (%d bytes)", mint, len(mintData))
+	}
+	decimals := mintData[mintDecimalsOffset]
+
+	source, err := FindAssociatedTokenAddress(from, mint, tokenProgram)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to derive source token account: %w", err)
+	}
+
+	var instructions []solanago.Instruction
+	computeUnits := uint32(tokenTransferComputeUnits)
+	createAccount := false
+
+	// resolve destination token account
+	destination := to
+	toInfo, err := getAccount(ctx, reader, to)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get destination: %w", err)
+	}
+	if toInfo == nil || !toInfo.Owner.Equals(tokenProgram) {
+		// destination is a wallet, use the associated token account
+		destination, err = FindAssociatedTokenAddress(to, mint, tokenProgram)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to derive destination token account: %w", err)
+		}
+		ataInfo, err := getAccount(ctx, reader, destination)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to get destination token account: %w", err)
+		}
+		if ataInfo == nil {
+			instructions = append(instructions, NewCreateIdempotentAssociatedTokenAccountInstruction(from, to, mint, tokenProgram))
+			computeUnits += createTokenAccountComputeUnits
+			createAccount = true
+		}
+	} else if err = validateTokenAccount(toInfo.Data.GetBinary(), mint, nil); err != nil {
+		return nil, 0, fmt.Errorf("invalid destination token account %s: %w", to, err)
+	}
+
+	transfer, err := NewTransferCheckedInstruction(tokenProgram, amount, decimals, source, mint, destination, from)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to build transfer instruction: %w", err)
+	}
+	instructions = append(instructions, transfer)
+
+	blockhash, err := reader.LatestBlockhash(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get latest block hash: %w", err)
+	}
+	tx, err := solanago.NewTransaction(instructions, blockhash.Value.Blockhash, solanago.TransactionPayer(from))
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to create tx: %w", err)
+	}
+
+	if balanceCheck {
+		if err = solanaValidateTokenBalance(ctx, reader, source, from, mint, amount); err != nil {
+			return nil, 0, fmt.Errorf("failed to validate token balance: %w", err)
+		}
+		// fee payer covers the tx fee and the rent of a created token account
+		var rent uint64
+		if createAccount {
+			if rent, err = associatedTokenAccountRent(ctx, reader, tokenProgram, mintData); err != nil {
+				return nil, 0, fmt.Errorf("failed to get token account rent: %w", err)
+			}
+		}
+		if err = solanaValidateBalance(ctx, reader, from, rent, tx.Message.ToBase64()); err != nil {
+			return nil, 0, fmt.Errorf("failed to validate balance: %w", err)
+		}
+	}
+	return tx, computeUnits, nil
+}
+
+// solanaValidateTokenBalance checks the source token account exists, belongs to owner, and holds at least amount
+func solanaValidateTokenBalance(ctx context.Context, reader client.Reader, account, owner, mint solanago.PublicKey, amount uint64) error {
+	info, err := getAccount(ctx, reader, account)
+	if err != nil {
+		return err
+	}
+	if info == nil {
+		return fmt.Errorf("token account %s not found", account)
+	}
+	data := info.Data.GetBinary()
+	if err = validateTokenAccount(data, mint, &owner); err != nil {
+		return fmt.Errorf("invalid token account %s: %w", account, err)
+	}
+	balance := binary.LittleEndian.Uint64(data[tokenAccountAmountOffset:])
+	if balance < amount {
+		return fmt.Errorf("token balance %d is too low for this transaction to be executed: amount %d", balance, amount)
+	}
+	return nil
+}
+
+func validateTokenAccount(data []byte, mint solanago.PublicKey, owner *solanago.PublicKey) error {
+	if len(data) < tokenAccountLen {
+		return fmt.Errorf("data too short (%d bytes)", len(data))
+	}
+	if accountMint := solanago.PublicKeyFromBytes(data[tokenAccountMintOffset:tokenAccountOwnerOffset]); !accountMint.Equals(mint) {
+		return fmt.Errorf("mint %s does not match %s", accountMint, mint)
+	}
+	if owner == nil {
+		return nil
+	}
+	if accountOwner := solanago.PublicKeyFromBytes(data[tokenAccountOwnerOffset:tokenAccountAmountOffset]); !accountOwner.Equals(*owner) {
+		return fmt.Errorf("owner %s does not match %s", accountOwner, owner)
+	}
+	return nil
+}
+
+// getAccount returns nil if the account does not exist
+func getAccount(ctx context.Context, reader client.AccountReader, account solanago.PublicKey) (*rpc.Account, error) {
+	res, err := reader.GetAccountInfoWithOpts(ctx, account, &rpc.GetAccountInfoOpts{Encoding: solanago.EncodingBase64})
+	if errors.Is(err, rpc.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if res == nil || res.Value == nil {
+		return nil, nil
+	}
+	return res.Value, nil
+}
+
+// FindAssociatedTokenAddress derives the associated token account of wallet for mint owned by tokenProgram
+func FindAssociatedTokenAddress(wallet, mint, tokenProgram solanago.PublicKey) (solanago.PublicKey, error) {
+	addr, _, err := solanago.FindProgramAddress([][]byte{wallet[:], tokenProgram[:], mint[:]}, solanago.SPLAssociatedTokenAccountProgramID)
+	return addr, err
+}
+
+// NewCreateIdempotentAssociatedTokenAccountInstruction creates the associated token account of wallet if it does not exist
+func NewCreateIdempotentAssociatedTokenAccountInstruction(payer, wallet, mint, tokenProgram solanago.PublicKey) solanago.Instruction {
+	ata, _ := FindAssociatedTokenAddress(wallet, mint, tokenProgram) //nolint:errcheck // only fails if no bump seed is found, instruction fails onchain
+	return solanago.NewInstruction(
+		solanago.SPLAssociatedTokenAccountProgramID,
+		solanago.AccountMetaSlice{
+			solanago.Meta(payer).WRITE().SIGNER(),
+			solanago.Meta(ata).WRITE(),
+			solanago.Meta(wallet),
+			solanago.Meta(mint),
+			solanago.Meta(solanago.SystemProgramID),
+			solanago.Meta(tokenProgram),
+		},
+		[]byte{1}, // CreateIdempotent
+	)
+}
+
+// NewTransferCheckedInstruction builds a TransferChecked instruction for SPL Token or Token-2022 (same instruction layout)
+func NewTransferCheckedInstruction(tokenProgram solanago.PublicKey, amount uint64, decimals uint8, source, mint, destination, owner solanago.PublicKey) (solanago.Instruction, error) {
+	ix := token.NewTransferCheckedInstruction(amount, decimals, source, mint, destination, owner, nil).Build()
+	data, err := ix.Data()
+	if err != nil {
+		return nil, err
+	}
+	return solanago.NewInstruction(tokenProgram, ix.Accounts(), data), nil
+}
+
+// associatedTokenAccountRent returns the rent exemption of a new associated token account of the mint
+func associatedTokenAccountRent(ctx context.Context, reader client.Reader, tokenProgram solanago.PublicKey, mintData []byte) (uint64, error) {
+	size, err := associatedTokenAccountLen(tokenProgram, mintData)
+	if err != nil {
+		return 0, err
+	}
+	return reader.GetMinimumBalanceForRentExemption(ctx, size)
+}
+
+// associatedTokenAccountLen returns the size of a new associated token account of the mint
+// token-2022 accounts include the ImmutableOwner extension and the account extensions required by the mint extensions
+func associatedTokenAccountLen(tokenProgram solanago.PublicKey, mintData []byte) (uint64, error) {
+	if !tokenProgram.Equals(Token2022ProgramID) {
+		return tokenAccountLen, nil
+	}
+
+	// account type + ImmutableOwner (no value)
+	size := uint64(tokenAccountLen + 1 + token2022ExtensionHeaderLen)
+	if len(mintData) <= mintLen {
+		return size, nil // no extensions
+	}
+	if len(mintData) <= tokenAccountLen || mintData[tokenAccountLen] != token2022AccountTypeMint {
+		return 0, fmt.Errorf("invalid token-2022 mint: unexpected data length %d", len(mintData))
+	}
+
+	offset := tokenAccountLen + 1
+	for offset+token2022ExtensionHeaderLen <= len(mintData) {
+		extension := binary.LittleEndian.Uint16(mintData[offset:])
+		if extension == 0 {
+			break // uninitialized, the remaining data is unused
+		}
+		if extension > token2022MaxKnownExtension {
+			return 0, fmt.Errorf("unsupported token-2022 mint extension %d", extension)
+		}
+		if extensionLen, ok := token2022AccountExtensionLen[extension]; ok {
+			size += token2022ExtensionHeaderLen + extensionLen
+		}
+		offset += token2022ExtensionHeaderLen + int(binary.LittleEndian.Uint16(mintData[offset+2:]))
+	}
+	if offset > len(mintData) {
+		return 0, errors.New("invalid token-2022 mint: extension exceeds account data")
+	}
+
+	// accounts with the multisig length are padded so they can be distinguished from multisigs
+	if size == multisigLen {
+		size += 2
+	}
+	return size, nil
+}
@@ -0,0 +1,199 @@
+package solana
+
+import (
+	"encoding/binary"
+	"testing"
+
+	solanago "github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/token"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/client/mocks"
+)
+
+func mockAccount(rw *mocks.ReaderWriter, addr, owner solanago.PublicKey, data []byte) {
+	if data == nil {
+		rw.On("GetAccountInfoWithOpts", mock.Anything, addr, mock.Anything).Return(nil, rpc.ErrNotFound).Once()
+		return
+	}
+	rw.On("GetAccountInfoWithOpts", mock.Anything, addr, mock.Anything).Return(&rpc.GetAccountInfoResult{
+		Value: &rpc.Account{Lamports: 1, Owner: owner, Data: rpc.DataBytesOrJSONFromBytes(data)},
+	}, nil).Once()
+}
+
+func mintData(decimals uint8) []byte {
+	data := make([]byte, 82)
+	data[mintDecimalsOffset] = decimals
+	return data
+}
+
+func tokenAccountData(mint, owner solanago.PublicKey, amount uint64) []byte {
+	data := make([]byte, tokenAccountLen)
+	copy(data[tokenAccountMintOffset:], mint[:])
+	copy(data[tokenAccountOwnerOffset:], owner[:])
+	binary.LittleEndian.PutUint64(data[tokenAccountAmountOffset:], amount)
+	return data
+}
+
+func TestBuildTokenTransfer(t *testing.T) {
+	ctx := tests.Context(t)
+	from, to, mint := solanago.PublicKey{1}, solanago.PublicKey{2}, solanago.PublicKey{3}
+
+	t.Run("SPL token to wallet without token account", func(t *testing.T) {
+		rw := mocks.NewReaderWriter(t)
+		source, err := FindAssociatedTokenAddress(from, mint, token.ProgramID)
+		require.NoError(t, err)
+		destination, err := FindAssociatedTokenAddress(to, mint, token.ProgramID)
+		require.NoError(t, err)
+
+		mockAccount(rw, mint, token.ProgramID, mintData(9))
+		mockAccount(rw, to, solanago.SystemProgramID, []byte{})
+		mockAccount(rw, destination, solanago.PublicKey{}, nil)
+		mockAccount(rw, source, token.ProgramID, tokenAccountData(mint, from, 100))
+		rw.On("LatestBlockhash", mock.Anything).Return(&rpc.GetLatestBlockhashResult{Value: &rpc.LatestBlockhashResult{}}, nil)
+		rent := uint64(2_039_280)
+		rw.On("GetMinimumBalanceForRentExemption", mock.Anything, uint64(tokenAccountLen)).Return(rent, nil)
+		rw.On("Balance", mock.Anything, from).Return(rent+5_000, nil)
+		rw.On("GetFeeForMessage", mock.Anything, mock.Anything).Return(uint64(5_000), nil)
+
+		tx, computeUnits, err := buildTokenTransfer(ctx, rw, from, to, mint, 100, true)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(tokenTransferComputeUnits+createTokenAccountComputeUnits), computeUnits)
+		require.Len(t, tx.Message.Instructions, 2)
+
+		create := tx.Message.Instructions[0]
+		assert.Equal(t, solanago.SPLAssociatedTokenAccountProgramID, tx.Message.AccountKeys[create.ProgramIDIndex])
+		assert.Equal(t, []byte{1}, []byte(create.Data))
+
+		transfer := tx.Message.Instructions[1]
+		assert.Equal(t, token.ProgramID, tx.Message.AccountKeys[transfer.ProgramIDIndex])
+		accounts, err := transfer.ResolveInstructionAccounts(&tx.Message)
+		require.NoError(t, err)
+		require.Len(t, accounts, 4)
+		assert.Equal(t, source, accounts[0].PublicKey)
+		assert.Equal(t, mint, accounts[1].PublicKey)
+		assert.Equal(t, destination, accounts[2].PublicKey)
+		assert.Equal(t, from, accounts[3].PublicKey)
+		assert.Equal(t, uint8(12), transfer.Data[0]) // TransferChecked
+		assert.Equal(t, uint64(100), binary.LittleEndian.Uint64(transfer.Data[1:]))
+		assert.Equal(t, uint8(9), transfer.Data[9])
+	})
+
+	t.Run("Token-2022 to token account", func(t *testing.T) {
+		rw := mocks.NewReaderWriter(t)
+		mockAccount(rw, mint, Token2022ProgramID, mintData(6))
+		mockAccount(rw, to, Token2022ProgramID, tokenAccountData(mint, solanago.PublicKey{4}, 0))
+		rw.On("LatestBlockhash", mock.Anything).Return(&rpc.GetLatestBlockhashResult{Value: &rpc.LatestBlockhashResult{}}, nil)
+
+		tx, computeUnits, err := buildTokenTransfer(ctx, rw, from, to, mint, 100, false)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(tokenTransferComputeUnits), computeUnits)
+		require.Len(t, tx.Message.Instructions, 1)
+		transfer := tx.Message.Instructions[0]
+		assert.Equal(t, Token2022ProgramID, tx.Message.AccountKeys[transfer.ProgramIDIndex])
+		accounts, err := transfer.ResolveInstructionAccounts(&tx.Message)
+		require.NoError(t, err)
+		assert.Equal(t, to, accounts[2].PublicKey)
+	})
+
+	t.Run("insufficient token balance", func(t *testing.T) {
+		rw := mocks.NewReaderWriter(t)
+		source, err := FindAssociatedTokenAddress(from, mint, token.ProgramID)
+		require.NoError(t, err)
+		mockAccount(rw, mint, token.ProgramID, mintData(9))
+		mockAccount(rw, to, token.ProgramID, tokenAccountData(mint, solanago.PublicKey{4}, 0))
+		mockAccount(rw, source, token.ProgramID, tokenAccountData(mint, from, 99))
+		rw.On("LatestBlockhash", mock.Anything).Return(&rpc.GetLatestBlockhashResult{Value: &rpc.LatestBlockhashResult{}}, nil)
+
+		_, _, err = buildTokenTransfer(ctx, rw, from, to, mint, 100, true)
+		require.ErrorContains(t, err, "token balance 99 is too low")
+	})
+
+	t.Run("invalid mint", func(t *testing.T) {
+		rw := mocks.NewReaderWriter(t)
+		mockAccount(rw, mint, solanago.SystemProgramID, []byte{})
+		_, _, err := buildTokenTransfer(ctx, rw, from, to, mint, 100, true)
+		require.ErrorContains(t, err, "not a token program")
+
+		mockAccount(rw, mint, solanago.PublicKey{}, nil)
+		_, _, err = buildTokenTransfer(ctx, rw, from, to, mint, 100, true)
+		require.ErrorContains(t, err, "not found")
+	})
+
+	t.Run("destination token account for another mint", func(t *testing.T) {
+		rw := mocks.NewReaderWriter(t)
+		mockAccount(rw, mint, token.ProgramID, mintData(9))
+		mockAccount(rw, to, token.ProgramID, tokenAccountData(solanago.PublicKey{5}, solanago.PublicKey{4}, 0))
+		_, _, err := buildTokenTransfer(ctx, rw, from, to, mint, 100, true)
+		require.ErrorContains(t, err, "invalid destination token account")
+	})
+}
+
+// token2022MintData returns a token-2022 mint with the given extensions (type, length)
+func token2022MintData(extensions ...[2]uint16) []byte {
+	data := make([]byte, tokenAccountLen+1)
+	data[tokenAccountLen] = token2022AccountTypeMint
+	for _, ext := range extensions {
+		entry := make([]byte, token2022ExtensionHeaderLen+int(ext[1]))
+		binary.LittleEndian.PutUint16(entry, ext[0])
+		binary.LittleEndian.PutUint16(entry[2:], ext[1])
+		data = append(data, entry...)
+	}
+	return data
+}
+
+func TestAssociatedTokenAccountRent(t *testing.T) {
+	ctx := tests.Context(t)
+	rw := mocks.NewReaderWriter(t)
+	rw.On("GetMinimumBalanceForRentExemption", mock.Anything, uint64(165)).Return(uint64(2_039_280), nil).Once()
+	// token-2022 associated token accounts include the ImmutableOwner extension
+	rw.On("GetMinimumBalanceForRentExemption", mock.Anything, uint64(170)).Return(uint64(2_074_080), nil).Once()
+
+	rent, err := associatedTokenAccountRent(ctx, rw, token.ProgramID, mintData(9))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2_039_280), rent)
+
+	rent, err = associatedTokenAccountRent(ctx, rw, Token2022ProgramID, mintData(9))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2_074_080), rent)
+
+	_, err = associatedTokenAccountRent(ctx, rw, Token2022ProgramID, token2022MintData([2]uint16{99, 0}))
+	require.ErrorContains(t, err, "unsupported token-2022 mint extension 99")
+}
+
+func TestAssociatedTokenAccountLen(t *testing.T) {
+	for _, tt := range []struct {
+		name         string
+		tokenProgram solanago.PublicKey
+		mint         []byte
+		size         uint64
+		err          string
+	}{
+		{"SPL token", token.ProgramID, mintData(9), 165, ""},
+		{"token-2022 without extensions", Token2022ProgramID, mintData(9), 170, ""},
+		{"token-2022 mint only extensions", Token2022ProgramID, token2022MintData([2]uint16{3, 32}, [2]uint16{18, 64}), 170, ""},
+		// TransferFeeConfig requires TransferFeeAmount (8 bytes)
+		{"token-2022 transfer fee", Token2022ProgramID, token2022MintData([2]uint16{1, 108}), 182, ""},
+		// TransferHookAccount (1 byte) + NonTransferableAccount (0 bytes)
+		{"token-2022 multiple extensions", Token2022ProgramID, token2022MintData([2]uint16{14, 64}, [2]uint16{9, 0}), 179, ""},
+		{"token-2022 unused trailing data", Token2022ProgramID, append(token2022MintData([2]uint16{1, 108}), 0, 0, 0, 0), 182, ""},
+		{"unsupported extension", Token2022ProgramID, token2022MintData([2]uint16{24, 0}), 0, "unsupported token-2022 mint extension"},
+		{"invalid account type", Token2022ProgramID, make([]byte, tokenAccountLen+1), 0, "invalid token-2022 mint"},
+		{"truncated extension", Token2022ProgramID, token2022MintData([2]uint16{1, 108})[:tokenAccountLen+10], 0, "extension exceeds account data"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			size, err := associatedTokenAccountLen(tt.tokenProgram, tt.mint)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.size, size)
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/gagliardetto/solana-go"
+	"github.com/google/uuid"
 	"github.com/smartcontractkit/libocr/offchainreporting2/types"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -23,6 +24,8 @@ type Transmitter struct {
 	stateCache                                                              *StateCache
 	lggr                                                                    logger.Logger
 	txManager                                                               TxManager
+	// keys selects the transmission signer when multiple transmitter keys are configured, nil if only transmissionSigner is used
+	keys *transmitterKeys
 }
 
 // Transmit sends the report to the on-chain OCR2Aggregator smart contract's Transmit method
@@ -47,16 +50,6 @@ func (c *Transmitter) Transmit(
 		return fmt.Errorf("error on Transmit.FindProgramAddress: %w", err)
 	}
 
-	accounts := []*solana.AccountMeta{
-		// state, transmitter, transmissions, store_program, store, store_authority, instructions_sysvar
-		{PublicKey: c.stateID, IsWritable: true, IsSigner: false},
-		{PublicKey: c.transmissionSigner, IsWritable: false, IsSigner: true},
-		{PublicKey: c.transmissionsID, IsWritable: true, IsSigner: false},
-		{PublicKey: c.storeProgramID, IsWritable: false, IsSigner: false},
-		{PublicKey: storeAuthority, IsWritable: false, IsSigner: false},
-		{PublicKey: solana.SysVarInstructionsPubkey, IsWritable: false, IsSigner: false},
-	}
-
 	reportContext := utils.RawReportContext(reportCtx)
 
 	// Construct the instruction payload
@@ -71,23 +64,86 @@ func (c *Transmitter) Transmit(
 		data.Write(sig.Signature)
 	}
 
+	if c.keys == nil {
+		tx, err := c.newTransmitTx(c.transmissionSigner, storeAuthority, data.Bytes(), blockhash.Value.Blockhash)
+		if err != nil {
+			return err
+		}
+		// pass transmit payload to tx manager queue
+		c.lggr.Debugf("Queuing transmit tx: state (%s) + transmissions (%s)", c.stateID.String(), c.transmissionsID.String())
+		if err = c.txManager.Enqueue(ctx, c.stateID.String(), tx, nil); err != nil {
+			return fmt.Errorf("error on Transmit.txManager.Enqueue: %w", err)
+		}
+		return nil
+	}
+
+	statuses, _ := c.txManager.(txStatusGetter)
+	signers, err := c.keys.candidates(ctx, statuses, c.authorizedTransmitters())
+	if err != nil {
+		return fmt.Errorf("error on Transmit.selectTransmitter: %w", err)
+	}
+	// fall back to the next key if the tx manager rejects the transmission (e.g. key balance below the floor)
+	var errs error
+	for _, signer := range signers {
+		tx, err := c.newTransmitTx(signer, storeAuthority, data.Bytes(), blockhash.Value.Blockhash)
+		if err != nil {
+			return err
+		}
+		txID := uuid.New().String()
+		c.lggr.Debugw("Queuing transmit tx", "state", c.stateID, "transmissions", c.transmissionsID, "transmitter", signer, "txID", txID)
+		if err = c.txManager.Enqueue(ctx, c.stateID.String(), tx, &txID); err != nil {
+			c.lggr.Warnw("Failed to enqueue transmit tx", "transmitter", signer, "err", err)
+			errs = errors.Join(errs, fmt.Errorf("%s: %w", signer, err))
+			continue
+		}
+		c.keys.track(signer, txID)
+		return nil
+	}
+	return fmt.Errorf("error on Transmit.txManager.Enqueue: %w", errs)
+}
+
+// newTransmitTx builds the transmit transaction signed and paid for by signer
+func (c *Transmitter) newTransmitTx(signer, storeAuthority solana.PublicKey, data []byte, blockhash solana.Hash) (*solana.Transaction, error) {
+	accounts := []*solana.AccountMeta{
+		// state, transmitter, transmissions, store_program, store, store_authority, instructions_sysvar
+		{PublicKey: c.stateID, IsWritable: true, IsSigner: false},
+		{PublicKey: signer, IsWritable: false, IsSigner: true},
+		{PublicKey: c.transmissionsID, IsWritable: true, IsSigner: false},
+		{PublicKey: c.storeProgramID, IsWritable: false, IsSigner: false},
+		{PublicKey: storeAuthority, IsWritable: false, IsSigner: false},
+		{PublicKey: solana.SysVarInstructionsPubkey, IsWritable: false, IsSigner: false},
+	}
+
 	tx, err := solana.NewTransaction(
 		[]solana.Instruction{
-			solana.NewInstruction(c.programID, accounts, data.Bytes()),
+			solana.NewInstruction(c.programID, accounts, data),
 		},
-		blockhash.Value.Blockhash,
-		solana.TransactionPayer(c.transmissionSigner),
+		blockhash,
+		solana.TransactionPayer(signer),
 	)
 	if err != nil {
-		return fmt.Errorf("error on Transmit.NewTransaction: %w", err)
+		return nil, fmt.Errorf("error on Transmit.NewTransaction: %w", err)
 	}
+	return tx, nil
+}
 
-	// pass transmit payload to tx manager queue
-	c.lggr.Debugf("Queuing transmit tx: state (%s) + transmissions (%s)", c.stateID.String(), c.transmissionsID.String())
-	if err = c.txManager.Enqueue(ctx, c.stateID.String(), tx, nil); err != nil {
-		return fmt.Errorf("error on Transmit.txManager.Enqueue: %w", err)
+// authorizedTransmitters returns the oracle transmitters in the onchain state, nil if the state is unavailable
+func (c *Transmitter) authorizedTransmitters() map[solana.PublicKey]bool {
+	state, err := c.stateCache.Read()
+	if err != nil {
+		c.lggr.Warnw("Failed to read state for authorized transmitters, using all transmitter keys", "err", err)
+		return nil
+	}
+	oracles, err := state.Oracles.Data()
+	if err != nil {
+		c.lggr.Warnw("Failed to read oracles for authorized transmitters, using all transmitter keys", "err", err)
+		return nil
+	}
+	authorized := make(map[solana.PublicKey]bool, len(oracles))
+	for _, o := range oracles {
+		authorized[o.Transmitter] = true
 	}
-	return nil
+	return authorized
 }
 
 func (c *Transmitter) LatestConfigDigestAndEpoch(
@@ -0,0 +1,206 @@
+package solana
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+)
+
+const (
+	// transmissionQueuedTimeout is how long a transmission unknown to the tx manager is considered queued (not yet broadcast)
+	transmissionQueuedTimeout = 10 * time.Second
+	// transmissionInflightTimeout is how long a transmission is considered in-flight if the tx manager does not report statuses
+	transmissionInflightTimeout = time.Minute
+	// transmissionStatusInterval is the minimum time between status checks of the same transmission
+	transmissionStatusInterval = 5 * time.Second
+	// maxTransmissionStatusChecks caps the status checks made when selecting a key, the remaining transmissions are checked on later selections
+	maxTransmissionStatusChecks = 20
+)
+
+// txStatusGetter is implemented by tx managers that report the status of enqueued transactions
+type txStatusGetter interface {
+	GetTransactionStatus(ctx context.Context, transactionID string) (commontypes.TransactionStatus, error)
+}
+
+type trackedTransmission struct {
+	enqueuedAt time.Time
+	checkedAt  time.Time
+}
+
+// transmitterKeys selects the key used to sign and pay for each transmission.
+// Only keys set as an oracle transmitter in the onchain state are used, preferring the key with the fewest
+// in-flight transmissions and rotating between keys on ties. Retired keys (or keys removed from the onchain state)
+// are no longer selected, while their pending transactions continue to be confirmed by the tx manager.
+type transmitterKeys struct {
+	keys           []solana.PublicKey
+	retired        map[solana.PublicKey]bool
+	inflight       map[solana.PublicKey]map[string]*trackedTransmission // tx ID -> transmission
+	next           int
+	statusInterval time.Duration
+	lggr           logger.Logger
+	lock           sync.Mutex
+}
+
+func newTransmitterKeys(keys []solana.PublicKey, retired []solana.PublicKey, lggr logger.Logger) *transmitterKeys {
+	k := &transmitterKeys{
+		retired:        map[solana.PublicKey]bool{},
+		inflight:       map[solana.PublicKey]map[string]*trackedTransmission{},
+		statusInterval: transmissionStatusInterval,
+		lggr:           lggr,
+	}
+	for _, key := range keys {
+		if _, exists := k.inflight[key]; exists {
+			continue
+		}
+		k.keys = append(k.keys, key)
+		k.inflight[key] = map[string]*trackedTransmission{}
+	}
+	for _, key := range retired {
+		k.retired[key] = true
+	}
+	return k
+}
+
+// candidates returns the keys that can transmit, ordered by preference.
+// authorized is the set of transmitters in the onchain state, nil if the state is unavailable.
+func (k *transmitterKeys) candidates(ctx context.Context, statuses txStatusGetter, authorized map[solana.PublicKey]bool) ([]solana.PublicKey, error) {
+	k.prune(ctx, statuses)
+
+	k.lock.Lock()
+	defer k.lock.Unlock()
+
+	type candidate struct {
+		key      solana.PublicKey
+		inflight int
+		order    int
+	}
+	var cs []candidate
+	for i := range k.keys {
+		// rotate the starting key so ties are broken round-robin
+		idx := (k.next + i) % len(k.keys)
+		key := k.keys[idx]
+		if k.retired[key] {
+			continue
+		}
+		if authorized != nil && !authorized[key] {
+			continue
+		}
+		cs = append(cs, candidate{key: key, inflight: len(k.inflight[key]), order: i})
+	}
+	if len(cs) == 0 {
+		return nil, fmt.Errorf("no transmitter key is authorized in the onchain state out of %d key(s)", len(k.keys))
+	}
+	k.next = (k.next + 1) % len(k.keys)
+
+	sort.SliceStable(cs, func(i, j int) bool {
+		if cs[i].inflight != cs[j].inflight {
+			return cs[i].inflight < cs[j].inflight
+		}
+		return cs[i].order < cs[j].order
+	})
+	keys := make([]solana.PublicKey, len(cs))
+	for i, c := range cs {
+		keys[i] = c.key
+	}
+	return keys, nil
+}
+
+// track records a transmission enqueued with key
+func (k *transmitterKeys) track(key solana.PublicKey, txID string) {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	if _, ok := k.inflight[key]; ok {
+		now := time.Now()
+		k.inflight[key][txID] = &trackedTransmission{enqueuedAt: now, checkedAt: now}
+	}
+}
+
+// prune drops completed transmissions. Statuses are requested without holding the lock, at most once per
+// statusInterval for each transmission and for at most maxTransmissionStatusChecks transmissions per call.
+func (k *transmitterKeys) prune(ctx context.Context, statuses txStatusGetter) {
+	if statuses == nil {
+		k.lock.Lock()
+		defer k.lock.Unlock()
+		for key, txs := range k.inflight {
+			before := len(txs)
+			for id, tx := range txs {
+				if time.Since(tx.enqueuedAt) > transmissionInflightTimeout {
+					delete(txs, id)
+				}
+			}
+			k.logDrained(key, before)
+		}
+		return
+	}
+
+	// select the transmissions due for a status check
+	type check struct {
+		key    solana.PublicKey
+		id     string
+		status commontypes.TransactionStatus
+		err    error
+	}
+	var checks []check
+	k.lock.Lock()
+	now := time.Now()
+	for key, txs := range k.inflight {
+		for id, tx := range txs {
+			if len(checks) >= maxTransmissionStatusChecks {
+				break
+			}
+			if now.Sub(tx.checkedAt) < k.statusInterval {
+				continue
+			}
+			tx.checkedAt = now
+			checks = append(checks, check{key: key, id: id})
+		}
+	}
+	k.lock.Unlock()
+	if len(checks) == 0 {
+		return
+	}
+
+	for i := range checks {
+		checks[i].status, checks[i].err = statuses.GetTransactionStatus(ctx, checks[i].id)
+	}
+
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	before := map[solana.PublicKey]int{}
+	for _, c := range checks {
+		txs := k.inflight[c.key]
+		tx, ok := txs[c.id]
+		if !ok {
+			continue
+		}
+		if _, ok = before[c.key]; !ok {
+			before[c.key] = len(txs)
+		}
+		switch {
+		case c.err != nil:
+			// not yet tracked by the tx manager (queued), or already removed after completing
+			if time.Since(tx.enqueuedAt) > transmissionQueuedTimeout {
+				delete(txs, c.id)
+			}
+		case c.status == commontypes.Finalized || c.status == commontypes.Failed:
+			delete(txs, c.id)
+		}
+	}
+	for key, n := range before {
+		k.logDrained(key, n)
+	}
+}
+
+// logDrained logs when the last in-flight transmission of a retired key completes, must be called with lock held
+func (k *transmitterKeys) logDrained(key solana.PublicKey, before int) {
+	if k.retired[key] && before > 0 && len(k.inflight[key]) == 0 {
+		k.lggr.Infow("Retired transmitter key has no in-flight transmissions", "key", key)
+	}
+}
@@ -0,0 +1,72 @@
+package solana
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+)
+
+type countingStatusGetter struct {
+	lock  sync.Mutex
+	calls int
+}
+
+func (c *countingStatusGetter) GetTransactionStatus(context.Context, string) (commontypes.TransactionStatus, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.calls++
+	return commontypes.Pending, nil
+}
+
+func (c *countingStatusGetter) reset() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	calls := c.calls
+	c.calls = 0
+	return calls
+}
+
+func TestTransmitterKeys_StatusChecks(t *testing.T) {
+	ctx := tests.Context(t)
+	key := solana.PublicKey{1}
+	keys := newTransmitterKeys([]solana.PublicKey{key}, nil, logger.Test(t))
+	statuses := &countingStatusGetter{}
+
+	const tracked = maxTransmissionStatusChecks + 10
+	for i := 0; i < tracked; i++ {
+		keys.track(key, fmt.Sprintf("tx-%d", i))
+	}
+
+	// recently enqueued transmissions are not checked
+	_, err := keys.candidates(ctx, statuses, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, statuses.reset())
+
+	// status checks are capped per selection, the remaining transmissions are checked on the next selection
+	keys.lock.Lock()
+	for _, tx := range keys.inflight[key] {
+		tx.checkedAt = time.Time{}
+	}
+	keys.lock.Unlock()
+	_, err = keys.candidates(ctx, statuses, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, maxTransmissionStatusChecks, statuses.reset())
+	_, err = keys.candidates(ctx, statuses, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, tracked-maxTransmissionStatusChecks, statuses.reset())
+
+	// statuses are cached for the status interval
+	_, err = keys.candidates(ctx, statuses, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, statuses.reset())
+	assert.Len(t, keys.inflight[key], tracked)
+}
@@ -1,9 +1,12 @@
 package solana
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"testing"
 
+	bin "github.com/gagliardetto/binary"
 	"github.com/gagliardetto/solana-go"
 	"github.com/gagliardetto/solana-go/rpc"
 	"github.com/smartcontractkit/libocr/offchainreporting2/types"
@@ -12,9 +15,11 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 
 	clientmocks "github.com/smartcontractkit/chainlink-solana/pkg/solana/client/mocks"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
 	"github.com/smartcontractkit/chainlink-solana/pkg/solana/fees"
 	"github.com/smartcontractkit/chainlink-solana/pkg/solana/txm"
 )
@@ -42,6 +47,10 @@ func (txm verifyTxSize) Enqueue(_ context.Context, _ string, tx *solana.Transact
 	return nil
 }
 
+func (txm verifyTxSize) EnqueueSigned(context.Context, string, *solana.Transaction, *string) error {
+	return errors.New("not supported")
+}
+
 func TestTransmitter_TxSize(t *testing.T) {
 	mustNewRandomPublicKey := func() solana.PublicKey {
 		k, err := solana.NewRandomPrivateKey()
@@ -83,3 +92,137 @@ func TestTransmitter_TxSize(t *testing.T) {
 	}
 	require.NoError(t, transmitter.Transmit(tests.Context(t), types.ReportContext{}, make([]byte, ReportLen), sigs))
 }
+
+// keyRotationTxm records the signer of each transmission and reports configurable statuses
+type keyRotationTxm struct {
+	t        *testing.T
+	statuses map[string]commontypes.TransactionStatus
+	reject   map[solana.PublicKey]bool
+	last     solana.PublicKey
+}
+
+func (txm *keyRotationTxm) Enqueue(_ context.Context, _ string, tx *solana.Transaction, txID *string, _ ...txm.SetTxConfig) error {
+	require.NotNil(txm.t, txID)
+	signer := tx.Message.AccountKeys[0]
+	// transmitter account is the signer of the transmit instruction
+	accounts, err := tx.Message.Instructions[0].ResolveInstructionAccounts(&tx.Message)
+	require.NoError(txm.t, err)
+	assert.Equal(txm.t, signer, accounts[1].PublicKey)
+	if txm.reject[signer] {
+		return errors.New("balance below floor")
+	}
+	txm.last = signer
+	txm.statuses[*txID] = commontypes.Pending
+	return nil
+}
+
+func (txm *keyRotationTxm) EnqueueSigned(context.Context, string, *solana.Transaction, *string) error {
+	return errors.New("not supported")
+}
+
+func (txm *keyRotationTxm) GetTransactionStatus(_ context.Context, id string) (commontypes.TransactionStatus, error) {
+	status, ok := txm.statuses[id]
+	if !ok {
+		return commontypes.Unknown, errors.New("not found")
+	}
+	return status, nil
+}
+
+func (txm *keyRotationTxm) finalizeAll() {
+	for id := range txm.statuses {
+		txm.statuses[id] = commontypes.Finalized
+	}
+}
+
+func TestTransmitter_KeyRotation(t *testing.T) {
+	ctx := tests.Context(t)
+	keyA, keyB, keyC := solana.PublicKey{1}, solana.PublicKey{2}, solana.PublicKey{3}
+	stateID := solana.PublicKey{4}
+
+	// only keyA and keyB are authorized onchain
+	state := State{Version: 1}
+	state.Oracles.Raw[0].Transmitter = keyA
+	state.Oracles.Raw[1].Transmitter = keyB
+	state.Oracles.Len = 2
+	buf := new(bytes.Buffer)
+	require.NoError(t, bin.NewBinEncoder(buf).Encode(state))
+
+	rw := clientmocks.NewReaderWriter(t)
+	rw.On("LatestBlockhash", mock.Anything).Return(&rpc.GetLatestBlockhashResult{
+		Value: &rpc.LatestBlockhashResult{},
+	}, nil)
+	rw.On("GetAccountInfoWithOpts", mock.Anything, stateID, mock.Anything).Return(&rpc.GetAccountInfoResult{
+		Value: &rpc.Account{Data: rpc.DataBytesOrJSONFromBytes(buf.Bytes())},
+	}, nil)
+	stateCache := NewStateCache(stateID, "test", config.NewDefault(), rw, logger.Test(t))
+	require.NoError(t, stateCache.Fetch(ctx))
+
+	mockTxm := &keyRotationTxm{
+		t:        t,
+		statuses: map[string]commontypes.TransactionStatus{},
+		reject:   map[solana.PublicKey]bool{},
+	}
+	transmitter := Transmitter{
+		stateID:            stateID,
+		programID:          solana.PublicKey{5},
+		storeProgramID:     solana.PublicKey{6},
+		transmissionsID:    solana.PublicKey{7},
+		transmissionSigner: keyA,
+		reader:             rw,
+		stateCache:         stateCache,
+		lggr:               logger.Test(t),
+		txManager:          mockTxm,
+		keys:               newTransmitterKeys([]solana.PublicKey{keyA, keyB, keyC}, nil, logger.Test(t)),
+	}
+	transmitter.keys.statusInterval = 0
+
+	transmit := func() (solana.PublicKey, error) {
+		err := transmitter.Transmit(ctx, types.ReportContext{}, make([]byte, ReportLen), nil)
+		return mockTxm.last, err
+	}
+	inflight := func(key solana.PublicKey) int {
+		transmitter.keys.prune(ctx, mockTxm)
+		transmitter.keys.lock.Lock()
+		defer transmitter.keys.lock.Unlock()
+		return len(transmitter.keys.inflight[key])
+	}
+	// keys are retired with RelayConfig.RetiredTransmitterIDs, which requires a restart
+	retire := func(key solana.PublicKey) {
+		transmitter.keys.lock.Lock()
+		defer transmitter.keys.lock.Unlock()
+		transmitter.keys.retired[key] = true
+	}
+
+	// key with fewest in-flight transmissions is selected
+	signer, err := transmit()
+	require.NoError(t, err)
+	assert.Equal(t, keyA, signer)
+	signer, err = transmit()
+	require.NoError(t, err)
+	assert.Equal(t, keyB, signer)
+
+	// unauthorized keyC is skipped, rejected keyA falls back to keyB
+	mockTxm.finalizeAll()
+	mockTxm.reject[keyA] = true
+	signer, err = transmit()
+	require.NoError(t, err)
+	assert.Equal(t, keyB, signer)
+	assert.Equal(t, 0, inflight(keyA))
+	assert.Equal(t, 1, inflight(keyB))
+	assert.Equal(t, 0, inflight(keyC))
+
+	// retired keys are no longer used while pending transmissions drain
+	delete(mockTxm.reject, keyA)
+	retire(keyA)
+	signer, err = transmit()
+	require.NoError(t, err)
+	assert.Equal(t, keyB, signer)
+	assert.Equal(t, 2, inflight(keyB))
+
+	retire(keyB)
+	_, err = transmit()
+	require.ErrorContains(t, err, "no transmitter key is authorized")
+	assert.Equal(t, 2, inflight(keyB))
+	mockTxm.finalizeAll()
+	assert.Equal(t, 0, inflight(keyB))
+}
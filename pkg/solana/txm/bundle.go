@@ -0,0 +1,556 @@
+package txm
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	solanaGo "github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/gagliardetto/solana-go/rpc"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/fees"
+)
+
+// Bundle submission sends each transaction to a block engine as a single transaction bundle.
+// The fee payer pays a tip to one of the block engine tip accounts using a transfer appended to the transaction.
+// Bundles are only executed by leaders running the block engine client, but are prioritized by tip during congestion.
+// https://docs.jito.wtf/lowlatencytxnsend/
+const (
+	MaxBundleStatuses     = 5                // max number of bundle ids in getBundleStatuses call
+	MinBundleTip          = 1_000            // lamports, minimum tip accepted by the block engine
+	BundleTipComputeUnits = 150              // compute units consumed by the tip transfer
+	BundleResendInterval  = time.Second      // min time between resubmitting the same transaction (block engines rate limit submissions)
+	TipFloorCacheTTL      = 10 * time.Second // duration before refreshing the tip floor
+)
+
+// BundleClient is a JSON-RPC client for the block engine bundle API
+type BundleClient struct {
+	url    string
+	client *http.Client
+	id     atomic.Uint64
+}
+
+func NewBundleClient(endpoint *url.URL, timeout time.Duration) *BundleClient {
+	return &BundleClient{
+		url:    endpoint.String(),
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+type bundleRequest struct {
+	JSONRPC string        `json:"jsonrpc"`
+	ID      uint64        `json:"id"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+}
+
+type bundleResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *BundleRPCError `json:"error"`
+}
+
+// BundleRPCError is an error returned by the block engine (e.g. rate limited, invalid bundle)
+type BundleRPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *BundleRPCError) Error() string {
+	return fmt.Sprintf("block engine error (%d): %s", e.Code, e.Message)
+}
+
+func (c *BundleClient) call(ctx context.Context, method string, params []interface{}, out interface{}) error {
+	body, err := json.Marshal(bundleRequest{
+		JSONRPC: "2.0",
+		ID:      c.id.Add(1),
+		Method:  method,
+		Params:  params,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode %s request: %w", method, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create %s request: %w", method, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call %s: %w", method, err)
+	}
+	defer res.Body.Close()
+
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read %s response: %w", method, err)
+	}
+
+	var decoded bundleResponse
+	if err := json.Unmarshal(resBody, &decoded); err != nil {
+		return fmt.Errorf("failed to decode %s response (status %d): %w", method, res.StatusCode, err)
+	}
+	if decoded.Error != nil {
+		return fmt.Errorf("%s: %w", method, decoded.Error)
+	}
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: unexpected status code %d", method, res.StatusCode)
+	}
+	if err := json.Unmarshal(decoded.Result, out); err != nil {
+		return fmt.Errorf("failed to decode %s result: %w", method, err)
+	}
+	return nil
+}
+
+// SendBundle submits signed transactions as an atomic bundle and returns the bundle id
+func (c *BundleClient) SendBundle(ctx context.Context, txs []*solanaGo.Transaction) (string, error) {
+	encoded := make([]string, len(txs))
+	for i, tx := range txs {
+		b, err := tx.MarshalBinary()
+		if err != nil {
+			return "", fmt.Errorf("failed to encode bundle transaction %d: %w", i, err)
+		}
+		encoded[i] = base64.StdEncoding.EncodeToString(b)
+	}
+
+	var id string
+	err := c.call(ctx, "sendBundle", []interface{}{encoded, map[string]string{"encoding": "base64"}}, &id)
+	return id, err
+}
+
+// BundleStatus is the landed status of a bundle
+type BundleStatus struct {
+	BundleID           string                     `json:"bundle_id"`
+	Transactions       []solanaGo.Signature       `json:"transactions"`
+	Slot               uint64                     `json:"slot"`
+	ConfirmationStatus rpc.ConfirmationStatusType `json:"confirmation_status"`
+	Err                map[string]interface{}     `json:"err"` // {"Ok": null} if the bundle executed successfully
+}
+
+// Failed returns true if the bundle landed but a transaction failed
+func (s BundleStatus) Failed() bool {
+	if len(s.Err) == 0 {
+		return false
+	}
+	_, ok := s.Err["Ok"]
+	return !ok
+}
+
+// GetBundleStatuses returns the status for each bundle id, statuses are nil for bundles that have not landed
+func (c *BundleClient) GetBundleStatuses(ctx context.Context, ids []string) ([]*BundleStatus, error) {
+	if len(ids) > MaxBundleStatuses {
+		return nil, fmt.Errorf("too many bundle ids: %d > %d", len(ids), MaxBundleStatuses)
+	}
+
+	var res struct {
+		Value []*BundleStatus `json:"value"`
+	}
+	if err := c.call(ctx, "getBundleStatuses", []interface{}{ids}, &res); err != nil {
+		return nil, err
+	}
+	if len(res.Value) != len(ids) {
+		return nil, fmt.Errorf("getBundleStatuses: expected %d statuses, got %d", len(ids), len(res.Value))
+	}
+	return res.Value, nil
+}
+
+// GetTipAccounts returns the accounts that can receive bundle tips
+func (c *BundleClient) GetTipAccounts(ctx context.Context) ([]solanaGo.PublicKey, error) {
+	var accounts []solanaGo.PublicKey
+	if err := c.call(ctx, "getTipAccounts", []interface{}{}, &accounts); err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+// TipEstimator provides the base bundle tip in lamports, bumped tips are calculated from the base tip
+type TipEstimator interface {
+	BaseTip(ctx context.Context) uint64
+}
+
+// NewTipEstimator uses the tip floor endpoint if configured, otherwise a fixed tip
+func NewTipEstimator(cfg config.Config, lggr logger.Logger) TipEstimator {
+	endpoint := cfg.BundleTipFloorEndpoint()
+	if endpoint == nil {
+		return &fixedTipEstimator{cfg: cfg}
+	}
+	return &tipFloorEstimator{
+		url:    endpoint.String(),
+		client: &http.Client{Timeout: cfg.TxTimeout()},
+		cfg:    cfg,
+		lggr:   logger.Named(lggr, "TipFloorEstimator"),
+	}
+}
+
+type fixedTipEstimator struct {
+	cfg config.Config
+}
+
+func (f *fixedTipEstimator) BaseTip(context.Context) uint64 {
+	return f.cfg.BundleTipDefault()
+}
+
+// tipFloorEstimator uses the 75th percentile of recently landed tips
+// https://docs.jito.wtf/lowlatencytxnsend/#get-tip-information
+type tipFloorEstimator struct {
+	url    string
+	client *http.Client
+	cfg    config.Config
+	lggr   logger.Logger
+
+	lock    sync.Mutex
+	tip     uint64
+	updated time.Time
+}
+
+type tipFloor struct {
+	LandedTips75thPercentile float64 `json:"landed_tips_75th_percentile"` // SOL
+}
+
+func (e *tipFloorEstimator) BaseTip(ctx context.Context) uint64 {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if time.Since(e.updated) < TipFloorCacheTTL {
+		return e.tip
+	}
+
+	tip, err := e.fetch(ctx)
+	if err != nil {
+		e.lggr.Warnw("failed to fetch tip floor, using default tip", "error", err, "tip", e.cfg.BundleTipDefault())
+		return e.cfg.BundleTipDefault()
+	}
+
+	e.tip = min(max(tip, MinBundleTip), e.cfg.BundleTipMax())
+	e.updated = time.Now()
+	return e.tip
+}
+
+func (e *tipFloorEstimator) fetch(ctx context.Context) (uint64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, e.url, nil)
+	if err != nil {
+		return 0, err
+	}
+	res, err := e.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+
+	var floors []tipFloor
+	if err := json.NewDecoder(res.Body).Decode(&floors); err != nil {
+		return 0, fmt.Errorf("failed to decode tip floor: %w", err)
+	}
+	if len(floors) == 0 {
+		return 0, errors.New("empty tip floor response")
+	}
+	if floors[0].LandedTips75thPercentile < 0 {
+		return 0, fmt.Errorf("invalid tip floor: %f", floors[0].LandedTips75thPercentile)
+	}
+	return uint64(floors[0].LandedTips75thPercentile * float64(solanaGo.LAMPORTS_PER_SOL)), nil
+}
+
+type submittedBundle struct {
+	sig    solanaGo.Signature
+	sentAt time.Time
+}
+
+// bundleSender replaces the RPC broadcast and tracks submitted bundles for status polling
+type bundleSender struct {
+	client *BundleClient
+	tips   TipEstimator
+	lggr   logger.Logger
+
+	lock        sync.Mutex
+	tipAccounts []solanaGo.PublicKey
+	bundles     map[string]submittedBundle       // bundle id -> transaction
+	lastSent    map[solanaGo.Signature]time.Time // prevents resubmitting faster than the block engine rate limit
+}
+
+func newBundleSender(client *BundleClient, tips TipEstimator, lggr logger.Logger) *bundleSender {
+	return &bundleSender{
+		client:   client,
+		tips:     tips,
+		lggr:     logger.Named(lggr, "BundleSender"),
+		bundles:  map[string]submittedBundle{},
+		lastSent: map[solanaGo.Signature]time.Time{},
+	}
+}
+
+// tipAccount returns a random tip account to reduce write lock contention on a single account
+func (s *bundleSender) tipAccount(ctx context.Context) (solanaGo.PublicKey, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if len(s.tipAccounts) == 0 {
+		accounts, err := s.client.GetTipAccounts(ctx)
+		if err != nil {
+			return solanaGo.PublicKey{}, fmt.Errorf("failed to get tip accounts: %w", err)
+		}
+		if len(accounts) == 0 {
+			return solanaGo.PublicKey{}, errors.New("block engine returned no tip accounts")
+		}
+		s.tipAccounts = accounts
+	}
+	return s.tipAccounts[rand.Intn(len(s.tipAccounts))], nil //nolint:gosec // tip account selection does not require secure randomness
+}
+
+// SendTx matches the txm sendTx signature, the transaction must already include the tip instruction
+func (s *bundleSender) SendTx(ctx context.Context, tx *solanaGo.Transaction) (solanaGo.Signature, error) {
+	if len(tx.Signatures) == 0 {
+		return solanaGo.Signature{}, errors.New("transaction is not signed")
+	}
+	sig := tx.Signatures[0]
+
+	s.lock.Lock()
+	if last, exists := s.lastSent[sig]; exists && time.Since(last) < BundleResendInterval {
+		s.lock.Unlock()
+		return sig, nil // bundle was recently submitted, skip rebroadcast
+	}
+	s.lastSent[sig] = time.Now()
+	s.lock.Unlock()
+
+	id, err := s.client.SendBundle(ctx, []*solanaGo.Transaction{tx})
+	if err != nil {
+		return sig, err
+	}
+
+	s.lock.Lock()
+	if _, exists := s.bundles[id]; !exists {
+		s.bundles[id] = submittedBundle{sig: sig, sentAt: time.Now()}
+		s.lggr.Debugw("bundle submitted", "bundleID", id, "signature", sig)
+	}
+	s.lock.Unlock()
+	return sig, nil
+}
+
+// inflight returns a copy of the bundles being tracked
+func (s *bundleSender) inflight() map[string]submittedBundle {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	out := make(map[string]submittedBundle, len(s.bundles))
+	for id, b := range s.bundles {
+		out[id] = b
+	}
+	return out
+}
+
+func (s *bundleSender) remove(id string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if b, exists := s.bundles[id]; exists {
+		delete(s.lastSent, b.sig)
+		delete(s.bundles, id)
+	}
+}
+
+// addTipInstruction appends a transfer from the fee payer to the tip account
+// the base transaction is not modified so it can be rebuilt with a bumped tip
+func addTipInstruction(tx *solanaGo.Transaction, tipAccount solanaGo.PublicKey, lamports uint64) error {
+	msg := tx.Message
+	if len(msg.AccountKeys) == 0 {
+		return errors.New("transaction has no fee payer")
+	}
+	if msg.NumLookups() > 0 {
+		return errors.New("bundle tips are not supported for transactions with address table lookups")
+	}
+
+	// copy slices to prevent modifying the base transaction
+	keys := make(solanaGo.PublicKeySlice, len(msg.AccountKeys))
+	copy(keys, msg.AccountKeys)
+	instructions := make([]solanaGo.CompiledInstruction, len(msg.Instructions))
+	for i, ins := range msg.Instructions {
+		instructions[i] = ins
+		instructions[i].Accounts = append([]uint16{}, ins.Accounts...)
+	}
+
+	tipIdx := -1
+	for i, k := range keys {
+		if k.Equals(tipAccount) {
+			tipIdx = i
+			break
+		}
+	}
+	if tipIdx >= 0 {
+		if writable, err := msg.IsWritable(tipAccount); err != nil || !writable {
+			return fmt.Errorf("tip account %s is included in the transaction as readonly", tipAccount)
+		}
+	} else {
+		// writable non-signer accounts are ordered before readonly non-signer accounts
+		tipIdx = len(keys) - int(msg.Header.NumReadonlyUnsignedAccounts)
+		keys = append(keys[:tipIdx], append(solanaGo.PublicKeySlice{tipAccount}, keys[tipIdx:]...)...)
+		for i := range instructions {
+			if int(instructions[i].ProgramIDIndex) >= tipIdx {
+				instructions[i].ProgramIDIndex++
+			}
+			for j := range instructions[i].Accounts {
+				if int(instructions[i].Accounts[j]) >= tipIdx {
+					instructions[i].Accounts[j]++
+				}
+			}
+		}
+	}
+
+	programIdx := -1
+	for i, k := range keys {
+		if k.Equals(solanaGo.SystemProgramID) {
+			programIdx = i
+			break
+		}
+	}
+	if programIdx < 0 {
+		keys = append(keys, solanaGo.SystemProgramID)
+		programIdx = len(keys) - 1
+		msg.Header.NumReadonlyUnsignedAccounts++
+	}
+
+	data, err := system.NewTransferInstruction(lamports, keys[0], tipAccount).Build().Data()
+	if err != nil {
+		return fmt.Errorf("failed to encode tip transfer: %w", err)
+	}
+	instructions = append(instructions, solanaGo.CompiledInstruction{
+		ProgramIDIndex: uint16(programIdx),          //nolint:gosec // max value would exceed tx size
+		Accounts:       []uint16{0, uint16(tipIdx)}, //nolint:gosec // max value would exceed tx size
+		Data:           data,
+	})
+
+	msg.AccountKeys = keys
+	msg.Instructions = instructions
+	tx.Message = msg
+	return nil
+}
+
+// bundleTip returns the tip for the given bump count
+func bundleTip(base, max uint64, count int) uint64 {
+	return fees.CalculateFee(base, max, MinBundleTip, uint(count)) //nolint:gosec // reasonable number of bumps should never cause overflow
+}
+
+// confirmBundles polls the block engine for landed bundles and updates the transaction state
+// signature polling in confirm continues to run, bundle statuses report failed bundles that never reach the RPCs
+// landing and drops are reported for the state changes made here, the same as signature polling
+func (txm *Txm) confirmBundles() {
+	defer txm.done.Done()
+	ctx, cancel := txm.chStop.NewCtx()
+	defer cancel()
+
+	tick := time.After(0)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-tick:
+			txm.processBundleStatuses(ctx)
+		}
+		tick = time.After(utils.WithJitter(txm.cfg.ConfirmPollPeriod()))
+	}
+}
+
+func (txm *Txm) processBundleStatuses(ctx context.Context) {
+	bundles := txm.bundles.inflight()
+	if len(bundles) == 0 {
+		return
+	}
+
+	// stop tracking bundles for transactions that are no longer pending (finalized, errored, or dropped)
+	pending := map[solanaGo.Signature]struct{}{}
+	for _, sig := range txm.txs.ListAll() {
+		pending[sig] = struct{}{}
+	}
+	var ids []string
+	for id, b := range bundles {
+		if _, exists := pending[b.sig]; !exists && time.Since(b.sentAt) > BundleResendInterval {
+			txm.bundles.remove(id)
+			continue
+		}
+		ids = append(ids, id)
+	}
+
+	batches, err := utils.BatchSplit(ids, MaxBundleStatuses)
+	if err != nil { // this should never happen
+		txm.lggr.Errorw("failed to batch bundle ids", "error", err)
+		return
+	}
+
+	for _, batch := range batches {
+		statuses, err := txm.bundles.client.GetBundleStatuses(ctx, batch)
+		if err != nil {
+			txm.lggr.Errorw("failed to get bundle statuses", "error", err)
+			return
+		}
+
+		for i, status := range statuses {
+			id, sig := batch[i], bundles[batch[i]].sig
+
+			// bundle has not landed (yet), drop the transaction once the confirmation timeout is exceeded
+			if status == nil {
+				if txm.txs.Expired(sig, txm.cfg.TxConfirmTimeout()) {
+					txID, err := txm.txs.OnError(sig, txm.cfg.TxRetentionTimeout(), TxFailDrop)
+					if err != nil {
+						txm.lggr.Infow("failed to mark transaction as errored", "id", txID, "signature", sig, "bundleID", id, "error", err)
+					} else {
+						txm.lggr.Infow("bundle failed to land within confirm timeout", "id", txID, "signature", sig, "bundleID", id, "timeoutSeconds", txm.cfg.TxConfirmTimeout())
+						txm.reportLanding(sig, false)
+					}
+					txm.bundles.remove(id)
+				}
+				continue
+			}
+
+			switch {
+			case status.Failed():
+				txID, err := txm.txs.OnError(sig, txm.cfg.TxRetentionTimeout(), TxFailRevert)
+				if err != nil {
+					txm.lggr.Infow("failed to mark transaction as errored", "id", txID, "signature", sig, "bundleID", id, "error", err)
+				} else {
+					txm.lggr.Debugw("bundle state: failed", "id", txID, "signature", sig, "bundleID", id, "error", status.Err)
+					txm.reportLanding(sig, true) // reverted transactions are included onchain
+				}
+				txm.bundles.remove(id)
+			case status.ConfirmationStatus == rpc.ConfirmationStatusProcessed:
+				txID, err := txm.txs.OnProcessed(sig)
+				if err != nil && !errors.Is(err, ErrAlreadyInExpectedState) {
+					txm.lggr.Debugw("failed to mark transaction as processed", "signature", sig, "bundleID", id, "error", err)
+				} else if err == nil {
+					txm.lggr.Debugw("marking bundled transaction as processed", "id", txID, "signature", sig, "bundleID", id)
+					txm.reportLanding(sig, true)
+				}
+			case status.ConfirmationStatus == rpc.ConfirmationStatusConfirmed:
+				txID, err := txm.txs.OnConfirmed(sig)
+				if err != nil && !errors.Is(err, ErrAlreadyInExpectedState) {
+					txm.lggr.Debugw("failed to mark transaction as confirmed", "signature", sig, "bundleID", id, "error", err)
+				} else if err == nil {
+					txm.lggr.Debugw("marking bundled transaction as confirmed", "id", txID, "signature", sig, "bundleID", id)
+					txm.reportLanding(sig, true)
+				}
+			case status.ConfirmationStatus == rpc.ConfirmationStatusFinalized:
+				txID, err := txm.txs.OnFinalized(sig, txm.cfg.TxRetentionTimeout())
+				if err != nil {
+					txm.lggr.Debugw("failed to mark transaction as finalized", "signature", sig, "bundleID", id, "error", err)
+				} else {
+					txm.lggr.Debugw("marking bundled transaction as finalized", "id", txID, "signature", sig, "bundleID", id)
+					txm.reportLanding(sig, true)
+				}
+				txm.bundles.remove(id)
+			}
+		}
+	}
+}
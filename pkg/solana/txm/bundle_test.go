@@ -0,0 +1,436 @@
+package txm
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	bin "github.com/gagliardetto/binary"
+	solanaGo "github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	relayconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	solanaClient "github.com/smartcontractkit/chainlink-solana/pkg/solana/client"
+	clientmocks "github.com/smartcontractkit/chainlink-solana/pkg/solana/client/mocks"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/fees"
+	ksmocks "github.com/smartcontractkit/chainlink-solana/pkg/solana/txm/mocks"
+)
+
+// stubBlockEngine implements the block engine bundle JSON-RPC methods
+type stubBlockEngine struct {
+	t           *testing.T
+	lock        sync.Mutex
+	tipAccounts []solanaGo.PublicKey
+	bundles     []*solanaGo.Transaction
+	statuses    map[string]*BundleStatus
+	rpcErr      *BundleRPCError
+}
+
+func parseURL(t *testing.T, raw string) *url.URL {
+	u, err := url.Parse(raw)
+	require.NoError(t, err)
+	return u
+}
+
+func newStubBlockEngine(t *testing.T) (*stubBlockEngine, *httptest.Server) {
+	engine := &stubBlockEngine{
+		t:           t,
+		tipAccounts: []solanaGo.PublicKey{{1}},
+		statuses:    map[string]*BundleStatus{},
+	}
+	srv := httptest.NewServer(engine)
+	t.Cleanup(srv.Close)
+	return engine, srv
+}
+
+func (s *stubBlockEngine) setStatus(id string, status *BundleStatus) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.statuses[id] = status
+}
+
+func (s *stubBlockEngine) sent() []*solanaGo.Transaction {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]*solanaGo.Transaction{}, s.bundles...)
+}
+
+func (s *stubBlockEngine) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     uint64            `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+	require.NoError(s.t, json.NewDecoder(r.Body).Decode(&req))
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	res := map[string]interface{}{"jsonrpc": "2.0", "id": req.ID}
+	defer func() {
+		assert.NoError(s.t, json.NewEncoder(w).Encode(res))
+	}()
+	if s.rpcErr != nil {
+		res["error"] = s.rpcErr
+		return
+	}
+
+	switch req.Method {
+	case "sendBundle":
+		var encoded []string
+		require.NoError(s.t, json.Unmarshal(req.Params[0], &encoded))
+		require.Len(s.t, encoded, 1)
+		raw, err := base64.StdEncoding.DecodeString(encoded[0])
+		require.NoError(s.t, err)
+		tx, err := solanaGo.TransactionFromDecoder(bin.NewBinDecoder(raw))
+		require.NoError(s.t, err)
+		s.bundles = append(s.bundles, tx)
+		res["result"] = tx.Signatures[0].String() // bundle id derived from signatures
+	case "getBundleStatuses":
+		var ids []string
+		require.NoError(s.t, json.Unmarshal(req.Params[0], &ids))
+		value := make([]*BundleStatus, len(ids))
+		for i, id := range ids {
+			value[i] = s.statuses[id]
+		}
+		res["result"] = map[string]interface{}{"context": map[string]uint64{"slot": 1}, "value": value}
+	case "getTipAccounts":
+		res["result"] = s.tipAccounts
+	default:
+		res["error"] = BundleRPCError{Code: -32601, Message: "method not found"}
+	}
+}
+
+func TestBundleClient(t *testing.T) {
+	ctx := tests.Context(t)
+	engine, srv := newStubBlockEngine(t)
+	client := NewBundleClient(parseURL(t, srv.URL), time.Second)
+
+	accounts, err := client.GetTipAccounts(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, engine.tipAccounts, accounts)
+
+	tx := &solanaGo.Transaction{Signatures: []solanaGo.Signature{{1}}}
+	tx.Message.AccountKeys = solanaGo.PublicKeySlice{{2}}
+	id, err := client.SendBundle(ctx, []*solanaGo.Transaction{tx})
+	require.NoError(t, err)
+	assert.Equal(t, tx.Signatures[0].String(), id)
+	require.Len(t, engine.sent(), 1)
+	assert.Equal(t, tx.Message.AccountKeys, engine.sent()[0].Message.AccountKeys)
+
+	// unknown bundles are nil
+	engine.setStatus(id, &BundleStatus{
+		BundleID:           id,
+		Transactions:       tx.Signatures,
+		Slot:               10,
+		ConfirmationStatus: rpc.ConfirmationStatusConfirmed,
+		Err:                map[string]interface{}{"Ok": nil},
+	})
+	statuses, err := client.GetBundleStatuses(ctx, []string{"unknown", id})
+	require.NoError(t, err)
+	require.Len(t, statuses, 2)
+	assert.Nil(t, statuses[0])
+	require.NotNil(t, statuses[1])
+	assert.Equal(t, rpc.ConfirmationStatusConfirmed, statuses[1].ConfirmationStatus)
+	assert.Equal(t, tx.Signatures, statuses[1].Transactions)
+	assert.False(t, statuses[1].Failed())
+	assert.True(t, BundleStatus{Err: map[string]interface{}{"Err": "BundleFailed"}}.Failed())
+
+	_, err = client.GetBundleStatuses(ctx, make([]string, MaxBundleStatuses+1))
+	require.ErrorContains(t, err, "too many bundle ids")
+
+	// block engine errors are returned
+	engine.lock.Lock()
+	engine.rpcErr = &BundleRPCError{Code: -32097, Message: "rate limited"}
+	engine.lock.Unlock()
+	_, err = client.GetTipAccounts(ctx)
+	var rpcErr *BundleRPCError
+	require.ErrorAs(t, err, &rpcErr)
+	assert.Equal(t, -32097, rpcErr.Code)
+}
+
+func TestAddTipInstruction(t *testing.T) {
+	payer, receiver, tipAccount := solanaGo.PublicKey{1}, solanaGo.PublicKey{2}, solanaGo.PublicKey{3}
+	base, err := solanaGo.NewTransaction(
+		[]solanaGo.Instruction{system.NewTransferInstruction(5, payer, receiver).Build()},
+		solanaGo.Hash{},
+		solanaGo.TransactionPayer(payer),
+	)
+	require.NoError(t, err)
+	// compute budget program is appended as a readonly account
+	require.NoError(t, fees.SetComputeUnitPrice(base, 10))
+	baseKeys := len(base.Message.AccountKeys)
+
+	tx := *base
+	require.NoError(t, addTipInstruction(&tx, tipAccount, 2_000))
+
+	// base transaction is not modified
+	assert.Len(t, base.Message.AccountKeys, baseKeys)
+	assert.Len(t, base.Message.Instructions, 2)
+
+	// tip account is a writable non-signer
+	require.Len(t, tx.Message.AccountKeys, baseKeys+1)
+	writable, err := tx.Message.IsWritable(tipAccount)
+	require.NoError(t, err)
+	assert.True(t, writable)
+	assert.False(t, tx.Message.IsSigner(tipAccount))
+	assert.Equal(t, base.Message.Header, tx.Message.Header) // system program already included
+
+	decode := func(ins solanaGo.CompiledInstruction) (solanaGo.PublicKey, []*solanaGo.AccountMeta) {
+		program, err := tx.Message.Program(ins.ProgramIDIndex)
+		require.NoError(t, err)
+		accounts, err := ins.ResolveInstructionAccounts(&tx.Message)
+		require.NoError(t, err)
+		return program, accounts
+	}
+
+	// existing instructions reference the same accounts after the tip account is inserted
+	require.Len(t, tx.Message.Instructions, 3)
+	program, _ := decode(tx.Message.Instructions[0])
+	assert.Equal(t, fees.ComputeBudgetProgram, program)
+	program, accounts := decode(tx.Message.Instructions[1])
+	assert.Equal(t, solanaGo.SystemProgramID, program)
+	assert.Equal(t, receiver, accounts[1].PublicKey)
+
+	// tip transfer is appended
+	program, accounts = decode(tx.Message.Instructions[2])
+	assert.Equal(t, solanaGo.SystemProgramID, program)
+	ins, err := system.DecodeInstruction(accounts, tx.Message.Instructions[2].Data)
+	require.NoError(t, err)
+	transfer, ok := ins.Impl.(*system.Transfer)
+	require.True(t, ok)
+	assert.Equal(t, uint64(2_000), *transfer.Lamports)
+	assert.Equal(t, payer, transfer.GetFundingAccount().PublicKey)
+	assert.Equal(t, tipAccount, transfer.GetRecipientAccount().PublicKey)
+
+	// system program is added if not present
+	tx = solanaGo.Transaction{}
+	tx.Message.AccountKeys = solanaGo.PublicKeySlice{payer}
+	tx.Message.Header.NumRequiredSignatures = 1
+	require.NoError(t, addTipInstruction(&tx, tipAccount, 2_000))
+	assert.Equal(t, []solanaGo.PublicKey{payer, tipAccount, solanaGo.SystemProgramID}, tx.Message.AccountKeys)
+	assert.Equal(t, uint8(1), tx.Message.Header.NumReadonlyUnsignedAccounts)
+
+	// tip account cannot be readonly
+	tx = solanaGo.Transaction{}
+	tx.Message.AccountKeys = solanaGo.PublicKeySlice{payer, tipAccount}
+	tx.Message.Header.NumRequiredSignatures = 1
+	tx.Message.Header.NumReadonlyUnsignedAccounts = 1
+	require.ErrorContains(t, addTipInstruction(&tx, tipAccount, 2_000), "readonly")
+}
+
+func TestTipEstimator(t *testing.T) {
+	ctx := tests.Context(t)
+	lggr := logger.Test(t)
+
+	cfg := config.NewDefault()
+	assert.Equal(t, cfg.BundleTipDefault(), NewTipEstimator(cfg, lggr).BaseTip(ctx))
+
+	var lock sync.Mutex
+	var calls int
+	floor := `[{"landed_tips_75th_percentile": 0.00005}]`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		calls++
+		if floor == "" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprint(w, floor)
+	}))
+	defer srv.Close()
+
+	newEstimator := func(tipMax uint64) TipEstimator {
+		cfg := config.NewDefault()
+		cfg.Chain.BundleTipFloorEndpoint = relayconfig.MustParseURL(srv.URL)
+		cfg.Chain.BundleTipMax = &tipMax
+		return NewTipEstimator(cfg, lggr)
+	}
+
+	// floor is converted to lamports and cached
+	estimator := newEstimator(1_000_000)
+	assert.Equal(t, uint64(50_000), estimator.BaseTip(ctx))
+	assert.Equal(t, uint64(50_000), estimator.BaseTip(ctx))
+	lock.Lock()
+	assert.Equal(t, 1, calls)
+	lock.Unlock()
+
+	// floor is bounded by the max tip
+	assert.Equal(t, uint64(20_000), newEstimator(20_000).BaseTip(ctx))
+
+	// default tip is used if the floor is unavailable
+	lock.Lock()
+	floor = ""
+	lock.Unlock()
+	assert.Equal(t, cfg.BundleTipDefault(), newEstimator(1_000_000).BaseTip(ctx))
+}
+
+func TestBundleTip(t *testing.T) {
+	assert.Equal(t, uint64(MinBundleTip), bundleTip(0, 10_000, 0))
+	assert.Equal(t, uint64(5_000), bundleTip(5_000, 10_000, 0))
+	assert.Equal(t, uint64(10_000), bundleTip(5_000, 10_000, 1))
+	assert.Equal(t, uint64(10_000), bundleTip(5_000, 10_000, 5))
+}
+
+func TestTxm_BundleSubmission(t *testing.T) {
+	ctx := tests.Context(t)
+	engine, srv := newStubBlockEngine(t)
+
+	cfg := config.NewDefault()
+	cfg.Chain.BundleEndpoint = relayconfig.MustParseURL(srv.URL)
+	cfg.Chain.FeeBumpPeriod = relayconfig.MustNewDuration(0)
+	cfg.Chain.TxRetentionTimeout = relayconfig.MustNewDuration(5 * time.Second)
+
+	ks := ksmocks.NewSimpleKeystore(t)
+	ks.On("Sign", mock.Anything, mock.Anything, mock.Anything).Return([]byte{1}, nil)
+	loader := utils.NewLazyLoad(func() (solanaClient.ReaderWriter, error) {
+		return clientmocks.NewReaderWriter(t), nil
+	})
+	txm := NewTxm("bundle_submission", loader, nil, cfg, ks, logger.Test(t))
+	require.NotNil(t, txm.bundles)
+	var landed []bool
+	txm.SetLandingObserver(func(_ solanaGo.Signature, l bool) { landed = append(landed, l) })
+	fee, err := fees.NewFixedPriceEstimator(cfg)
+	require.NoError(t, err)
+	txm.fee = fee
+
+	msg := NewTestMsg()
+	msg.id = "bundle"
+	msg.tx.Message.Header.NumRequiredSignatures = 1
+	msg.cfg = txm.defaultTxConfig()
+	_, _, sig, err := txm.sendWithRetry(ctx, msg)
+	require.NoError(t, err)
+
+	// transaction is submitted as a bundle with a tip
+	sent := engine.sent()
+	require.NotEmpty(t, sent)
+	tx := sent[0]
+	assert.Equal(t, sig, tx.Signatures[0])
+	tipIns := tx.Message.Instructions[len(tx.Message.Instructions)-1]
+	accounts, err := tipIns.ResolveInstructionAccounts(&tx.Message)
+	require.NoError(t, err)
+	ins, err := system.DecodeInstruction(accounts, tipIns.Data)
+	require.NoError(t, err)
+	transfer, ok := ins.Impl.(*system.Transfer)
+	require.True(t, ok)
+	assert.Equal(t, cfg.BundleTipDefault(), *transfer.Lamports)
+	assert.Equal(t, engine.tipAccounts[0], transfer.GetRecipientAccount().PublicKey)
+
+	// compute unit limit includes the tip transfer
+	var limit fees.ComputeUnitLimit
+	for _, ins := range tx.Message.Instructions {
+		if parsed, err := fees.ParseComputeUnitLimit(ins.Data); err == nil {
+			limit = parsed
+		}
+	}
+	assert.Equal(t, fees.ComputeUnitLimit(cfg.ComputeUnitLimitDefault()+BundleTipComputeUnits), limit)
+
+	// bundle statuses update the transaction state
+	bundleID := sig.String()
+	require.Contains(t, txm.bundles.inflight(), bundleID)
+	txm.processBundleStatuses(ctx) // not landed
+	state, err := txm.txs.GetTxState(msg.id)
+	require.NoError(t, err)
+	assert.Equal(t, Broadcasted, state)
+	assert.Empty(t, landed)
+
+	engine.setStatus(bundleID, &BundleStatus{BundleID: bundleID, ConfirmationStatus: rpc.ConfirmationStatusConfirmed, Err: map[string]interface{}{"Ok": nil}})
+	txm.processBundleStatuses(ctx)
+	state, err = txm.txs.GetTxState(msg.id)
+	require.NoError(t, err)
+	assert.Equal(t, Confirmed, state)
+	assert.Equal(t, []bool{true}, landed)
+
+	engine.setStatus(bundleID, &BundleStatus{BundleID: bundleID, ConfirmationStatus: rpc.ConfirmationStatusFinalized, Err: map[string]interface{}{"Ok": nil}})
+	txm.processBundleStatuses(ctx)
+	state, err = txm.txs.GetTxState(msg.id)
+	require.NoError(t, err)
+	assert.Equal(t, Finalized, state)
+	assert.Equal(t, []bool{true, true}, landed)
+	assert.Empty(t, txm.bundles.inflight())
+}
+
+func TestTxm_BundleDropped(t *testing.T) {
+	ctx := tests.Context(t)
+	_, srv := newStubBlockEngine(t)
+
+	cfg := config.NewDefault()
+	cfg.Chain.BundleEndpoint = relayconfig.MustParseURL(srv.URL)
+	cfg.Chain.FeeBumpPeriod = relayconfig.MustNewDuration(0)
+	cfg.Chain.TxConfirmTimeout = relayconfig.MustNewDuration(time.Millisecond)
+	cfg.Chain.TxRetentionTimeout = relayconfig.MustNewDuration(5 * time.Second)
+
+	ks := ksmocks.NewSimpleKeystore(t)
+	ks.On("Sign", mock.Anything, mock.Anything, mock.Anything).Return([]byte{1}, nil)
+	loader := utils.NewLazyLoad(func() (solanaClient.ReaderWriter, error) {
+		return clientmocks.NewReaderWriter(t), nil
+	})
+	txm := NewTxm("bundle_dropped", loader, nil, cfg, ks, logger.Test(t))
+	var landed []bool
+	txm.SetLandingObserver(func(_ solanaGo.Signature, l bool) { landed = append(landed, l) })
+	fee, err := fees.NewFixedPriceEstimator(cfg)
+	require.NoError(t, err)
+	txm.fee = fee
+
+	msg := NewTestMsg()
+	msg.id = "dropped"
+	msg.tx.Message.Header.NumRequiredSignatures = 1
+	msg.cfg = txm.defaultTxConfig()
+	_, _, _, err = txm.sendWithRetry(ctx, msg)
+	require.NoError(t, err)
+
+	// bundle never lands within the confirm timeout
+	require.Eventually(t, func() bool {
+		txm.processBundleStatuses(ctx)
+		return len(txm.bundles.inflight()) == 0
+	}, tests.WaitTimeout(t), 10*time.Millisecond)
+	state, err := txm.txs.GetTxState(msg.id)
+	require.NoError(t, err)
+	assert.Equal(t, Errored, state)
+	assert.Equal(t, []bool{false}, landed)
+}
+
+func TestBundleSender_SendTx(t *testing.T) {
+	ctx := tests.Context(t)
+	engine, srv := newStubBlockEngine(t)
+	sender := newBundleSender(NewBundleClient(parseURL(t, srv.URL), time.Second), &fixedTipEstimator{cfg: config.NewDefault()}, logger.Test(t))
+
+	_, err := sender.SendTx(ctx, &solanaGo.Transaction{})
+	require.ErrorContains(t, err, "not signed")
+
+	tx := &solanaGo.Transaction{Signatures: []solanaGo.Signature{{1}}}
+	tx.Message.AccountKeys = solanaGo.PublicKeySlice{{2}}
+
+	// rebroadcasts within the resend interval are skipped
+	for i := 0; i < 3; i++ {
+		sig, err := sender.SendTx(ctx, tx)
+		require.NoError(t, err)
+		assert.Equal(t, tx.Signatures[0], sig)
+	}
+	assert.Len(t, engine.sent(), 1)
+	assert.Len(t, sender.inflight(), 1)
+
+	// tip accounts are loaded once
+	account, err := sender.tipAccount(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, engine.tipAccounts[0], account)
+
+	sender.remove(tx.Signatures[0].String())
+	assert.Empty(t, sender.inflight())
+}
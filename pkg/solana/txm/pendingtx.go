@@ -52,6 +52,8 @@ type pendingTx struct {
 	createTs    time.Time
 	retentionTs time.Time
 	state       TxState
+	// presigned transactions are signed offline and broadcast without changes (see EnqueueSigned)
+	presigned bool
 }
 
 var _ PendingTxContext = &pendingTxContext{}
@@ -0,0 +1,57 @@
+package txm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	solanaGo "github.com/gagliardetto/solana-go"
+	"github.com/google/uuid"
+)
+
+// EnqueueSigned enqueues a transaction signed offline, e.g. by multisig members using a durable nonce (see pkg/solana/multisig).
+// The transaction is broadcast as is: it is not re-signed, compute unit price and limit are not changed, fees are not bumped,
+// and it is never submitted as a bundle. The transaction status is tracked like enqueued transactions, use GetTransactionStatus.
+func (txm *Txm) EnqueueSigned(ctx context.Context, accountID string, tx *solanaGo.Transaction, txID *string) error {
+	if err := txm.Ready(); err != nil {
+		return fmt.Errorf("error in soltxm.EnqueueSigned: %w", err)
+	}
+
+	// validate nil pointer
+	if tx == nil {
+		return errors.New("error in soltxm.EnqueueSigned: tx is nil pointer")
+	}
+	// validate account keys slice
+	if len(tx.Message.AccountKeys) == 0 {
+		return errors.New("error in soltxm.EnqueueSigned: not enough account keys in tx")
+	}
+	// all signatures must be present, the fee payer key is not required to be in the keystore
+	if err := tx.VerifySignatures(); err != nil {
+		return fmt.Errorf("error in soltxm.EnqueueSigned.VerifySignatures: %w", err)
+	}
+
+	cfg := txm.defaultTxConfig()
+	cfg.FeeBumpPeriod = 0
+	cfg.ComputeUnitLimit = 0
+	cfg.EstimateComputeUnitLimit = false
+
+	// Use transaction ID provided by caller if set
+	id := uuid.New().String()
+	if txID != nil && *txID != "" {
+		id = *txID
+	}
+	msg := pendingTx{
+		tx:        *tx,
+		cfg:       cfg,
+		id:        id,
+		presigned: true,
+	}
+
+	select {
+	case txm.chSend <- msg:
+	default:
+		txm.lggr.Errorw("failed to enqeue signed tx", "queueFull", len(txm.chSend) == MaxQueueLen, "tx", msg)
+		return fmt.Errorf("failed to enqueue signed transaction for %s", accountID)
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package txm
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	solanaGo "github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	relayconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	solanaClient "github.com/smartcontractkit/chainlink-solana/pkg/solana/client"
+	clientmocks "github.com/smartcontractkit/chainlink-solana/pkg/solana/client/mocks"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/fees"
+	ksmocks "github.com/smartcontractkit/chainlink-solana/pkg/solana/txm/mocks"
+)
+
+// signedTx returns a transfer signed by a key which is not in the txm keystore
+func signedTx(t *testing.T) *solanaGo.Transaction {
+	payer, err := solanaGo.NewRandomPrivateKey()
+	require.NoError(t, err)
+	tx, err := solanaGo.NewTransaction(
+		[]solanaGo.Instruction{system.NewTransferInstruction(1, payer.PublicKey(), solanaGo.PublicKey{2}).Build()},
+		solanaGo.Hash{1},
+		solanaGo.TransactionPayer(payer.PublicKey()),
+	)
+	require.NoError(t, err)
+	_, err = tx.Sign(func(solanaGo.PublicKey) *solanaGo.PrivateKey { return &payer })
+	require.NoError(t, err)
+	return tx
+}
+
+func TestTxm_SendWithRetry_Presigned(t *testing.T) {
+	ctx := tests.Context(t)
+	engine, srv := newStubBlockEngine(t)
+
+	cfg := config.NewDefault()
+	cfg.Chain.BundleEndpoint = relayconfig.MustParseURL(srv.URL)
+	cfg.Chain.TxRetentionTimeout = relayconfig.MustNewDuration(5 * time.Second)
+
+	var lock sync.Mutex
+	var sent []solanaGo.Transaction
+	sendTx := func(_ context.Context, tx *solanaGo.Transaction) (solanaGo.Signature, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		sent = append(sent, *tx)
+		return tx.Signatures[0], nil
+	}
+	// the keystore is never used to sign presigned transactions
+	ks := ksmocks.NewSimpleKeystore(t)
+	loader := utils.NewLazyLoad(func() (solanaClient.ReaderWriter, error) {
+		return clientmocks.NewReaderWriter(t), nil
+	})
+	txm := NewTxm("presigned", loader, sendTx, cfg, ks, logger.Test(t))
+	fee, err := fees.NewFixedPriceEstimator(cfg)
+	require.NoError(t, err)
+	txm.fee = fee
+
+	tx := signedTx(t)
+	raw, err := tx.MarshalBinary()
+	require.NoError(t, err)
+	msg := pendingTx{tx: *tx, cfg: txm.defaultTxConfig(), id: "presigned", presigned: true}
+	msg.cfg.FeeBumpPeriod = 0 // as set by EnqueueSigned
+	_, id, sig, err := txm.sendWithRetry(ctx, msg)
+	require.NoError(t, err)
+	assert.Equal(t, "presigned", id)
+	assert.Equal(t, tx.Signatures[0], sig)
+
+	// broadcast to RPCs unchanged, even with bundle submission enabled
+	require.Eventually(t, func() bool {
+		lock.Lock()
+		defer lock.Unlock()
+		return len(sent) > 1
+	}, 5*time.Second, 10*time.Millisecond)
+	lock.Lock()
+	for _, s := range sent {
+		out, err := s.MarshalBinary()
+		require.NoError(t, err)
+		assert.Equal(t, raw, out)
+	}
+	lock.Unlock()
+	assert.Empty(t, engine.sent())
+
+	state, err := txm.txs.GetTxState("presigned")
+	require.NoError(t, err)
+	assert.Equal(t, Broadcasted, state)
+}
+
+func TestTxm_EnqueueSigned(t *testing.T) {
+	ctx := tests.Context(t)
+	mc := clientmocks.NewReaderWriter(t)
+	mc.On("SendTx", mock.Anything, mock.Anything).Return(solanaGo.Signature{}, nil).Maybe()
+	mc.On("SimulateTx", mock.Anything, mock.Anything, mock.Anything).Return(&rpc.SimulateTransactionResult{}, nil).Maybe()
+	mc.On("SignatureStatuses", mock.Anything, mock.Anything).Return([]*rpc.SignatureStatusesResult{}, nil).Maybe()
+	loader := utils.NewLazyLoad(func() (solanaClient.ReaderWriter, error) { return mc, nil })
+	txm := NewTxm("enqueue_signed", loader, nil, config.NewDefault(), ksmocks.NewSimpleKeystore(t), logger.Test(t))
+
+	tx := signedTx(t)
+	require.ErrorContains(t, txm.EnqueueSigned(ctx, "unstarted", tx, nil), "not started")
+	require.NoError(t, txm.Start(ctx))
+	t.Cleanup(func() { require.NoError(t, txm.Close()) })
+
+	unsigned := *tx
+	unsigned.Signatures = nil
+	invalid := *tx
+	invalid.Signatures = []solanaGo.Signature{{1}}
+
+	for _, run := range []struct {
+		name string
+		tx   *solanaGo.Transaction
+		err  string
+	}{
+		{"success", tx, ""},
+		{"nil_pointer", nil, "nil pointer"},
+		{"empty_tx", &solanaGo.Transaction{}, "not enough account keys"},
+		{"missing_signature", &unsigned, "signatures"},
+		{"invalid_signature", &invalid, "invalid signature"},
+	} {
+		t.Run(run.name, func(t *testing.T) {
+			err := txm.EnqueueSigned(ctx, run.name, run.tx, nil)
+			if run.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, run.err)
+		})
+	}
+}
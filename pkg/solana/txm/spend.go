@@ -0,0 +1,120 @@
+package txm
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/fees"
+)
+
+const (
+	// FeeSpendWindow is the period over which landed transaction fees are tracked per key
+	FeeSpendWindow = 24 * time.Hour
+	// LamportsPerSignature is the base fee charged per transaction signature
+	LamportsPerSignature = 5_000
+	// DefaultComputeUnitsPerInstruction is the compute unit limit per instruction if no limit is set
+	DefaultComputeUnitsPerInstruction = 200_000
+	// MaxComputeUnitLimit is the maximum compute unit limit of a transaction
+	MaxComputeUnitLimit = 1_400_000
+)
+
+type sentFee struct {
+	payer    solana.PublicKey
+	lamports uint64
+	sentAt   time.Time
+}
+
+type landedFee struct {
+	lamports uint64
+	at       time.Time
+}
+
+// spendTracker records the fees of broadcast signatures and the fees spent per fee payer once a signature lands
+type spendTracker struct {
+	lock    sync.Mutex
+	started time.Time
+	sent    map[solana.Signature]sentFee
+	landed  map[solana.PublicKey][]landedFee
+}
+
+func newSpendTracker() *spendTracker {
+	return &spendTracker{
+		started: time.Now(),
+		sent:    map[solana.Signature]sentFee{},
+		landed:  map[solana.PublicKey][]landedFee{},
+	}
+}
+
+// onSent records the fee paid if the signature lands
+func (s *spendTracker) onSent(sig solana.Signature, payer solana.PublicKey, lamports uint64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	// signatures replaced by a fee bump or dropped without being reported are pruned
+	for k, v := range s.sent {
+		if time.Since(v.sentAt) > FeeSpendWindow {
+			delete(s.sent, k)
+		}
+	}
+	s.sent[sig] = sentFee{payer: payer, lamports: lamports, sentAt: time.Now()}
+}
+
+// onLanded moves the fee of the signature to the payer spend, only the first report for a signature is counted
+func (s *spendTracker) onLanded(sig solana.Signature) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	fee, ok := s.sent[sig]
+	if !ok {
+		return
+	}
+	delete(s.sent, sig)
+	s.landed[fee.payer] = append(s.prune(fee.payer), landedFee{lamports: fee.lamports, at: time.Now()})
+}
+
+func (s *spendTracker) onDropped(sig solana.Signature) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.sent, sig)
+}
+
+// spend returns the fees spent by the payer and the period they were observed over (at most FeeSpendWindow)
+func (s *spendTracker) spend(payer solana.PublicKey) (uint64, time.Duration) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var total uint64
+	for _, fee := range s.prune(payer) {
+		total += fee.lamports
+	}
+	return total, min(time.Since(s.started), FeeSpendWindow)
+}
+
+// prune drops fees outside of the window, must be called with lock held
+func (s *spendTracker) prune(payer solana.PublicKey) []landedFee {
+	landed := s.landed[payer]
+	i := 0
+	for i < len(landed) && time.Since(landed[i].at) > FeeSpendWindow {
+		i++
+	}
+	landed = landed[i:]
+	if len(landed) == 0 {
+		delete(s.landed, payer)
+		return nil
+	}
+	s.landed[payer] = landed
+	return landed
+}
+
+// txFee returns the lamports charged for a transaction: base fee per signature, priority fee, and bundle tip
+func txFee(tx *solana.Transaction, price fees.ComputeUnitPrice, limit uint32, tip uint64) uint64 {
+	computeUnits := uint64(limit)
+	if computeUnits == 0 {
+		computeUnits = min(uint64(len(tx.Message.Instructions))*DefaultComputeUnitsPerInstruction, MaxComputeUnitLimit)
+	}
+	// compute unit price is in micro-lamports, rounded up
+	priorityFee := (uint64(price)*computeUnits + 999_999) / 1_000_000
+	return uint64(tx.Message.Header.NumRequiredSignatures)*LamportsPerSignature + priorityFee + tip
+}
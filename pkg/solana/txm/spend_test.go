@@ -0,0 +1,59 @@
+package txm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/fees"
+)
+
+func TestSpendTracker(t *testing.T) {
+	s := newSpendTracker()
+	payer, other := solana.PublicKey{1}, solana.PublicKey{2}
+
+	s.onSent(solana.Signature{1}, payer, 5_000)
+	s.onSent(solana.Signature{2}, payer, 7_000) // fee bumped signature
+	s.onSent(solana.Signature{3}, other, 5_000)
+	s.onSent(solana.Signature{4}, payer, 9_000)
+
+	spent, period := s.spend(payer)
+	assert.Zero(t, spent)
+	assert.Greater(t, period, time.Duration(0))
+
+	s.onLanded(solana.Signature{2})
+	s.onLanded(solana.Signature{2}) // counted once
+	s.onDropped(solana.Signature{4})
+	s.onLanded(solana.Signature{4}) // dropped signatures are not counted
+	s.onLanded(solana.Signature{3})
+
+	spent, _ = s.spend(payer)
+	assert.Equal(t, uint64(7_000), spent)
+	spent, _ = s.spend(other)
+	assert.Equal(t, uint64(5_000), spent)
+
+	// fees outside of the window are dropped
+	s.landed[payer][0].at = time.Now().Add(-FeeSpendWindow - time.Minute)
+	spent, _ = s.spend(payer)
+	assert.Zero(t, spent)
+	assert.NotContains(t, s.landed, payer)
+
+	// period is capped at the window
+	s.started = time.Now().Add(-2 * FeeSpendWindow)
+	_, period = s.spend(payer)
+	assert.Equal(t, FeeSpendWindow, period)
+}
+
+func TestTxFee(t *testing.T) {
+	from, to := solana.PublicKey{1}, solana.PublicKey{2}
+	tx, err := solana.NewTransaction([]solana.Instruction{system.NewTransferInstruction(1, from, to).Build()}, solana.Hash{}, solana.TransactionPayer(from))
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(5_000), txFee(tx, 0, 0, 0))
+	assert.Equal(t, uint64(5_000+1), txFee(tx, 1, 500, 0))                                      // priority fee rounded up
+	assert.Equal(t, uint64(5_000+200+1_000), txFee(tx, fees.ComputeUnitPrice(1_000), 0, 1_000)) // default limit + tip
+}
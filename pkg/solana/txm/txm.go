@@ -62,6 +62,15 @@ type Txm struct {
 	// sendTx is an override for sending transactions rather than using a single client
 	// Enabling MultiNode uses this function to send transactions to all RPCs
 	sendTx func(ctx context.Context, tx *solanaGo.Transaction) (solanaGo.Signature, error)
+	// sendPresignedTx broadcasts transactions signed offline, they can't include a bundle tip so are always sent to RPCs
+	sendPresignedTx func(ctx context.Context, tx *solanaGo.Transaction) (solanaGo.Signature, error)
+	// bundles is set when bundle submission is enabled (BundleEndpoint), and replaces sendTx
+	bundles *bundleSender
+	// landingObserver is notified when a broadcast transaction lands onchain or is dropped
+	landingObserver func(sig solanaGo.Signature, landed bool)
+	// balanceCheck rejects new transactions from keys below the balance floor
+	balanceCheck func(account string) error
+	spend        *spendTracker
 }
 
 type TxConfig struct {
@@ -92,16 +101,57 @@ func NewTxm(chainID string, client internal.Loader[client.ReaderWriter],
 		}
 	}
 
+	lggr = logger.Named(lggr, "Txm")
+	sendPresignedTx := sendTx
+
+	// bundle submission takes precedence over RPC broadcasting
+	// tipped transactions should not be sent to RPCs where the tip could be paid without the bundle auction
+	var bundles *bundleSender
+	if endpoint := cfg.BundleEndpoint(); endpoint != nil {
+		bundles = newBundleSender(NewBundleClient(endpoint, cfg.TxTimeout()), NewTipEstimator(cfg, lggr), lggr)
+		sendTx = bundles.SendTx
+	}
+
 	return &Txm{
-		lggr:   logger.Named(lggr, "Txm"),
-		chSend: make(chan pendingTx, MaxQueueLen), // queue can support 1000 pending txs
-		chSim:  make(chan pendingTx, MaxQueueLen), // queue can support 1000 pending txs
-		chStop: make(chan struct{}),
-		cfg:    cfg,
-		txs:    newPendingTxContextWithProm(chainID),
-		ks:     ks,
-		client: client,
-		sendTx: sendTx,
+		lggr:    lggr,
+		chSend:  make(chan pendingTx, MaxQueueLen), // queue can support 1000 pending txs
+		chSim:   make(chan pendingTx, MaxQueueLen), // queue can support 1000 pending txs
+		chStop:  make(chan struct{}),
+		cfg:     cfg,
+		txs:     newPendingTxContextWithProm(chainID),
+		ks:      ks,
+		client:  client,
+		sendTx:  sendTx,
+		bundles: bundles,
+		spend:   newSpendTracker(),
+
+		sendPresignedTx: sendPresignedTx,
+	}
+}
+
+// SetLandingObserver registers a callback for transactions that land onchain or are dropped, must be called before Start.
+func (txm *Txm) SetLandingObserver(fn func(sig solanaGo.Signature, landed bool)) {
+	txm.landingObserver = fn
+}
+
+// SetBalanceCheck registers a check for the fee payer of new transactions, must be called before Start.
+func (txm *Txm) SetBalanceCheck(fn func(account string) error) {
+	txm.balanceCheck = fn
+}
+
+// FeeSpend returns the fees (lamports) of landed transactions paid by the account and the period they were observed over.
+func (txm *Txm) FeeSpend(account solanaGo.PublicKey) (uint64, time.Duration) {
+	return txm.spend.spend(account)
+}
+
+func (txm *Txm) reportLanding(sig solanaGo.Signature, landed bool) {
+	if landed {
+		txm.spend.onLanded(sig)
+	} else {
+		txm.spend.onDropped(sig)
+	}
+	if txm.landingObserver != nil {
+		txm.landingObserver(sig, landed)
 	}
 }
 
@@ -131,6 +181,10 @@ func (txm *Txm) Start(ctx context.Context) error {
 		go txm.run()
 		go txm.confirm()
 		go txm.simulate()
+		if txm.bundles != nil {
+			txm.done.Add(1) // waitgroup: bundle confirmer
+			go txm.confirmBundles()
+		}
 		// Start reaping loop only if TxRetentionTimeout > 0
 		// Otherwise, transactions are dropped immediately after finalization so the loop is not required
 		if txm.cfg.TxRetentionTimeout() > 0 {
@@ -194,17 +248,45 @@ func (txm *Txm) sendWithRetry(ctx context.Context, msg pendingTx) (solanaGo.Tran
 	}
 
 	baseTx := msg.tx
+	sendTx := txm.sendTx
+	if msg.presigned {
+		sendTx = txm.sendPresignedTx
+	}
+
+	// bundle tip account and base tip should only be calculated once, tips are bumped together with the fee
+	var tipAccount solanaGo.PublicKey
+	var baseTip uint64
+	if txm.bundles != nil && !msg.presigned {
+		var tipErr error
+		if tipAccount, tipErr = txm.bundles.tipAccount(ctx); tipErr != nil {
+			return solanaGo.Transaction{}, "", solanaGo.Signature{}, tipErr
+		}
+		baseTip = txm.bundles.tips.BaseTip(ctx)
+	}
+	getTip := func(count int) uint64 {
+		if txm.bundles == nil || msg.presigned {
+			return 0
+		}
+		return bundleTip(baseTip, txm.cfg.BundleTipMax(), count)
+	}
 
 	// add compute unit limit instruction - static for the transaction
 	// skip if compute unit limit = 0 (otherwise would always fail)
-	if msg.cfg.ComputeUnitLimit != 0 {
-		if computeUnitLimitErr := fees.SetComputeUnitLimit(&baseTx, fees.ComputeUnitLimit(msg.cfg.ComputeUnitLimit)); computeUnitLimitErr != nil {
+	computeUnitLimit := msg.cfg.ComputeUnitLimit
+	if computeUnitLimit != 0 && !msg.presigned {
+		if txm.bundles != nil {
+			computeUnitLimit += BundleTipComputeUnits // account for the tip transfer
+		}
+		if computeUnitLimitErr := fees.SetComputeUnitLimit(&baseTx, fees.ComputeUnitLimit(computeUnitLimit)); computeUnitLimitErr != nil {
 			return solanaGo.Transaction{}, "", solanaGo.Signature{}, fmt.Errorf("failed to add compute unit limit instruction: %w", computeUnitLimitErr)
 		}
 	}
 
 	buildTx := func(ctx context.Context, base solanaGo.Transaction, retryCount int) (solanaGo.Transaction, error) {
 		newTx := base // make copy
+		if msg.presigned {
+			return newTx, nil // signed offline, any change invalidates the signatures
+		}
 
 		// set fee
 		// fee bumping can be enabled by moving the setting & signing logic to the broadcaster
@@ -212,6 +294,13 @@ func (txm *Txm) sendWithRetry(ctx context.Context, msg pendingTx) (solanaGo.Tran
 			return solanaGo.Transaction{}, computeUnitErr
 		}
 
+		// set bundle tip
+		if txm.bundles != nil {
+			if tipErr := addTipInstruction(&newTx, tipAccount, getTip(retryCount)); tipErr != nil {
+				return solanaGo.Transaction{}, fmt.Errorf("failed to add bundle tip instruction: %w", tipErr)
+			}
+		}
+
 		// sign tx
 		txMsg, marshalErr := newTx.Message.MarshalBinary()
 		if marshalErr != nil {
@@ -225,6 +314,8 @@ func (txm *Txm) sendWithRetry(ctx context.Context, msg pendingTx) (solanaGo.Tran
 		copy(finalSig[:], sigBytes)
 		newTx.Signatures = append(newTx.Signatures, finalSig)
 
+		// track fee spend per key once the signature lands
+		txm.spend.onSent(newTx.Signatures[0], newTx.Message.AccountKeys[0], txFee(&newTx, getFee(retryCount), computeUnitLimit, getTip(retryCount)))
 		return newTx, nil
 	}
 
@@ -237,7 +328,7 @@ func (txm *Txm) sendWithRetry(ctx context.Context, msg pendingTx) (solanaGo.Tran
 	ctx, cancel := context.WithTimeout(ctx, msg.cfg.Timeout)
 
 	// send initial tx (do not retry and exit early if fails)
-	sig, initSendErr := txm.sendTx(ctx, &initTx)
+	sig, initSendErr := sendTx(ctx, &initTx)
 	if initSendErr != nil {
 		cancel()                                                         // cancel context when exiting early
 		txm.txs.OnError(sig, txm.cfg.TxRetentionTimeout(), TxFailReject) //nolint // no need to check error since only incrementing metric here
@@ -258,7 +349,7 @@ func (txm *Txm) sendWithRetry(ctx context.Context, msg pendingTx) (solanaGo.Tran
 		return solanaGo.Transaction{}, "", solanaGo.Signature{}, fmt.Errorf("failed to save initial signature in signature list: %w", initSetErr)
 	}
 
-	txm.lggr.Debugw("tx initial broadcast", "id", msg.id, "fee", getFee(0), "signature", sig)
+	txm.lggr.Debugw("tx initial broadcast", "id", msg.id, "fee", getFee(0), "tip", getTip(0), "signature", sig)
 
 	txm.done.Add(1)
 	// retry with exponential backoff
@@ -308,7 +399,7 @@ func (txm *Txm) sendWithRetry(ctx context.Context, msg pendingTx) (solanaGo.Tran
 				go func(bump bool, count int, retryTx solanaGo.Transaction) {
 					defer wg.Done()
 
-					retrySig, retrySendErr := txm.sendTx(ctx, &retryTx)
+					retrySig, retrySendErr := sendTx(ctx, &retryTx)
 					// this could occur if endpoint goes down or if ctx cancelled
 					if retrySendErr != nil {
 						if strings.Contains(retrySendErr.Error(), "context canceled") || strings.Contains(retrySendErr.Error(), "context deadline exceeded") {
@@ -329,7 +420,7 @@ func (txm *Txm) sendWithRetry(ctx context.Context, msg pendingTx) (solanaGo.Tran
 							// this should never happen
 							txm.lggr.Errorw("INVARIANT VIOLATION", "error", setErr)
 						}
-						txm.lggr.Debugw("tx rebroadcast with bumped fee", "id", msg.id, "fee", getFee(count), "signatures", sigs.List())
+						txm.lggr.Debugw("tx rebroadcast with bumped fee", "id", msg.id, "fee", getFee(count), "tip", getTip(count), "signatures", sigs.List())
 					}
 
 					// prevent locking on waitgroup when ctx is closed
@@ -423,6 +514,7 @@ func (txm *Txm) confirm() {
 								txm.lggr.Infow("failed to mark transaction as errored", "id", id, "signature", s[i], "timeoutSeconds", txm.cfg.TxConfirmTimeout(), "error", err)
 							} else {
 								txm.lggr.Infow("failed to find transaction within confirm timeout", "id", id, "signature", s[i], "timeoutSeconds", txm.cfg.TxConfirmTimeout())
+								txm.reportLanding(s[i], false)
 							}
 						}
 						continue
@@ -435,6 +527,7 @@ func (txm *Txm) confirm() {
 							txm.lggr.Infow("failed to mark transaction as errored", "id", id, "signature", s[i], "error", err)
 						} else {
 							txm.lggr.Debugw("tx state: failed", "id", id, "signature", s[i], "error", res[i].Err, "status", res[i].ConfirmationStatus)
+							txm.reportLanding(s[i], true) // reverted transactions are included onchain
 						}
 						continue
 					}
@@ -447,6 +540,7 @@ func (txm *Txm) confirm() {
 							txm.lggr.Errorw("failed to mark transaction as processed", "signature", s[i], "error", err)
 						} else if err == nil {
 							txm.lggr.Debugw("marking transaction as processed", "id", id, "signature", s[i])
+							txm.reportLanding(s[i], true)
 						}
 						// check confirm timeout exceeded if TxConfirmTimeout set
 						if txm.cfg.TxConfirmTimeout() != 0*time.Second && txm.txs.Expired(s[i], txm.cfg.TxConfirmTimeout()) {
@@ -467,6 +561,7 @@ func (txm *Txm) confirm() {
 							txm.lggr.Errorw("failed to mark transaction as confirmed", "id", id, "signature", s[i], "error", err)
 						} else if err == nil {
 							txm.lggr.Debugw("marking transaction as confirmed", "id", id, "signature", s[i])
+							txm.reportLanding(s[i], true)
 						}
 						continue
 					}
@@ -478,6 +573,7 @@ func (txm *Txm) confirm() {
 							txm.lggr.Errorw("failed to mark transaction as finalized", "id", id, "signature", s[i], "error", err)
 						} else {
 							txm.lggr.Debugw("marking transaction as finalized", "id", id, "signature", s[i])
+							txm.reportLanding(s[i], true)
 						}
 						continue
 					}
@@ -585,6 +681,12 @@ func (txm *Txm) Enqueue(ctx context.Context, accountID string, tx *solanaGo.Tran
 		return fmt.Errorf("error in soltxm.Enqueue.GetKey: %w", err)
 	}
 
+	if txm.balanceCheck != nil {
+		if err = txm.balanceCheck(tx.Message.AccountKeys[0].String()); err != nil {
+			return fmt.Errorf("error in soltxm.Enqueue.BalanceCheck: %w", err)
+		}
+	}
+
 	// apply changes to default config
 	cfg := txm.defaultTxConfig()
 	for _, v := range txCfgs {
@@ -57,6 +57,7 @@ func TestTxm_SendWithRetry_Race(t *testing.T) {
 	cfg.On("TxRetryTimeout").Return(txRetryDuration)
 	cfg.On("ComputeUnitLimitDefault").Return(uint32(200_000)) // default value, cannot not use 0
 	cfg.On("EstimateComputeUnitLimit").Return(false)
+	cfg.On("BundleEndpoint").Return(nil) // bundle submission disabled
 	// keystore mock
 	ks.On("Sign", mock.Anything, mock.Anything, mock.Anything).Return([]byte{}, nil)
 
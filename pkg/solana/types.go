@@ -133,6 +133,12 @@ type AccessController struct {
 	Len           uint64
 }
 
+// TransmissionsHeader.State values, the store program flags a feed when consecutive answers deviate beyond FlaggingThreshold
+const (
+	FeedStateNormal  uint8 = 0
+	FeedStateFlagged uint8 = 1
+)
+
 // TransmissionsHeader struct for decoding transmission state header
 type TransmissionsHeader struct {
 	Version           uint8
@@ -176,4 +182,13 @@ type RelayConfig struct {
 	OCR2ProgramID   string `json:"ocr2ProgramID"`
 	TransmissionsID string `json:"transmissionsID"`
 	StoreProgramID  string `json:"storeProgramID"`
+
+	// additional transmitter keys used alongside PluginArgs.TransmitterID, each must be an oracle transmitter in the state account
+	TransmitterIDs []string `json:"transmitterIDs"`
+	// transmitter keys no longer used for new transmissions while their pending transactions are confirmed, requires TransmitterIDs
+	RetiredTransmitterIDs []string `json:"retiredTransmitterIDs"`
+
+	// optional: submit lower_flag with this key once the feed is flagged and LowerFlagHealthyRounds rounds are within the flagging threshold
+	LowerFlagAuthority     string `json:"lowerFlagAuthority"`
+	LowerFlagHealthyRounds uint32 `json:"lowerFlagHealthyRounds"`
 }